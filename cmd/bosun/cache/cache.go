@@ -1,44 +1,234 @@
 package cache // import "bosun.org/cmd/bosun/cache"
 
 import (
+	"fmt"
+	"sort"
 	"sync"
+	"time"
 
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
 	"github.com/golang/groupcache/lru"
 	"github.com/golang/groupcache/singleflight"
 )
 
+func init() {
+	metadata.AddMetricMeta(
+		"bosun.query_cache.hits", metadata.Counter, metadata.Count,
+		"Number of backend queries answered from the query cache.")
+	metadata.AddMetricMeta(
+		"bosun.query_cache.misses", metadata.Counter, metadata.Count,
+		"Number of backend queries not found in the query cache.")
+}
+
 type Cache struct {
 	g singleflight.Group
 
 	sync.Mutex
 	lru *lru.Cache
+
+	name       string
+	ttl        time.Duration
+	backendTTL map[string]time.Duration
+	keys       map[string]*entry
+	stats      map[string]*BackendStats
+}
+
+type entry struct {
+	backend string
+	key     string
+	value   interface{}
+	now     time.Time
+	added   time.Time
 }
 
 func New(MaxEntries int) *Cache {
-	return &Cache{
-		lru: lru.New(MaxEntries),
+	c := &Cache{
+		lru:   lru.New(MaxEntries),
+		keys:  make(map[string]*entry),
+		stats: make(map[string]*BackendStats),
+	}
+	c.lru.OnEvicted = func(key lru.Key, value interface{}) {
+		delete(c.keys, key.(string))
 	}
+	return c
+}
+
+// NewShared creates a cache that is shared between check cycles. A result
+// cached for a query is reused by a later identical query for up to the TTL
+// of its backend, or ttl if the backend has none. Hits and misses are sent
+// as metrics tagged with name.
+func NewShared(name string, maxEntries int, ttl time.Duration, backendTTL map[string]time.Duration) *Cache {
+	c := New(maxEntries)
+	c.name = name
+	c.ttl = ttl
+	c.backendTTL = backendTTL
+	return c
 }
 
 func (c *Cache) Get(key string, getFn func() (interface{}, error)) (interface{}, error) {
+	return c.GetRelative("", key, time.Time{}, getFn)
+}
+
+// GetRelative is like Get, but for a query of backend evaluated at now. Times
+// in key should be relative to now, so that the same query evaluated by a
+// later check matches it. A cached result is used if it was evaluated less than
+// the TTL of the backend before now.
+func (c *Cache) GetRelative(backend, key string, now time.Time, getFn func() (interface{}, error)) (interface{}, error) {
 	if c == nil {
 		return getFn()
 	}
+	ckey := backend + "\x00" + key
 	c.Lock()
-	result, ok := c.lru.Get(key)
+	var result interface{}
+	ok := false
+	if v, found := c.lru.Get(ckey); found {
+		e := v.(*entry)
+		if age := now.Sub(e.now); age == 0 || (age > 0 && age < c.ttlOf(backend)) {
+			result, ok = e.value, true
+		}
+	}
+	c.count(backend, ok)
 	c.Unlock()
 	if ok {
 		return result, nil
 	}
 	// our lock only serves to protect the lru.
 	// we can (and should!) do singleflight requests concurently
-	return c.g.Do(key, func() (interface{}, error) {
+	return c.g.Do(fmt.Sprint(now.UnixNano(), ckey), func() (interface{}, error) {
 		v, err := getFn()
 		if err == nil {
 			c.Lock()
-			c.lru.Add(key, v)
+			e := &entry{
+				backend: backend,
+				key:     key,
+				value:   v,
+				now:     now,
+				added:   time.Now(),
+			}
+			c.lru.Add(ckey, e)
+			c.keys[ckey] = e
 			c.Unlock()
 		}
 		return v, err
 	})
 }
+
+// ttlOf returns the TTL of results of backend. c must be locked.
+func (c *Cache) ttlOf(backend string) time.Duration {
+	if ttl, ok := c.backendTTL[backend]; ok {
+		return ttl
+	}
+	return c.ttl
+}
+
+// count records a hit or miss for backend. c must be locked.
+func (c *Cache) count(backend string, hit bool) {
+	s := c.stats[backend]
+	if s == nil {
+		s = &BackendStats{}
+		c.stats[backend] = s
+	}
+	metric := "query_cache.misses"
+	if hit {
+		s.Hits++
+		metric = "query_cache.hits"
+	} else {
+		s.Misses++
+	}
+	if c.name != "" && backend != "" {
+		collect.Add(metric, opentsdb.TagSet{"cache": c.name, "backend": backend}, 1)
+	}
+}
+
+// Flush removes all cached results of backend, or all results if backend is
+// empty. It returns the number of removed results.
+func (c *Cache) Flush(backend string) int {
+	if c == nil {
+		return 0
+	}
+	c.Lock()
+	defer c.Unlock()
+	n := 0
+	for k, e := range c.keys {
+		if backend == "" || e.backend == backend {
+			c.lru.Remove(k)
+			n++
+		}
+	}
+	return n
+}
+
+// Stats describes the contents of a cache.
+type Stats struct {
+	Name       string
+	Entries    int
+	MaxEntries int
+	TTL        time.Duration
+	BackendTTL map[string]time.Duration
+	Backends   map[string]*BackendStats
+	Results    []EntryStats
+}
+
+// BackendStats counts the lookups of the queries of a backend.
+type BackendStats struct {
+	Hits    int64
+	Misses  int64
+	Entries int
+}
+
+// EntryStats describes a cached result.
+type EntryStats struct {
+	Backend string
+	Key     string
+	Now     time.Time
+	Added   time.Time
+}
+
+// Stats returns the current statistics of c. If results is true the cached
+// results are listed, oldest first.
+func (c *Cache) Stats(results bool) Stats {
+	if c == nil {
+		return Stats{}
+	}
+	c.Lock()
+	defer c.Unlock()
+	s := Stats{
+		Name:       c.name,
+		Entries:    c.lru.Len(),
+		MaxEntries: c.lru.MaxEntries,
+		TTL:        c.ttl,
+		BackendTTL: c.backendTTL,
+		Backends:   make(map[string]*BackendStats),
+	}
+	for b, bs := range c.stats {
+		cp := *bs
+		cp.Entries = 0
+		s.Backends[b] = &cp
+	}
+	for _, e := range c.keys {
+		bs := s.Backends[e.backend]
+		if bs == nil {
+			bs = &BackendStats{}
+			s.Backends[e.backend] = bs
+		}
+		bs.Entries++
+		if results {
+			s.Results = append(s.Results, EntryStats{
+				Backend: e.backend,
+				Key:     e.key,
+				Now:     e.now,
+				Added:   e.added,
+			})
+		}
+	}
+	sort.Sort(entryStatsByAdded(s.Results))
+	return s
+}
+
+type entryStatsByAdded []EntryStats
+
+func (e entryStatsByAdded) Len() int           { return len(e) }
+func (e entryStatsByAdded) Swap(i, j int)      { e[i], e[j] = e[j], e[i] }
+func (e entryStatsByAdded) Less(i, j int) bool { return e[i].Added.Before(e[j].Added) }
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetRelative(t *testing.T) {
+	c := NewShared("test", 10, time.Minute, map[string]time.Duration{"graphite": 0})
+	calls := 0
+	get := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+	now := time.Unix(1483228800, 0)
+	tests := []struct {
+		backend string
+		now     time.Time
+		expect  int
+	}{
+		{"opentsdb", now, 1},
+		{"opentsdb", now, 1},
+		{"opentsdb", now.Add(30 * time.Second), 1},
+		{"opentsdb", now.Add(time.Minute), 2},
+		{"opentsdb", now.Add(-time.Second), 3},
+		{"graphite", now, 4},
+		{"graphite", now.Add(time.Second), 5},
+	}
+	for i, test := range tests {
+		v, err := c.GetRelative(test.backend, "q", test.now, get)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.(int) != test.expect {
+			t.Errorf("%v: got %v, expected %v", i, v, test.expect)
+		}
+	}
+	s := c.Stats(true)
+	if s.Entries != 2 || len(s.Results) != 2 {
+		t.Errorf("got %v entries, expected 2", s.Entries)
+	}
+	if bs := s.Backends["opentsdb"]; bs.Hits != 2 || bs.Misses != 3 || bs.Entries != 1 {
+		t.Errorf("bad opentsdb stats: %+v", bs)
+	}
+	if n := c.Flush("graphite"); n != 1 {
+		t.Errorf("flushed %v results, expected 1", n)
+	}
+	if s := c.Stats(false); s.Entries != 1 || s.Backends["graphite"].Entries != 0 {
+		t.Errorf("bad stats after flush: %+v", s)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	ttemplate "text/template"
 
 	"bosun.org/slog"
+	"bosun.org/util"
 )
 
 // SystemConfProvider providers all the information about the system configuration.
@@ -46,6 +47,8 @@ type SystemConfProvider interface {
 	GetRedisHost() string
 	GetRedisDb() int
 	GetRedisPassword() string
+	GetSQLDriver() string
+	GetSQLDataSource() string
 	GetTimeAndDate() []int
 	GetSearchSince() time.Duration
 
@@ -71,12 +74,19 @@ type SystemConfProvider interface {
 
 	GetAuthConf() *AuthConf
 
+	GetQueryCacheConf() QueryCacheConf
+	GetRetentionConf() RetentionConf
+	GetWebhooks() []*Webhook
+	GetWebhook(name string) *Webhook
+
 	// Contexts
 	GetTSDBContext() opentsdb.Context
 	GetGraphiteContext() graphite.Context
 	GetInfluxContext() client.HTTPConfig
 	GetLogstashContext() expr.LogstashElasticHosts
 	GetElasticContext() expr.ElasticHosts
+	GetPrometheusContext() expr.PrometheusHost
+	GetLokiContext() expr.LokiHost
 	AnnotateEnabled() bool
 	GetAnnotateContext() annotate.Client // for function queries which will use the API
 
@@ -97,6 +107,35 @@ func ValidateSystemConf(sc SystemConfProvider) error {
 	if sc.GetHTTPSListen() != "" && (sc.GetTLSCertFile() == "" || sc.GetTLSKeyFile() == "") {
 		return fmt.Errorf("must specify TLSCertFile and TLSKeyFile if HTTPSListen is specified")
 	}
+	qc := sc.GetQueryCacheConf()
+	if qc.MaxEntries < 0 {
+		return fmt.Errorf("query cache max entries must not be negative, is %v", qc.MaxEntries)
+	}
+	if qc.TTL.Duration < 0 {
+		return fmt.Errorf("query cache TTL must not be negative, is %v", qc.TTL)
+	}
+	for b, ttl := range qc.BackendTTL.Map() {
+		if ttl < 0 {
+			return fmt.Errorf("query cache TTL of %v must not be negative, is %v", b, ttl)
+		}
+	}
+	rc := sc.GetRetentionConf()
+	if rc.ClosedIncidentDays < 0 || rc.MaxEvents < 0 || rc.RenderedTemplates.Duration < 0 {
+		return fmt.Errorf("retention ClosedIncidentDays, MaxEvents and RenderedTemplates must not be negative")
+	}
+	if rc.Enabled() && rc.Interval.Duration <= 0 {
+		return fmt.Errorf("retention Interval must be positive, is %v", rc.Interval)
+	}
+	webhooks := make(map[string]bool)
+	for _, w := range sc.GetWebhooks() {
+		if err := w.validate(); err != nil {
+			return err
+		}
+		if webhooks[w.Name] {
+			return fmt.Errorf("duplicate webhook %s", w.Name)
+		}
+		webhooks[w.Name] = true
+	}
 	return nil
 }
 
@@ -110,16 +149,22 @@ func ValidateSystemConf(sc SystemConfProvider) error {
 type RuleConfProvider interface {
 	RuleConfWriter
 	GetUnknownTemplate() *Template
+	GetDigestTemplate() *Template
 	GetTemplate(string) *Template
 
 	GetAlerts() map[string]*Alert
 	GetAlert(string) *Alert
 
+	GetRecords() map[string]*Record
+	GetRecord(string) *Record
+
 	GetNotifications() map[string]*Notification
 	GetNotification(string) *Notification
 
 	GetLookup(string) *Lookup
 
+	GetEscalation(string) *Escalation
+
 	AlertSquelched(*Alert) func(opentsdb.TagSet) bool
 	Squelched(*Alert, opentsdb.TagSet) bool
 	Expand(string, map[string]string, bool) string
@@ -129,11 +174,15 @@ type RuleConfProvider interface {
 // RuleConfWriter is a collection of the methods that are used to manipulate the configuration
 // Save methods will trigger the reload that has been passed to the rule configuration
 type RuleConfWriter interface {
-	BulkEdit(BulkEditRequest) error
+	BulkEdit(edits BulkEditRequest, user, message string) error
 	GetRawText() string
+	GetFileNames() []string
+	GetRawFile(name string) (string, error)
 	GetHash() string
 	SaveRawText(rawConf, diff, user, message string, args ...string) error
+	SaveRawFile(name, rawConf, diff, user, message string, args ...string) error
 	RawDiff(rawConf string) (string, error)
+	RawFileDiff(name, rawConf string) (string, error)
 	SetReload(reload func() error)
 	SetSaveHook(SaveHook)
 }
@@ -219,6 +268,17 @@ type Notification struct {
 	ContentType  string
 	RunOnActions bool
 	UseBody      bool
+	Retries      int
+	RetryBackoff time.Duration
+	Rotation     *Rotation `json:",omitempty"`
+	Slack        *url.URL  `json:"-"`
+	PagerDuty    string    `json:"-"`
+	OpsGenie     string    `json:"-"`
+
+	// MaxPerHour and DigestInterval limit the notifications sent: incidents
+	// over the limit are sent in a digest every DigestInterval.
+	MaxPerHour     int           `json:",omitempty"`
+	DigestInterval time.Duration `json:",omitempty"`
 
 	NextName        string `json:"-"`
 	RawEmail        string `json:"-"`
@@ -335,6 +395,19 @@ type Macro struct {
 	Locator `json:"-"`
 }
 
+// Func is an expression function declared in the rule configuration. Its
+// argument and return types are inferred from the expression of its body
+// so that it can be called and type checked like any built-in function.
+type Func struct {
+	Text    string
+	Name    string
+	Params  []string
+	Args    []models.FuncType
+	Return  models.FuncType
+	Body    string
+	Locator `json:"-"`
+}
+
 // Alert stores all information about alerts. All other major
 // sections of rule configuration are referenced by alerts including
 // Templates, Macros, and Notifications. Alerts hold the expressions
@@ -351,6 +424,8 @@ type Alert struct {
 	Squelch          Squelches  `json:"-"`
 	CritNotification *Notifications
 	WarnNotification *Notifications
+	CritEscalation   *Escalation `json:",omitempty"`
+	WarnEscalation   *Escalation `json:",omitempty"`
 	Unknown          time.Duration
 	MaxLogFrequency  time.Duration
 	IgnoreUnknown    bool
@@ -359,6 +434,15 @@ type Alert struct {
 	Log              bool
 	RunEvery         int
 	ReturnType       models.FuncType
+	CritFor          For            `json:",omitempty"`
+	WarnFor          For            `json:",omitempty"`
+	RecoverFor       For            `json:",omitempty"`
+	FlapWindow       time.Duration  `json:",omitempty"`
+	FlapStart        int            `json:",omitempty"`
+	FlapStop         int            `json:",omitempty"`
+	ActiveWindows    []*util.Window `json:",omitempty"`
+	Schedule         *util.Cron     `json:",omitempty"`
+	Timezone         *time.Location `json:"-"`
 
 	TemplateName string   `json:"-"`
 	RawSquelch   []string `json:"-"`
@@ -366,6 +450,69 @@ type Alert struct {
 	Locator `json:"-"`
 }
 
+// Active reports whether t is in one of the active windows of the alert, in
+// the timezone of the alert. An alert without active windows is always
+// active.
+func (a *Alert) Active(t time.Time) bool {
+	if len(a.ActiveWindows) == 0 {
+		return true
+	}
+	t = t.In(a.Timezone)
+	for _, w := range a.ActiveWindows {
+		if w.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextRun returns the first time after t at which the schedule of the alert
+// runs it, or the zero time if the alert has no schedule.
+func (a *Alert) NextRun(t time.Time) time.Time {
+	if a.Schedule == nil {
+		return time.Time{}
+	}
+	return a.Schedule.Next(t.In(a.Timezone))
+}
+
+// For is how long an alert key must be in a new status before it changes to
+// it: either a duration or a number of consecutive checks. The zero value
+// changes the status right away.
+type For struct {
+	Duration time.Duration `json:",omitempty"`
+	Checks   int           `json:",omitempty"`
+}
+
+// IsZero reports whether f changes the status right away.
+func (f For) IsZero() bool {
+	return f.Duration <= 0 && f.Checks <= 1
+}
+
+// Held reports whether a status that was first seen at since and has been
+// seen count consecutive times is still held back at now.
+func (f For) Held(since, now time.Time, count int) bool {
+	if f.Checks > 0 {
+		return count < f.Checks
+	}
+	return now.Sub(since) < f.Duration
+}
+
+// Record is a recording rule. Its expression is evaluated every RunEvery
+// check cycles and the value of each result group is written back to the
+// TSDB as a datapoint of Metric, so that costly expressions can be queried
+// like any other metric.
+type Record struct {
+	Text string
+	Vars
+	Name     string
+	Expr     *expr.Expr
+	Metric   string
+	Tags     opentsdb.TagSet `json:",omitempty"`
+	RunEvery int
+
+	Locator `json:"-"`
+}
+
 // A Locator stores the information about the location of the rule in the underlying
 // rule store
 type Locator interface{}
@@ -374,33 +521,35 @@ type Locator interface{}
 type BulkEditRequest []EditRequest
 
 // EditRequest is a proposed edit to the config file for sections. The Name is the name of section,
-// Type can be "alert", "template", "notification", "lookup", or "macro". The Text should be the full
-// text of the definition, including the delaration and brackets (i.e. "alert foo { .. }"). If Delete
-// is true then the section will be deleted. In order to rename something, specify the old name in the
-// Name field but have the Text definition contain the new name.
+// Type can be "alert", "template", "notification", "escalation", "rotation", "lookup", "macro", "func" or "record". The Text
+// should be the full text of the definition, including the delaration and brackets (i.e. "alert foo
+// { .. }"). If Delete is true then the section will be deleted. In order to rename something, specify the old name in the
+// Name field but have the Text definition contain the new name. A new section is added to File, or
+// to the first file of the configuration if File is empty.
 type EditRequest struct {
 	Name   string
 	Type   string
 	Text   string
 	Delete bool
+	File   string
 }
 
-// SaveHook is a function that is passed files as a string (currently the only implementation
-// has a single file, so there is no convention for the format of multiple files yet), a user
-// a message and vargs. A SaveHook is called when using bosun to save the config. A save is reverted
+// SaveHook is a function that is passed the names of the changed files, a user, a message and
+// vargs. A SaveHook is called when using bosun to save the config. A save is reverted
 // when the SaveHook returns an error.
-type SaveHook func(files, user, message string, args ...string) error
+type SaveHook func(files []string, user, message string, args ...string) error
 
 // MakeSaveCommandHook takes a fuction based on the command name and will run it on save passing files, user,
-// message, args... as arguments to the command. For the SaveHook function that is returned, If the command fails
+// message, args... as arguments to the command. The files are passed as a single comma separated argument.
+// For the SaveHook function that is returned, If the command fails
 // to execute or returns a non normal output then an error is returned.
 func MakeSaveCommandHook(cmdName string) (f SaveHook, err error) {
 	_, err = exec.LookPath(cmdName)
 	if err != nil {
 		return f, fmt.Errorf("command %v not found, failed to create save hook: %v", cmdName, err)
 	}
-	f = func(files, user, message string, args ...string) error {
-		cArgs := []string{files, user, message}
+	f = func(files []string, user, message string, args ...string) error {
+		cArgs := []string{strings.Join(files, ","), user, message}
 		cArgs = append(cArgs, args...)
 		slog.Infof("executing save hook %v\n", cmdName)
 		c := exec.Command(cmdName, cArgs...)
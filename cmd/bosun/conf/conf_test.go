@@ -1,8 +1,10 @@
 package conf
 
 import (
+	"net/mail"
 	"regexp"
 	"testing"
+	"time"
 
 	"bosun.org/opentsdb"
 )
@@ -66,3 +68,48 @@ func TestSquelch(t *testing.T) {
 		}
 	}
 }
+
+func TestRotationOnCall(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	members, err := mail.ParseAddressList("a@x.com, b@x.com, c@x.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Daylight saving time starts on 2016-03-13 in New York.
+	daily := &Rotation{
+		Members:  members,
+		Start:    time.Date(2016, 3, 12, 9, 0, 0, 0, ny),
+		Shift:    24 * time.Hour,
+		Timezone: ny,
+	}
+	hourly := &Rotation{
+		Members:  members,
+		Start:    time.Date(2016, 3, 12, 9, 0, 0, 0, time.UTC),
+		Shift:    time.Hour,
+		Timezone: time.UTC,
+	}
+	tests := []struct {
+		r      *Rotation
+		t      time.Time
+		expect string
+	}{
+		{daily, time.Date(2016, 3, 12, 9, 0, 0, 0, ny), "a@x.com"},
+		{daily, time.Date(2016, 3, 13, 8, 59, 0, 0, ny), "a@x.com"},
+		{daily, time.Date(2016, 3, 13, 9, 0, 0, 0, ny), "b@x.com"},
+		{daily, time.Date(2016, 3, 14, 8, 59, 0, 0, ny), "b@x.com"},
+		{daily, time.Date(2016, 3, 14, 9, 0, 0, 0, ny), "c@x.com"},
+		{daily, time.Date(2016, 3, 15, 9, 0, 0, 0, ny), "a@x.com"},
+		{daily, time.Date(2016, 3, 11, 9, 0, 0, 0, ny), "c@x.com"},
+		{hourly, time.Date(2016, 3, 12, 10, 30, 0, 0, time.UTC), "b@x.com"},
+		{hourly, time.Date(2016, 3, 12, 8, 30, 0, 0, time.UTC), "c@x.com"},
+		{hourly, time.Date(2016, 3, 13, 9, 0, 0, 0, time.UTC), "a@x.com"},
+	}
+	for _, test := range tests {
+		if got := test.r.OnCall(test.t).Address; got != test.expect {
+			t.Errorf("%s: got %s, expected %s", test.t, got, test.expect)
+		}
+	}
+}
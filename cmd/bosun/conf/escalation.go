@@ -0,0 +1,70 @@
+package conf
+
+import (
+	"net/mail"
+	"time"
+)
+
+// An Escalation is an escalation policy: its levels are notified one after
+// another until the incident is acknowledged or closed.
+type Escalation struct {
+	Text    string
+	Name    string
+	Levels  []*EscalationLevel
+	Locator `json:"-"`
+}
+
+// EscalationLevel is a level of an Escalation. Its notification is sent
+// Repeat times, Delay apart, before the escalation moves on to the next level.
+// A Repeat of zero repeats the level until the incident is acknowledged.
+type EscalationLevel struct {
+	Notification     *Notification `json:"-"`
+	NotificationName string
+	Delay            time.Duration
+	Repeat           int
+}
+
+// A Rotation is an on-call rotation. Its members take turns being on call for
+// a shift each, the first one starting at Start.
+type Rotation struct {
+	Text     string
+	Name     string
+	Members  []*mail.Address
+	Start    time.Time
+	Shift    time.Duration
+	Timezone *time.Location `json:"-"`
+	Locator  `json:"-"`
+}
+
+// OnCall returns the member of r that is on call at t.
+func (r *Rotation) OnCall(t time.Time) *mail.Address {
+	n := r.shifts(t) % int64(len(r.Members))
+	if n < 0 {
+		n += int64(len(r.Members))
+	}
+	return r.Members[n]
+}
+
+// shifts returns the number of shifts that started after Start and at or
+// before t. Shifts of whole days start at the same local time in the timezone
+// of r regardless of daylight saving time.
+func (r *Rotation) shifts(t time.Time) int64 {
+	n := int64(t.Sub(r.Start) / r.Shift)
+	if t.Before(r.Start) {
+		n--
+	}
+	if r.Shift%(24*time.Hour) != 0 {
+		return n
+	}
+	days := int(r.Shift / (24 * time.Hour))
+	handoff := func(n int64) time.Time {
+		return r.Start.In(r.Timezone).AddDate(0, 0, int(n)*days)
+	}
+	for handoff(n).After(t) {
+		n--
+	}
+	for !handoff(n + 1).After(t) {
+		n++
+	}
+	return n
+}
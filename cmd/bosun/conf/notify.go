@@ -3,11 +3,15 @@ package conf
 import (
 	"bytes"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/mail"
 	"net/smtp"
 	"strings"
+	"time"
 
 	"bosun.org/collect"
 	"bosun.org/metadata"
@@ -26,15 +30,54 @@ func init() {
 		"The number of email notifications that Bosun failed to send.")
 }
 
-func (n *Notification) Notify(subject, body string, emailsubject, emailbody []byte, c SystemConfProvider, ak string, attachments ...*models.Attachment) {
-	if len(n.Email) > 0 {
-		go n.DoEmail(emailsubject, emailbody, c, ak, attachments...)
+// The actions of the deliveries sent through the outbox: those of
+// notifications, and the posts of webhooks.
+const (
+	ActionEmail     = "email"
+	ActionPost      = "post"
+	ActionGet       = "get"
+	ActionSlack     = "slack"
+	ActionPagerDuty = "pagerduty"
+	ActionOpsGenie  = "opsgenie"
+	ActionWebhook   = "webhook"
+)
+
+// deliveryClient makes the requests of the actions sent over HTTP. Its timeout
+// is well under the minute the outbox waits for an attempt of a delivery
+// before it makes another.
+var deliveryClient = &http.Client{Timeout: 20 * time.Second}
+
+type emailPayload struct {
+	// To are the recipients, including the member of the rotation that was
+	// on call when the delivery was created.
+	To            []string
+	Subject, Body []byte
+	Attachments   []*models.Attachment `json:",omitempty"`
+}
+
+// Notify prints the notification if n has a print action, and returns a
+// delivery for each of its email, post and get actions. They are to be sent
+// with Deliver.
+func (n *Notification) Notify(subject, body string, emailsubject, emailbody []byte, ak string, attachments ...*models.Attachment) []*models.Delivery {
+	var ds []*models.Delivery
+	if len(n.Email) > 0 || n.Rotation != nil {
+		ds = append(ds, n.newDelivery(ActionEmail, ak, subject, &emailPayload{n.EmailTo(time.Now()), emailsubject, emailbody, attachments}))
 	}
 	if n.Post != nil {
-		go n.DoPost(n.GetPayload(subject, body), ak)
+		payload := n.GetPayload(subject, body)
+		if n.Body != nil {
+			buf := new(bytes.Buffer)
+			if err := n.Body.Execute(buf, string(payload)); err != nil {
+				slog.Errorln(err)
+			} else {
+				ds = append(ds, n.newDelivery(ActionPost, ak, subject, buf.Bytes()))
+			}
+		} else {
+			ds = append(ds, n.newDelivery(ActionPost, ak, subject, payload))
+		}
 	}
 	if n.Get != nil {
-		go n.DoGet(ak)
+		ds = append(ds, n.newDelivery(ActionGet, ak, subject, nil))
 	}
 	if n.Print {
 		if n.UseBody {
@@ -43,6 +86,94 @@ func (n *Notification) Notify(subject, body string, emailsubject, emailbody []by
 			go n.DoPrint(subject)
 		}
 	}
+	return ds
+}
+
+func (n *Notification) newDelivery(action, ak, subject string, payload interface{}) *models.Delivery {
+	now := time.Now().UTC()
+	d := &models.Delivery{
+		Notification: n.Name,
+		Action:       action,
+		AlertKey:     ak,
+		Subject:      subject,
+		Created:      now,
+		NextAttempt:  now,
+	}
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			slog.Errorln(err)
+		}
+		d.Payload = b
+	}
+	return d
+}
+
+// Deliver makes an attempt to send d, a delivery of n.
+func (n *Notification) Deliver(d *models.Delivery, c SystemConfProvider) error {
+	switch d.Action {
+	case ActionEmail:
+		var p emailPayload
+		if err := json.Unmarshal(d.Payload, &p); err != nil {
+			return err
+		}
+		if len(p.To) == 0 {
+			p.To = n.EmailTo(time.Now())
+		}
+		return n.sendEmail(p.To, p.Subject, p.Body, c, d.AlertKey, p.Attachments...)
+	case ActionPost:
+		var p []byte
+		if err := json.Unmarshal(d.Payload, &p); err != nil {
+			return err
+		}
+		return n.DoPost(p, d.AlertKey)
+	case ActionGet:
+		return n.DoGet(d.AlertKey)
+	case ActionSlack, ActionPagerDuty, ActionOpsGenie:
+		e := &IncidentEvent{}
+		if err := json.Unmarshal(d.Payload, e); err != nil {
+			return err
+		}
+		switch d.Action {
+		case ActionSlack:
+			return n.DoSlack(e)
+		case ActionPagerDuty:
+			return n.DoPagerDuty(e)
+		default:
+			return n.DoOpsGenie(e)
+		}
+	}
+	return fmt.Errorf("unknown notification action %s", d.Action)
+}
+
+// A Deliverer sends deliveries of the outbox: a notification or a webhook.
+type Deliverer interface {
+	Deliver(d *models.Delivery, c SystemConfProvider) error
+	// RetryDelay returns how long to wait before the next attempt of a
+	// delivery that failed attempts times, and false if it is not retried.
+	RetryDelay(attempts int) (time.Duration, bool)
+}
+
+// RetryDelay returns how long to wait before the next attempt of a delivery of
+// n that failed attempts times, and false after its retries.
+func (n *Notification) RetryDelay(attempts int) (time.Duration, bool) {
+	return retryDelay(n.Retries, n.RetryBackoff, attempts)
+}
+
+// retryDelay returns backoff doubled for each attempt after the first, up to
+// an hour, and false once attempts exceeds retries.
+func retryDelay(retries int, backoff time.Duration, attempts int) (time.Duration, bool) {
+	if attempts > retries {
+		return 0, false
+	}
+	d := backoff
+	for i := 1; i < attempts && d < time.Hour; i++ {
+		d *= 2
+	}
+	if d > time.Hour {
+		d = time.Hour
+	}
+	return d, true
 }
 
 func (n *Notification) GetPayload(subject, body string) (payload []byte) {
@@ -57,49 +188,67 @@ func (n *Notification) DoPrint(payload string) {
 	slog.Infoln(payload)
 }
 
-func (n *Notification) DoPost(payload []byte, ak string) {
-	if n.Body != nil {
-		buf := new(bytes.Buffer)
-		if err := n.Body.Execute(buf, string(payload)); err != nil {
-			slog.Errorln(err)
-			return
-		}
-		payload = buf.Bytes()
-	}
-	resp, err := http.Post(n.Post.String(), n.ContentType, bytes.NewBuffer(payload))
+func (n *Notification) DoPost(payload []byte, ak string) error {
+	resp, err := deliveryClient.Post(n.Post.String(), n.ContentType, bytes.NewBuffer(payload))
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
 	if err != nil {
-		slog.Error(err)
-		return
+		return err
 	}
 	if resp.StatusCode >= 300 {
-		slog.Errorln("bad response on notification post:", resp.Status)
-	} else {
-		slog.Infof("post notification successful for alert %s. Response code %d.", ak, resp.StatusCode)
+		return badResponse("post", resp)
 	}
+	slog.Infof("post notification successful for alert %s. Response code %d.", ak, resp.StatusCode)
+	return nil
 }
 
-func (n *Notification) DoGet(ak string) {
-	resp, err := http.Get(n.Get.String())
+func (n *Notification) DoGet(ak string) error {
+	resp, err := deliveryClient.Get(n.Get.String())
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
 	if err != nil {
-		slog.Error(err)
-		return
+		return err
 	}
 	if resp.StatusCode >= 300 {
-		slog.Error("bad response on notification get:", resp.Status)
-	} else {
-		slog.Infof("get notification successful for alert %s. Response code %d.", ak, resp.StatusCode)
+		return badResponse("get", resp)
 	}
+	slog.Infof("get notification successful for alert %s. Response code %d.", ak, resp.StatusCode)
+	return nil
 }
 
-func (n *Notification) DoEmail(subject, body []byte, c SystemConfProvider, ak string, attachments ...*models.Attachment) {
-	e := email.NewEmail()
-	e.From = c.GetEmailFrom()
+// badResponse returns an error for the unsuccessful response resp to a
+// request of a notification action.
+func badResponse(action string, resp *http.Response) error {
+	msg, _ := ioutil.ReadAll(resp.Body)
+	if len(msg) > 200 {
+		msg = msg[:200]
+	}
+	return fmt.Errorf("bad response on notification %s: %s: %s", action, resp.Status, strings.TrimSpace(string(msg)))
+}
+
+// EmailTo returns the addresses the email action of n sends to at t: its
+// email addresses and the member of its rotation that is on call.
+func (n *Notification) EmailTo(t time.Time) []string {
+	var to []string
 	for _, a := range n.Email {
-		e.To = append(e.To, a.Address)
+		to = append(to, a.Address)
 	}
+	if n.Rotation != nil {
+		to = append(to, n.Rotation.OnCall(t).Address)
+	}
+	return to
+}
+
+func (n *Notification) DoEmail(subject, body []byte, c SystemConfProvider, ak string, attachments ...*models.Attachment) error {
+	return n.sendEmail(n.EmailTo(time.Now()), subject, body, c, ak, attachments...)
+}
+
+func (n *Notification) sendEmail(to []string, subject, body []byte, c SystemConfProvider, ak string, attachments ...*models.Attachment) error {
+	e := email.NewEmail()
+	e.From = c.GetEmailFrom()
+	e.To = to
 	e.Subject = string(subject)
 	e.HTML = body
 	for _, a := range attachments {
@@ -108,11 +257,11 @@ func (n *Notification) DoEmail(subject, body []byte, c SystemConfProvider, ak st
 	e.Headers.Add("X-Bosun-Server", util.Hostname)
 	if err := Send(e, c.GetSMTPHost(), c.GetSMTPUsername(), c.GetSMTPPassword()); err != nil {
 		collect.Add("email.sent_failed", nil, 1)
-		slog.Errorf("failed to send alert %v to %v %v\n", ak, e.To, err)
-		return
+		return fmt.Errorf("failed to send alert %v to %v: %v", ak, e.To, err)
 	}
 	collect.Add("email.sent", nil, 1)
 	slog.Infof("relayed alert %v to %v sucessfully. Subject: %d bytes. Body: %d bytes.", ak, e.To, len(subject), len(body))
+	return nil
 }
 
 // Send an email using the given host and SMTP auth (optional), returns any
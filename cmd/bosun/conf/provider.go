@@ -0,0 +1,257 @@
+package conf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+	"bosun.org/util"
+)
+
+func init() {
+	metadata.AddMetricMeta(
+		"bosun.provider.sent", metadata.Counter, metadata.PerSecond,
+		"The number of incident events sent by Bosun to Slack, PagerDuty and OpsGenie.")
+	metadata.AddMetricMeta(
+		"bosun.provider.sent_failed", metadata.Counter, metadata.PerSecond,
+		"The number of incident events Bosun failed to send to Slack, PagerDuty and OpsGenie.")
+}
+
+// The API endpoints of the PagerDuty and OpsGenie notifications.
+var (
+	PagerDutyURL = "https://events.pagerduty.com/v2/enqueue"
+	OpsGenieURL  = "https://api.opsgenie.com/v2/alerts"
+)
+
+// IncidentEventType is the kind of an IncidentEvent.
+type IncidentEventType int
+
+const (
+	IncidentTrigger IncidentEventType = iota
+	IncidentAcknowledge
+	IncidentResolve
+)
+
+func (t IncidentEventType) String() string {
+	switch t {
+	case IncidentTrigger:
+		return "trigger"
+	case IncidentAcknowledge:
+		return "acknowledge"
+	case IncidentResolve:
+		return "resolve"
+	default:
+		return "unknown"
+	}
+}
+
+// IncidentEvent is a change of an incident sent to the slack, pagerduty and
+// opsgenie notifications.
+type IncidentEvent struct {
+	Type     IncidentEventType
+	Id       int64
+	AlertKey models.AlertKey
+	Status   models.Status
+	Subject  string
+	Link     string
+
+	// User and Message of an acknowledge or resolve action.
+	User    string
+	Message string
+}
+
+// Key is the key of the incident of e at the providers. Incidents that are
+// not saved, such as those of log alerts, have none.
+func (e *IncidentEvent) Key() string {
+	if e.Id == 0 {
+		return ""
+	}
+	return fmt.Sprintf("bosun-%d", e.Id)
+}
+
+// HasProvider reports whether n sends incident events.
+func (n *Notification) HasProvider() bool {
+	return n.Slack != nil || n.PagerDuty != "" || n.OpsGenie != ""
+}
+
+// NotifyIncident returns a delivery of e for each provider of n. They are to
+// be sent with Deliver.
+func (n *Notification) NotifyIncident(e *IncidentEvent) []*models.Delivery {
+	var ds []*models.Delivery
+	for _, action := range []string{ActionSlack, ActionPagerDuty, ActionOpsGenie} {
+		switch {
+		case action == ActionSlack && n.Slack == nil,
+			action == ActionPagerDuty && n.PagerDuty == "",
+			action == ActionOpsGenie && n.OpsGenie == "":
+			continue
+		}
+		d := n.newDelivery(action, string(e.AlertKey), e.Subject, e)
+		d.IncidentId = e.Id
+		ds = append(ds, d)
+	}
+	return ds
+}
+
+var slackColors = map[models.Status]string{
+	models.StCritical: "danger",
+	models.StWarning:  "warning",
+	models.StUnknown:  "#888888",
+}
+
+func (n *Notification) DoSlack(e *IncidentEvent) error {
+	attachment := map[string]interface{}{
+		"title":      fmt.Sprintf("#%d: %s", e.Id, e.Subject),
+		"title_link": e.Link,
+		"fallback":   e.Subject,
+	}
+	var text string
+	switch e.Type {
+	case IncidentTrigger:
+		text = fmt.Sprintf("%s is %s", e.AlertKey, e.Status)
+		attachment["color"] = slackColors[e.Status]
+	case IncidentAcknowledge:
+		text = fmt.Sprintf("%s acknowledged %s", e.User, e.AlertKey)
+		attachment["color"] = "#439fe0"
+	case IncidentResolve:
+		text = fmt.Sprintf("%s closed %s", e.User, e.AlertKey)
+		attachment["color"] = "good"
+	}
+	if e.Message != "" {
+		attachment["text"] = e.Message
+	}
+	body := map[string]interface{}{
+		"text":        text,
+		"attachments": []interface{}{attachment},
+	}
+	return n.postProvider("slack", e, n.Slack.String(), nil, body)
+}
+
+var pagerDutySeverities = map[models.Status]string{
+	models.StCritical: "critical",
+	models.StWarning:  "warning",
+	models.StUnknown:  "error",
+}
+
+func (n *Notification) DoPagerDuty(e *IncidentEvent) error {
+	body := map[string]interface{}{
+		"routing_key":  n.PagerDuty,
+		"event_action": e.Type.String(),
+	}
+	if key := e.Key(); key != "" {
+		body["dedup_key"] = key
+	} else if e.Type != IncidentTrigger {
+		return nil
+	}
+	if e.Type == IncidentTrigger {
+		severity := pagerDutySeverities[e.Status]
+		if severity == "" {
+			severity = "info"
+		}
+		body["payload"] = map[string]interface{}{
+			"summary":  e.Subject,
+			"source":   util.Hostname,
+			"severity": severity,
+			"group":    e.AlertKey.Name(),
+			"custom_details": map[string]interface{}{
+				"alert_key": e.AlertKey,
+				"incident":  e.Id,
+			},
+		}
+		body["client"] = "Bosun"
+		body["client_url"] = e.Link
+	}
+	return n.postProvider("pagerduty", e, PagerDutyURL, nil, body)
+}
+
+var opsGeniePriorities = map[models.Status]string{
+	models.StCritical: "P1",
+	models.StUnknown:  "P2",
+	models.StWarning:  "P3",
+}
+
+func (n *Notification) DoOpsGenie(e *IncidentEvent) error {
+	key := e.Key()
+	u := OpsGenieURL
+	var body map[string]interface{}
+	switch e.Type {
+	case IncidentTrigger:
+		priority := opsGeniePriorities[e.Status]
+		if priority == "" {
+			priority = "P5"
+		}
+		// the message is limited to 130 characters
+		subject := e.Subject
+		if r := []rune(subject); len(r) > 130 {
+			subject = string(r[:130])
+		}
+		body = map[string]interface{}{
+			"message":     subject,
+			"description": e.Link,
+			"entity":      string(e.AlertKey),
+			"priority":    priority,
+			"source":      util.Hostname,
+			"details": map[string]string{
+				"alert_key": string(e.AlertKey),
+				"incident":  fmt.Sprint(e.Id),
+			},
+		}
+		if key != "" {
+			body["alias"] = key
+		}
+	case IncidentAcknowledge, IncidentResolve:
+		if key == "" {
+			return nil
+		}
+		action := "acknowledge"
+		if e.Type == IncidentResolve {
+			action = "close"
+		}
+		u = fmt.Sprintf("%s/%s/%s?identifierType=alias", OpsGenieURL, url.QueryEscape(key), action)
+		body = map[string]interface{}{
+			"user":   e.User,
+			"note":   e.Message,
+			"source": util.Hostname,
+		}
+	}
+	header := http.Header{"Authorization": []string{"GenieKey " + n.OpsGenie}}
+	return n.postProvider("opsgenie", e, u, header, body)
+}
+
+// postProvider posts body as JSON to the API of provider at u.
+func (n *Notification) postProvider(provider string, e *IncidentEvent, u string, header http.Header, body interface{}) error {
+	tags := opentsdb.TagSet{"provider": provider}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", u, bytes.NewBuffer(b))
+	if err != nil {
+		return err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := deliveryClient.Do(req)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		collect.Add("provider.sent_failed", tags, 1)
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		collect.Add("provider.sent_failed", tags, 1)
+		return badResponse(provider, resp)
+	}
+	collect.Add("provider.sent", tags, 1)
+	slog.Infof("sent %s of alert %s to %s. Response code %d.", e.Type, e.AlertKey, provider, resp.StatusCode)
+	return nil
+}
@@ -0,0 +1,401 @@
+package rule
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule/parse"
+	"bosun.org/cmd/bosun/expr"
+	eparse "bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/models"
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+var (
+	funcDeclRE  = regexp.MustCompile(`^([a-zA-Z]+)\(([^()]*)\)$`)
+	funcParamRE = regexp.MustCompile(`^[a-zA-Z]+$`)
+)
+
+// funcParamTypes are the types tried, in order, for a parameter that is used
+// outside of a string. Scalars are accepted wherever a number set is, so
+// number sets are preferred.
+var funcParamTypes = []models.FuncType{
+	models.TypeNumberSet,
+	models.TypeSeriesSet,
+	models.TypeScalar,
+}
+
+// funcPart is a piece of the body of a user-defined function: either literal
+// text or a reference to a parameter.
+type funcPart struct {
+	text   string
+	param  int  // index of the referenced parameter, -1 for literal text
+	quoted bool // reference is inside a double quoted string
+	raw    bool // reference is inside a triple quoted string
+}
+
+// userFunc is a parsed user-defined function.
+type userFunc struct {
+	*conf.Func
+	parts []funcPart
+}
+
+// loadFunc loads a func section, which declares an expression function
+// like "func name(a, b) { expr = ... }". Parameters are referenced in the
+// body as variables and are substituted when the function is called.
+func (c *Conf) loadFunc(s *parse.SectionNode) {
+	m := funcDeclRE.FindStringSubmatch(s.Name.Text)
+	if m == nil {
+		c.errorf("bad func declaration %s: expected name(param, ...)", s.Name.Text)
+	}
+	name := m[1]
+	if _, ok := c.Funcs[name]; ok {
+		c.errorf("duplicate func name: %s", name)
+	}
+	if _, ok := c.GetFuncs(c.backends)[name]; ok || expr.IsBuiltin(name) {
+		c.errorf("func %s: name already used by a built-in function", name)
+	}
+	f := conf.Func{
+		Name: name,
+	}
+	f.Text = s.RawText
+	f.Locator = c.newSectionLocator(s)
+	params := make(map[string]bool)
+	if strings.TrimSpace(m[2]) != "" {
+		for _, p := range strings.Split(m[2], ",") {
+			p = strings.TrimSpace(p)
+			if !funcParamRE.MatchString(p) {
+				c.errorf("func %s: bad parameter name %q", name, p)
+			}
+			if params[p] {
+				c.errorf("func %s: duplicate parameter %s", name, p)
+			}
+			params[p] = true
+			f.Params = append(f.Params, p)
+		}
+	}
+	vars := make(conf.Vars)
+	// expand expands variables in v, leaving references to parameters in place.
+	expand := func(v string) string {
+		return exRE.ReplaceAllStringFunc(v, func(s string) string {
+			k := funcVarName(s)
+			if params[k] {
+				return s
+			}
+			if n, ok := vars["$"+k]; ok {
+				return n
+			}
+			return c.Expand(s, nil, false)
+		})
+	}
+	saw := make(map[string]bool)
+	for _, n := range s.Nodes.Nodes {
+		c.at(n)
+		p, ok := n.(*parse.PairNode)
+		if !ok {
+			c.errorf("unexpected node")
+		}
+		c.seen(p.Key.Text, saw)
+		v := expand(p.Val.Text)
+		switch k := p.Key.Text; {
+		case k == "expr":
+			f.Body = v
+		case strings.HasPrefix(k, "$"):
+			vars[k] = v
+		default:
+			c.errorf("unknown key %s", k)
+		}
+	}
+	c.at(s)
+	if f.Body == "" {
+		c.errorf("func %s: no expr specified", name)
+	}
+	uf := &userFunc{
+		Func:  &f,
+		parts: splitFuncBody(f.Body, f.Params),
+	}
+	if err := c.inferFunc(uf); err != nil {
+		c.errorf("func %s: %v", name, err)
+	}
+	c.Funcs[name] = &f
+	c.userFuncs[name] = c.newUserFunc(uf)
+}
+
+// funcVarName returns the name of the variable reference s, which is of the
+// form $name or ${name}.
+func funcVarName(s string) string {
+	s = strings.TrimPrefix(s, "$")
+	if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+		s = s[1 : len(s)-1]
+	}
+	return s
+}
+
+// splitFuncBody splits body into literal text and references to params,
+// recording whether each reference is inside a string.
+func splitFuncBody(body string, params []string) []funcPart {
+	index := make(map[string]int)
+	for i, p := range params {
+		index[p] = i
+	}
+	var parts []funcPart
+	var quoted, raw bool
+	last := 0
+	for i := 0; i < len(body); i++ {
+		switch {
+		case raw:
+			if strings.HasPrefix(body[i:], "'''") {
+				raw = false
+				i += 2
+				continue
+			}
+		case quoted:
+			if body[i] == '"' {
+				quoted = false
+				continue
+			}
+		case body[i] == '"':
+			quoted = true
+			continue
+		case strings.HasPrefix(body[i:], "'''"):
+			raw = true
+			i += 2
+			continue
+		}
+		if body[i] != '$' {
+			continue
+		}
+		loc := exRE.FindStringIndex(body[i:])
+		if loc == nil || loc[0] != 0 {
+			continue
+		}
+		p, ok := index[funcVarName(body[i:i+loc[1]])]
+		if !ok {
+			continue
+		}
+		if last < i {
+			parts = append(parts, funcPart{text: body[last:i], param: -1})
+		}
+		parts = append(parts, funcPart{param: p, quoted: quoted, raw: raw})
+		i += loc[1] - 1
+		last = i + 1
+	}
+	if last < len(body) {
+		parts = append(parts, funcPart{text: body[last:], param: -1})
+	}
+	return parts
+}
+
+// funcPlaceholder is the name of the function that stands in for a parameter
+// used outside of a string.
+func funcPlaceholder(param string) string {
+	return "param" + param
+}
+
+// render returns the body of f with parameters used in strings replaced by
+// args and all other parameters replaced by calls to their placeholders.
+func (f *userFunc) render(args []string) string {
+	var b bytes.Buffer
+	for _, p := range f.parts {
+		switch {
+		case p.param < 0:
+			b.WriteString(p.text)
+		case p.raw:
+			b.WriteString(args[p.param])
+		case p.quoted:
+			q := strconv.Quote(args[p.param])
+			b.WriteString(q[1 : len(q)-1])
+		default:
+			b.WriteString(funcPlaceholder(f.Params[p.param]) + "()")
+		}
+	}
+	return b.String()
+}
+
+// placeholders returns the functions that stand in for parameters used
+// outside of a string. value returns the tags and result of the i'th
+// parameter.
+func (f *userFunc) placeholders(value func(i int) (eparse.Tags, *expr.Results)) map[string]eparse.Func {
+	funcs := make(map[string]eparse.Func)
+	for i, p := range f.Params {
+		if f.Args[i] == models.TypeString {
+			continue
+		}
+		i := i
+		pf := eparse.Func{
+			Return: f.Args[i],
+			F: func(e *expr.State, T miniprofiler.Timer) (*expr.Results, error) {
+				_, res := value(i)
+				return res, nil
+			},
+		}
+		if pf.Return != models.TypeScalar {
+			pf.Tags = func([]eparse.Node) (eparse.Tags, error) {
+				tags, _ := value(i)
+				return tags, nil
+			}
+		}
+		funcs[funcPlaceholder(p)] = pf
+	}
+	return funcs
+}
+
+// inferFunc infers the argument and return types of f. A parameter used in a
+// string is a string. Otherwise the first of funcParamTypes with which the
+// body type checks is used.
+func (c *Conf) inferFunc(f *userFunc) error {
+	inString := make([]bool, len(f.Params))
+	bare := make([]bool, len(f.Params))
+	for _, p := range f.parts {
+		switch {
+		case p.param < 0:
+		case p.quoted || p.raw:
+			inString[p.param] = true
+		default:
+			bare[p.param] = true
+		}
+	}
+	args := make([]string, len(f.Params))
+	var open []int
+	for i, p := range f.Params {
+		args[i] = "$" + p
+		switch {
+		case inString[i] && bare[i]:
+			return fmt.Errorf("parameter %s is used both inside and outside of a string", p)
+		case inString[i]:
+		case bare[i]:
+			open = append(open, i)
+		default:
+			return fmt.Errorf("parameter %s is not used", p)
+		}
+	}
+	text := f.render(args)
+	none := func(int) (eparse.Tags, *expr.Results) {
+		return eparse.Tags{}, nil
+	}
+	f.Args = make([]models.FuncType, len(f.Params))
+	for i := range f.Args {
+		f.Args[i] = models.TypeString
+	}
+	// Try each combination of types for the remaining parameters.
+	choice := make([]int, len(open))
+	var firstErr error
+	for {
+		for j, i := range open {
+			f.Args[i] = funcParamTypes[choice[j]]
+		}
+		e, err := expr.New(text, f.placeholders(none), c.GetFuncs(c.backends))
+		if err == nil {
+			f.Return = e.Root.Return()
+			return nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		j := 0
+		for ; j < len(choice); j++ {
+			choice[j]++
+			if choice[j] < len(funcParamTypes) {
+				break
+			}
+			choice[j] = 0
+		}
+		if j == len(choice) {
+			return firstErr
+		}
+	}
+}
+
+// newUserFunc returns the expression function for f. The body is parsed
+// with the arguments of each call, so the tags of the result follow from the
+// queries built from those arguments.
+func (c *Conf) newUserFunc(f *userFunc) eparse.Func {
+	stringArgs := func(args []eparse.Node) []string {
+		s := make([]string, len(f.Params))
+		for i, p := range f.Params {
+			s[i] = "$" + p
+			if sn, ok := args[i].(*eparse.StringNode); ok {
+				s[i] = sn.Text
+			}
+		}
+		return s
+	}
+	pf := eparse.Func{
+		Args:   f.Args,
+		Return: f.Return,
+	}
+	switch f.Return {
+	case models.TypeNumberSet, models.TypeSeriesSet:
+		pf.Tags = func(args []eparse.Node) (eparse.Tags, error) {
+			value := func(i int) (eparse.Tags, *expr.Results) {
+				tags, _ := args[i].Tags()
+				return tags, nil
+			}
+			e, err := expr.New(f.render(stringArgs(args)), f.placeholders(value), c.GetFuncs(c.backends))
+			if err != nil {
+				return nil, err
+			}
+			return e.Root.Tags()
+		}
+	}
+	in := []reflect.Type{
+		reflect.TypeOf((*expr.State)(nil)),
+		reflect.TypeOf((*miniprofiler.Timer)(nil)).Elem(),
+	}
+	for _, t := range f.Args {
+		switch t {
+		case models.TypeString:
+			in = append(in, reflect.TypeOf(""))
+		case models.TypeScalar:
+			in = append(in, reflect.TypeOf(float64(0)))
+		default:
+			in = append(in, reflect.TypeOf((*expr.Results)(nil)))
+		}
+	}
+	out := []reflect.Type{
+		reflect.TypeOf((*expr.Results)(nil)),
+		reflect.TypeOf((*error)(nil)).Elem(),
+	}
+	ft := reflect.FuncOf(in, out, false)
+	pf.F = reflect.MakeFunc(ft, func(vals []reflect.Value) []reflect.Value {
+		res, err := c.callFunc(f, vals[0].Interface().(*expr.State), vals[1].Interface().(miniprofiler.Timer), vals[2:])
+		rerr := reflect.Zero(out[1])
+		if err != nil {
+			rerr = reflect.ValueOf(&err).Elem()
+		}
+		return []reflect.Value{reflect.ValueOf(res), rerr}
+	}).Interface()
+	return pf
+}
+
+// callFunc executes the body of f with args in the state of the calling
+// expression.
+func (c *Conf) callFunc(f *userFunc, s *expr.State, T miniprofiler.Timer, args []reflect.Value) (*expr.Results, error) {
+	sargs := make([]string, len(args))
+	for i, a := range args {
+		if f.Args[i] == models.TypeString {
+			sargs[i] = a.String()
+		}
+	}
+	value := func(i int) (eparse.Tags, *expr.Results) {
+		if f.Args[i] == models.TypeScalar {
+			return nil, &expr.Results{
+				Results: expr.ResultSlice{
+					&expr.Result{Value: expr.Scalar(args[i].Float())},
+				},
+			}
+		}
+		return eparse.Tags{}, args[i].Interface().(*expr.Results)
+	}
+	e, err := expr.New(f.render(sargs), f.placeholders(value), c.GetFuncs(c.backends))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := e.ExecuteState(s, T)
+	return res, err
+}
@@ -3,36 +3,56 @@ package rule
 import (
 	"bytes"
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"bosun.org/cmd/bosun/conf"
 	"bosun.org/cmd/bosun/conf/rule/parse"
 	"github.com/pmezard/go-difflib/difflib"
 )
 
-// SaveRawText saves a new configuration file. The contextual diff of the change is provided
+// SaveRawText saves a new version of the first configuration file. The contextual diff of the change is provided
 // to verify that no other changes have happened since the save request is issue. User, message, and
 // args are passed to an optionally configured save hook. If the config file is not valid the file
 // will not be saved. If the savehook fails to run or returns an error thaen the orginal config
 // will be restored and the reload will not take place.
 func (c *Conf) SaveRawText(rawConfig, diff, user, message string, args ...string) error {
-	newConf, err := NewConf(c.Name, c.backends, rawConfig)
+	return c.SaveRawFile(c.Files[0].Name, rawConfig, diff, user, message, args...)
+}
+
+// SaveRawFile is like SaveRawText, but saves the file of the configuration named name.
+func (c *Conf) SaveRawFile(name, rawConfig, diff, user, message string, args ...string) error {
+	if c.getFile(name) == nil {
+		return fmt.Errorf("couldn't save config: unknown file %s", name)
+	}
+	newConf, err := c.reparse(map[string]string{name: rawConfig})
 	if err != nil {
 		return err
 	}
-	currentDiff, err := c.RawDiff(rawConfig)
+	currentDiff, err := c.RawFileDiff(name, rawConfig)
 	if err != nil {
 		return fmt.Errorf("couldn't save config because failed to generate a diff: %v", err)
 	}
 	if currentDiff != diff {
 		return fmt.Errorf("couldn't save config file because the change and supplied diff do not match the current diff")
 	}
-	if err = c.SaveConf(newConf); err != nil {
+	if err := c.save(newConf, user, message, args...); err != nil {
+		return err
+	}
+	return c.reload()
+}
+
+// save writes the files of newConf that differ from c and calls the save hook
+// with their names. If the save hook fails the files of c are restored.
+func (c *Conf) save(newConf *Conf, user, message string, args ...string) error {
+	changed := c.changedFiles(newConf)
+	if err := c.SaveConf(newConf); err != nil {
 		return fmt.Errorf("couldn't save config file: %v", err)
 	}
 	if c.saveHook != nil {
-		err := c.callSaveHook(c.Name, user, message, args...)
+		err := c.callSaveHook(changed, user, message, args...)
 		if err != nil {
-			sErr := c.SaveConf(c)
+			sErr := c.writeFiles(changed)
 			restore := "successful"
 			if sErr != nil {
 				restore = sErr.Error()
@@ -40,16 +60,47 @@ func (c *Conf) SaveRawText(rawConfig, diff, user, message string, args ...string
 			return fmt.Errorf("failed to call save hook: %v. Restoring config: %v", err, restore)
 		}
 	}
-	err = c.reload()
-	if err != nil {
-		return err
-	}
 	return nil
 }
 
-// BulkEdit applies sequental edits to the configuration file. Each individual edit
-// must generate a valid configuration or the edit request will fail.
-func (c *Conf) BulkEdit(edits conf.BulkEditRequest) error {
+// reparse loads the configuration again from the same root files, with the
+// text of the files in texts replaced. Files that are not in texts keep their
+// current text.
+func (c *Conf) reparse(texts map[string]string) (*Conf, error) {
+	read := func(name string) (string, error) {
+		for n, text := range texts {
+			if filepath.Clean(n) == filepath.Clean(name) {
+				return text, nil
+			}
+		}
+		if f := c.getFile(name); f != nil {
+			return f.RawText, nil
+		}
+		return c.read(name)
+	}
+	return c.parseRoots(read)
+}
+
+// parseRoots parses the roots of c again, reading them and the files they
+// include with read.
+func (c *Conf) parseRoots(read func(string) (string, error)) (*Conf, error) {
+	roots := make([]*File, len(c.roots))
+	for i, name := range c.roots {
+		text, err := read(name)
+		if err != nil {
+			return nil, err
+		}
+		roots[i] = &File{Name: name, RawText: text}
+	}
+	return newConf(c.Name, c.backends, roots, c.includeDir, read)
+}
+
+// BulkEdit applies sequental edits to the configuration files. Each individual edit
+// must generate a valid configuration or the edit request will fail. An edit of an
+// existing section changes the file the section is in. A new section is added to
+// the file of the edit, or the first file if none is given. User and message are
+// passed to the save hook with the names of the changed files.
+func (c *Conf) BulkEdit(edits conf.BulkEditRequest, user, message string) error {
 	select {
 	case c.writeLock <- true:
 		// Got Write Lock
@@ -60,67 +111,103 @@ func (c *Conf) BulkEdit(edits conf.BulkEditRequest) error {
 		<-c.writeLock
 	}()
 	newConf := c
+	texts := make(map[string]string)
 	var err error
 	for _, edit := range edits {
-		var l Location
+		var loc conf.Locator
 		switch edit.Type {
 		case "alert":
 			a := newConf.GetAlert(edit.Name)
 			if a != nil {
-				l = a.Locator.(Location)
+				loc = a.Locator
 			}
 		case "template":
 			t := newConf.GetTemplate(edit.Name)
 			if t != nil {
-				l = t.Locator.(Location)
+				loc = t.Locator
 			}
 		case "notification":
 			n := newConf.GetNotification(edit.Name)
 			if n != nil {
-				l = n.Locator.(Location)
+				loc = n.Locator
+			}
+		case "escalation":
+			e := newConf.GetEscalation(edit.Name)
+			if e != nil {
+				loc = e.Locator
+			}
+		case "rotation":
+			r := newConf.Rotations[edit.Name]
+			if r != nil {
+				loc = r.Locator
 			}
 		case "lookup":
 			look := newConf.GetLookup(edit.Name)
 			if look != nil {
-				l = look.Locator.(Location)
+				loc = look.Locator
 			}
 		case "macro":
 			m := newConf.GetMacro(edit.Name)
 			if m != nil {
-				l = m.Locator.(Location)
+				loc = m.Locator
+			}
+		case "func":
+			f := newConf.GetFunc(edit.Name)
+			if f != nil {
+				loc = f.Locator
+			}
+		case "record":
+			r := newConf.GetRecord(edit.Name)
+			if r != nil {
+				loc = r.Locator
 			}
 		default:
-			return fmt.Errorf("%v is an unsuported type for bulk edit. must be alert, template, notification, lookup or macro", edit.Type)
+			return fmt.Errorf("%v is an unsuported type for bulk edit. must be alert, template, notification, escalation, rotation, lookup, macro, func or record", edit.Type)
+		}
+		l, found := loc.(Location)
+		name := l.File
+		if !found {
+			name = edit.File
+			if name == "" {
+				name = newConf.Files[0].Name
+			}
+		}
+		f := newConf.getFile(name)
+		if f == nil {
+			return fmt.Errorf("could not edit %v:%v - unknown file %v", edit.Type, edit.Name, name)
 		}
 		var rawConf string
 		if edit.Delete {
-			if l == nil {
+			if !found {
 				return fmt.Errorf("could not delete %v:%v - not found", edit.Type, edit.Name)
 			}
-			rawConf = removeSection(l, newConf.RawText)
+			rawConf = removeSection(l, f.RawText)
+		} else if found {
+			rawConf = writeSection(&l, f.RawText, edit.Text)
 		} else {
-			rawConf = writeSection(l, newConf.RawText, edit.Text)
+			rawConf = writeSection(nil, f.RawText, edit.Text)
 		}
-		newConf, err = NewConf(c.Name, c.backends, rawConf)
+		texts[f.Name] = rawConf
+		newConf, err = c.reparse(texts)
 		if err != nil {
 			return fmt.Errorf("could not create new conf: failed on step %v:%v : %v", edit.Type, edit.Name, err)
 		}
 	}
-	if err := c.SaveConf(newConf); err != nil {
-		return fmt.Errorf("couldn't save config file: %v", err)
-	}
-	err = c.reload()
-	if err != nil {
+	if err := c.save(newConf, user, message); err != nil {
 		return err
 	}
-	return nil
+	return c.reload()
 }
 
-// Location stores the start byte position and end byte position of
-// an object in the raw configuration
-type Location []int
+// Location stores the file and line of the declaration of a section, and
+// the start byte position and end byte position of the section in the file.
+type Location struct {
+	File       string
+	Line       int
+	Start, End int
+}
 
-func writeSection(l Location, orginalRaw, newText string) string {
+func writeSection(l *Location, orginalRaw, newText string) string {
 	var newRawConf bytes.Buffer
 	if l == nil {
 		newRawConf.WriteString(orginalRaw)
@@ -129,44 +216,50 @@ func writeSection(l Location, orginalRaw, newText string) string {
 		newRawConf.WriteString("\n")
 		return newRawConf.String()
 	}
-	newRawConf.WriteString(orginalRaw[:getLocationStart(l)])
+	newRawConf.WriteString(orginalRaw[:l.Start])
 	newRawConf.WriteString(newText)
-	newRawConf.WriteString(orginalRaw[getLocationEnd(l):])
+	newRawConf.WriteString(orginalRaw[l.End:])
 	return newRawConf.String()
 }
 
 func removeSection(l Location, orginalRaw string) string {
 	var newRawConf bytes.Buffer
-	newRawConf.WriteString(orginalRaw[:getLocationStart(l)])
-	newRawConf.WriteString(orginalRaw[getLocationEnd(l):])
+	newRawConf.WriteString(orginalRaw[:l.Start])
+	newRawConf.WriteString(orginalRaw[l.End:])
 	return newRawConf.String()
 }
 
-func newSectionLocator(s *parse.SectionNode) Location {
+// newSectionLocator returns the location of s in the current file.
+func (c *Conf) newSectionLocator(s *parse.SectionNode) Location {
 	start := int(s.Position())
-	end := int(s.Position()) + len(s.RawText)
-	return Location{start, end}
-}
-
-func getLocationStart(l Location) int {
-	return l[0]
-}
-
-func getLocationEnd(l Location) int {
-	return l[1]
+	return Location{
+		File:  c.file.Name,
+		Line:  1 + strings.Count(c.file.RawText[:start], "\n"),
+		Start: start,
+		End:   start + len(s.RawText),
+	}
 }
 
-// RawDiff returns a contextual diff of the running rule configuration
+// RawDiff returns a contextual diff of the first file of the running rule configuration
 // against the provided configuration. This contextual diff library
 // does not guarantee that the generated unified diff can be applied
 // so this is only used for human consumption and verifying that the diff
 // has not change since an edit request was issued
 func (c *Conf) RawDiff(rawConf string) (string, error) {
+	return c.RawFileDiff(c.Files[0].Name, rawConf)
+}
+
+// RawFileDiff is like RawDiff, but for the file of the configuration named name.
+func (c *Conf) RawFileDiff(name, rawConf string) (string, error) {
+	f := c.getFile(name)
+	if f == nil {
+		return "", fmt.Errorf("unknown file %s", name)
+	}
 	diff := difflib.UnifiedDiff{
-		A:        difflib.SplitLines(c.RawText),
+		A:        difflib.SplitLines(f.RawText),
 		B:        difflib.SplitLines(rawConf),
-		FromFile: c.Name,
-		ToFile:   c.Name,
+		FromFile: f.Name,
+		ToFile:   f.Name,
 		Context:  3,
 	}
 	return difflib.GetUnifiedDiffString(diff)
@@ -43,6 +43,7 @@ const (
 	itemRightDelim           // '}'
 	itemString               // string (excluding prefix whitespace and EOL or NL at EOL)
 	itemSubsectionIdentifier // identifier for subsection names
+	itemQuotedString         // double quoted string (includes quotes)
 )
 
 const eof = -1
@@ -216,6 +217,9 @@ func lexValueNext(l *lexer) stateFn {
 			l.ignore()
 		case r == equal:
 			return lexEqual
+		case r == '"':
+			l.backup()
+			return lexQuote
 		case isSubsectionChar(r):
 			l.backup()
 			return lexSubsection
@@ -236,6 +240,18 @@ Loop:
 			break Loop
 		}
 	}
+	// A parameter list, as in "func name(a, b)", is part of the identifier.
+	if l.peek() == '(' {
+	Params:
+		for {
+			switch r := l.next(); {
+			case r == ')':
+				break Params
+			case isEndOfLine(r) || r == eof:
+				return l.errorf("unterminated parameter list")
+			}
+		}
+	}
 	l.emit(itemSubsectionIdentifier)
 	return lexSpace
 }
@@ -267,6 +283,26 @@ func lexString(l *lexer) stateFn {
 	}
 }
 
+// lexQuote scans a double quoted string, which must end on the same line.
+func lexQuote(l *lexer) stateFn {
+	l.next()
+Loop:
+	for {
+		switch r := l.next(); {
+		case r == '\\':
+			if r := l.next(); isEndOfLine(r) || r == eof {
+				return l.errorf("unterminated quoted string")
+			}
+		case isEndOfLine(r) || r == eof:
+			return l.errorf("unterminated quoted string")
+		case r == '"':
+			break Loop
+		}
+	}
+	l.emit(itemQuotedString)
+	return lexSpace
+}
+
 func lexRawString(l *lexer) stateFn {
 	l.next()
 Loop:
@@ -51,6 +51,7 @@ const (
 	NodeList                    // A list of nodes.
 	NodeString                  // A string constant.
 	NodeSection                 // [section] definition.
+	NodeInclude                 // include "path" directive.
 )
 
 // Nodes.
@@ -111,6 +112,23 @@ func (s *SectionNode) String() string {
 	return s.RawText
 }
 
+// IncludeNode holds an include directive. Path is a file name or glob
+// pattern.
+type IncludeNode struct {
+	NodeType
+	Pos
+	RawText string
+	Path    *StringNode
+}
+
+func newInclude(pos Pos) *IncludeNode {
+	return &IncludeNode{NodeType: NodeInclude, Pos: pos}
+}
+
+func (i *IncludeNode) String() string {
+	return i.RawText
+}
+
 // StringNode holds a string constant. The value has been "unquoted".
 type StringNode struct {
 	NodeType
@@ -187,6 +187,9 @@ func (t *Tree) parse(root *ListNode) item {
 			case itemIdentifier, itemSubsectionIdentifier:
 				t.backup2(token)
 				n = t.parseSection()
+			case itemQuotedString:
+				t.backup2(token)
+				n = t.parseInclude(root)
 			default:
 				t.unexpected(token, "input")
 			}
@@ -229,6 +232,27 @@ func (t *Tree) parsePair() *PairNode {
 	return p
 }
 
+func (t *Tree) parseInclude(root *ListNode) *IncludeNode {
+	const context = "include directive"
+	token := t.expect(itemIdentifier, context)
+	if token.val != "include" {
+		t.unexpected(token, "input")
+	}
+	if root != t.Root {
+		t.errorf("include is only allowed at the top level")
+	}
+	i := newInclude(token.pos)
+	start := token.pos
+	token = t.expect(itemQuotedString, context)
+	s, err := strconv.Unquote(token.val)
+	if err != nil {
+		t.error(err)
+	}
+	i.Path = newString(token.pos, token.val, s)
+	i.RawText = t.text[start : int(token.pos)+len(token.val)]
+	return i
+}
+
 func (t *Tree) parseSection() *SectionNode {
 	const context = "section declaration"
 	token := t.expect(itemIdentifier, context)
@@ -1,12 +1,14 @@
 package rule
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/mail"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"runtime"
 	"strconv"
@@ -15,6 +17,7 @@ import (
 
 	"bosun.org/models"
 	"bosun.org/slog"
+	"bosun.org/util"
 
 	htemplate "html/template"
 	ttemplate "text/template"
@@ -33,12 +36,18 @@ type Conf struct {
 	Name string // Config file name
 
 	UnknownTemplate *conf.Template
+	DigestTemplate  *conf.Template
 	Templates       map[string]*conf.Template
 	Alerts          map[string]*conf.Alert
+	Records         map[string]*conf.Record
 	Notifications   map[string]*conf.Notification `json:"-"`
+	Escalations     map[string]*conf.Escalation
+	Rotations       map[string]*conf.Rotation
 	RawText         string
+	Files           []*File
 	Macros          map[string]*conf.Macro
 	Lookups         map[string]*conf.Lookup
+	Funcs           map[string]*conf.Func
 	Squelch         conf.Squelches `json:"-"`
 	NoSleep         bool
 
@@ -47,10 +56,16 @@ type Conf struct {
 
 	tree            *parse.Tree
 	node            parse.Node
+	file            *File
+	roots           []string
+	includeDir      string // directory included files must be in, or empty if includes are not allowed
+	read            func(string) (string, error)
 	unknownTemplate string
+	digestTemplate  string
 	bodies          *htemplate.Template
 	subjects        *ttemplate.Template
 	squelch         []string
+	userFuncs       map[string]eparse.Func
 
 	writeLock chan bool
 
@@ -62,6 +77,16 @@ type Conf struct {
 type deferredSection struct {
 	LoadFunc    func(*parse.SectionNode)
 	SectionNode *parse.SectionNode
+	File        *File
+}
+
+// File is a file of a rule configuration. The root files are the file or
+// the files of the directory the configuration was loaded from, other files
+// are loaded by include directives.
+type File struct {
+	Name    string
+	RawText string
+	tree    *parse.Tree
 }
 
 func (c *Conf) AlertSquelched(a *conf.Alert) func(opentsdb.TagSet) bool {
@@ -125,55 +150,143 @@ func (c *Conf) parseNotifications(v string) (map[string]*conf.Notification, erro
 	return ns, nil
 }
 
+// ParseFile loads the rule configuration at fname. If fname is a directory,
+// all files of the directory ending in .conf are loaded in lexical order.
 func ParseFile(fname string, backends conf.EnabledBackends) (*Conf, error) {
-	f, err := ioutil.ReadFile(fname)
+	fi, err := os.Stat(fname)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		f, err := ioutil.ReadFile(fname)
+		if err != nil {
+			return nil, err
+		}
+		return newConf(fname, backends, []*File{{Name: fname, RawText: string(f)}}, filepath.Dir(fname), readFile)
+	}
+	names, err := filepath.Glob(filepath.Join(fname, "*.conf"))
 	if err != nil {
 		return nil, err
 	}
-	return NewConf(fname, backends, string(f))
+	if len(names) == 0 {
+		return nil, fmt.Errorf("conf: %s: no .conf files in directory", fname)
+	}
+	roots := make([]*File, len(names))
+	for i, name := range names {
+		text, err := readFile(name)
+		if err != nil {
+			return nil, err
+		}
+		roots[i] = &File{Name: name, RawText: text}
+	}
+	return newConf(fname, backends, roots, fname, readFile)
 }
 
+func readFile(name string) (string, error) {
+	b, err := ioutil.ReadFile(name)
+	return string(b), err
+}
+
+// SaveConf writes the files of newConf that differ from c.
 func (c *Conf) SaveConf(newConf *Conf) error {
-	return ioutil.WriteFile(c.Name, []byte(newConf.RawText), os.FileMode(int(0640)))
+	return newConf.writeFiles(c.changedFiles(newConf))
 }
 
+// changedFiles returns the names of the files of newConf whose text differs
+// from the same file of c.
+func (c *Conf) changedFiles(newConf *Conf) []string {
+	var names []string
+	for _, f := range newConf.Files {
+		if old := c.getFile(f.Name); old == nil || old.RawText != f.RawText {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
+
+// writeFiles writes the named files of c.
+func (c *Conf) writeFiles(names []string) error {
+	for _, name := range names {
+		f := c.getFile(name)
+		if f == nil {
+			return fmt.Errorf("unknown file %s", name)
+		}
+		if err := ioutil.WriteFile(f.Name, []byte(f.RawText), os.FileMode(int(0640))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewConf parses the rule configuration text. The text may not include
+// files: it is not a file of the rule directory, and may come from a user.
 func NewConf(name string, backends conf.EnabledBackends, text string) (c *Conf, err error) {
+	return newConf(name, backends, []*File{{Name: name, RawText: text}}, "", nil)
+}
+
+// ParseText parses c with text in place of its first file, such as the text
+// of the rule page.
+func (c *Conf) ParseText(text string) (*Conf, error) {
+	return c.ParseRawFile(c.Files[0].Name, text)
+}
+
+// ParseRawFile is like ParseText, but parses text in place of the file of c
+// named name. The other files keep the text they have in c, and the text may
+// only include files c has loaded.
+func (c *Conf) ParseRawFile(name, text string) (*Conf, error) {
+	if c.getFile(name) == nil {
+		return nil, fmt.Errorf("unknown file %s", name)
+	}
+	return c.parseRoots(func(n string) (string, error) {
+		if filepath.Clean(n) == filepath.Clean(name) {
+			return text, nil
+		}
+		if f := c.getFile(n); f != nil {
+			return f.RawText, nil
+		}
+		return "", fmt.Errorf("%s is not a file of the rule configuration", n)
+	})
+}
+
+// newConf loads the files roots in order, reading the files they include
+// with read. Included files must be in includeDir, and are not allowed if it
+// is empty.
+func newConf(name string, backends conf.EnabledBackends, roots []*File, includeDir string, read func(string) (string, error)) (c *Conf, err error) {
 	defer errRecover(&err)
 	c = &Conf{
 		Name:             name,
 		Vars:             make(map[string]string),
 		Templates:        make(map[string]*conf.Template),
 		Alerts:           make(map[string]*conf.Alert),
+		Records:          make(map[string]*conf.Record),
 		Notifications:    make(map[string]*conf.Notification),
-		RawText:          text,
+		Escalations:      make(map[string]*conf.Escalation),
+		Rotations:        make(map[string]*conf.Rotation),
+		RawText:          roots[0].RawText,
 		bodies:           htemplate.New(name).Funcs(htemplate.FuncMap(defaultFuncs)),
 		subjects:         ttemplate.New(name).Funcs(defaultFuncs),
 		Lookups:          make(map[string]*conf.Lookup),
 		Macros:           make(map[string]*conf.Macro),
+		Funcs:            make(map[string]*conf.Func),
+		userFuncs:        make(map[string]eparse.Func),
 		writeLock:        make(chan bool, 1),
 		deferredSections: make(map[string][]deferredSection),
 		backends:         backends,
-	}
-	c.tree, err = parse.Parse(name, text)
-	if err != nil {
-		c.error(err)
+		includeDir:       includeDir,
+		read:             read,
 	}
 	saw := make(map[string]bool)
-	for _, n := range c.tree.Root.Nodes {
-		c.at(n)
-		switch n := n.(type) {
-		case *parse.PairNode:
-			c.seen(n.Key.Text, saw)
-			c.loadGlobal(n)
-		case *parse.SectionNode:
-			c.loadSection(n)
-		default:
-			c.errorf("unexpected parse node %s", n)
+	for _, f := range roots {
+		c.roots = append(c.roots, f.Name)
+		if c.getFile(f.Name) == nil {
+			c.loadFile(f, saw)
 		}
 	}
+	c.node = nil
 
 	loadSections := func(sectionType string) {
 		for _, dSec := range c.deferredSections[sectionType] {
+			c.file, c.tree = dSec.File, dSec.File.tree
 			c.at(dSec.SectionNode)
 			dSec.LoadFunc(dSec.SectionNode)
 		}
@@ -187,20 +300,124 @@ func NewConf(name string, backends conf.EnabledBackends, text string) (c *Conf,
 		}
 		c.UnknownTemplate = t
 	}
+	if c.digestTemplate != "" {
+		t, ok := c.Templates[c.digestTemplate]
+		if !ok {
+			c.errorf("template not found: %s", c.digestTemplate)
+		}
+		c.DigestTemplate = t
+	}
+	loadSections("rotation")
 	loadSections("notification")
+	loadSections("escalation")
 	loadSections("macro")
 	loadSections("lookup")
+	loadSections("func")
+	loadSections("record")
 	loadSections("alert")
 
 	c.genHash()
 	return
 }
 
+// loadFile parses f and loads its globals, sections and includes. saw holds
+// the globals that have been seen in any file.
+func (c *Conf) loadFile(f *File, saw map[string]bool) {
+	c.Files = append(c.Files, f)
+	c.node = nil
+	var err error
+	f.tree, err = parse.Parse(f.Name, f.RawText)
+	if err != nil {
+		c.error(err)
+	}
+	for _, n := range f.tree.Root.Nodes {
+		c.file, c.tree = f, f.tree
+		c.at(n)
+		switch n := n.(type) {
+		case *parse.PairNode:
+			c.seen(n.Key.Text, saw)
+			c.loadGlobal(n)
+		case *parse.SectionNode:
+			c.loadSection(n)
+		case *parse.IncludeNode:
+			c.loadInclude(n, saw)
+		default:
+			c.errorf("unexpected parse node %s", n)
+		}
+	}
+}
+
+// loadInclude loads the files matching the path of an include directive of
+// the current file, in lexical order. A relative path is relative to the
+// directory of the current file. Files that have already been loaded are
+// skipped. Only files of the rule directory may be included.
+func (c *Conf) loadInclude(n *parse.IncludeNode, saw map[string]bool) {
+	if c.includeDir == "" {
+		c.errorf("include is only allowed in the files of the rule directory")
+	}
+	pattern := n.Path.Text
+	if !filepath.IsAbs(pattern) {
+		pattern = filepath.Join(filepath.Dir(c.file.Name), pattern)
+	}
+	if !inDir(c.includeDir, pattern) {
+		c.errorf("included path %s is outside of the rule directory %s", n.Path.Text, c.includeDir)
+	}
+	names := []string{pattern}
+	if strings.ContainsAny(pattern, `*?[\`) {
+		var err error
+		names, err = filepath.Glob(pattern)
+		if err != nil {
+			c.errorf("bad include pattern %s: %v", n.Path.Text, err)
+		}
+	}
+	for _, name := range names {
+		if !inDir(c.includeDir, name) {
+			c.errorf("included file %s is outside of the rule directory %s", name, c.includeDir)
+		}
+		if c.getFile(name) != nil {
+			continue
+		}
+		text, err := c.read(name)
+		if err != nil {
+			c.errorf("could not read included file: %v", err)
+		}
+		c.loadFile(&File{Name: name, RawText: text}, saw)
+	}
+}
+
+// inDir reports whether the path name is in the directory dir, once both are
+// cleaned.
+func inDir(dir, name string) bool {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+	name, err = filepath.Abs(name)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(dir, name)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// getFile returns the loaded file named name, or nil.
+func (c *Conf) getFile(name string) *File {
+	name = filepath.Clean(name)
+	for _, f := range c.Files {
+		if filepath.Clean(f.Name) == name {
+			return f
+		}
+	}
+	return nil
+}
+
 func (c *Conf) loadGlobal(p *parse.PairNode) {
 	v := c.Expand(p.Val.Text, nil, false)
 	switch k := p.Key.Text; k {
 	case "unknownTemplate":
 		c.unknownTemplate = v
+	case "digestTemplate":
+		c.digestTemplate = v
 	case "squelch":
 		c.squelch = append(c.squelch, v)
 		if err := c.Squelch.Add(v); err != nil {
@@ -224,14 +441,23 @@ func (c *Conf) loadSection(s *parse.SectionNode) {
 		ds.LoadFunc = c.loadAlert
 	case "notification":
 		ds.LoadFunc = c.loadNotification
+	case "escalation":
+		ds.LoadFunc = c.loadEscalation
+	case "rotation":
+		ds.LoadFunc = c.loadRotation
 	case "macro":
 		ds.LoadFunc = c.loadMacro
 	case "lookup":
 		ds.LoadFunc = c.loadLookup
+	case "func":
+		ds.LoadFunc = c.loadFunc
+	case "record":
+		ds.LoadFunc = c.loadRecord
 	default:
 		c.errorf("unknown section type: %s", s.SectionType.Text)
 	}
 	ds.SectionNode = s
+	ds.File = c.file
 	c.deferredSections[s.SectionType.Text] = append(c.deferredSections[s.SectionType.Text], ds)
 }
 
@@ -299,7 +525,7 @@ func (c *Conf) loadLookup(s *parse.SectionNode) {
 		Name: name,
 	}
 	l.Text = s.RawText
-	l.Locator = newSectionLocator(s)
+	l.Locator = c.newSectionLocator(s)
 	var lookupTags opentsdb.TagSet
 	saw := make(map[string]bool)
 	for _, n := range s.Nodes.Nodes {
@@ -367,7 +593,7 @@ func (c *Conf) loadMacro(s *parse.SectionNode) {
 		Name: name,
 	}
 	m.Text = s.RawText
-	m.Locator = newSectionLocator(s)
+	m.Locator = c.newSectionLocator(s)
 	pairs := c.getPairs(s, nil, sMacro)
 	for _, p := range pairs {
 		if _, ok := m.Pairs.([]nodePair); !ok { //bad
@@ -432,7 +658,7 @@ func (c *Conf) loadTemplate(s *parse.SectionNode) {
 		Name: name,
 	}
 	t.Text = s.RawText
-	t.Locator = newSectionLocator(s)
+	t.Locator = c.newSectionLocator(s)
 	funcs := ttemplate.FuncMap{
 		"V": func(v string) string {
 			return c.Expand(v, t.Vars, false)
@@ -494,7 +720,7 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 		WarnNotification: new(conf.Notifications),
 	}
 	a.Text = s.RawText
-	a.Locator = newSectionLocator(s)
+	a.Locator = c.newSectionLocator(s)
 	procNotification := func(v string, ns *conf.Notifications) {
 		if lookup := lookupNotificationRE.FindStringSubmatch(v); lookup != nil {
 			if ns.Lookups == nil {
@@ -529,6 +755,8 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 		}
 	}
 	pairs := c.getPairs(s, a.Vars, sNormal)
+	// flapStop may be set to 0, so whether it is set is kept apart.
+	flapStopSet := false
 	for _, p := range pairs {
 		c.at(p.node)
 		v := p.val
@@ -555,6 +783,10 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			procNotification(v, a.CritNotification)
 		case "warnNotification":
 			procNotification(v, a.WarnNotification)
+		case "critEscalation":
+			a.CritEscalation = c.getEscalation(v)
+		case "warnEscalation":
+			a.WarnEscalation = c.getEscalation(v)
 		case "unknown":
 			od, err := opentsdb.ParseDuration(v)
 			if err != nil {
@@ -589,6 +821,58 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			if err != nil {
 				c.error(err)
 			}
+		case "critFor":
+			a.CritFor = c.parseFor(p.key, v)
+		case "warnFor":
+			a.WarnFor = c.parseFor(p.key, v)
+		case "recoverFor":
+			a.RecoverFor = c.parseFor(p.key, v)
+		case "activeWindow":
+			w, err := util.ParseWindow(v)
+			if err != nil {
+				c.error(err)
+			}
+			a.ActiveWindows = append(a.ActiveWindows, w)
+		case "schedule":
+			cron, err := util.ParseCron(v)
+			if err != nil {
+				c.error(err)
+			}
+			a.Schedule = cron
+		case "timezone":
+			loc, err := time.LoadLocation(v)
+			if err != nil {
+				c.error(err)
+			}
+			a.Timezone = loc
+		case "flapWindow":
+			od, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			if od <= 0 {
+				c.errorf("flapWindow must be greater than zero")
+			}
+			a.FlapWindow = time.Duration(od)
+		case "flapStart":
+			var err error
+			a.FlapStart, err = strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if a.FlapStart < 2 {
+				c.errorf("flapStart must be at least 2")
+			}
+		case "flapStop":
+			var err error
+			flapStopSet = true
+			a.FlapStop, err = strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if a.FlapStop < 0 {
+				c.errorf("flapStop must not be negative")
+			}
 		default:
 			c.errorf("unknown key %s", p.key)
 		}
@@ -596,6 +880,31 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 	if a.MaxLogFrequency != 0 && !a.Log {
 		c.errorf("maxLogFrequency can only be used on alerts with `log = true`.")
 	}
+	if a.Log && !(a.CritFor.IsZero() && a.WarnFor.IsZero() && a.RecoverFor.IsZero()) {
+		c.errorf("critFor, warnFor and recoverFor can not be used on alerts with `log = true`.")
+	}
+	if a.Schedule != nil && a.RunEvery != 0 {
+		c.errorf("runEvery can not be used with schedule")
+	}
+	if a.Timezone == nil {
+		a.Timezone = time.UTC
+	}
+	if (a.FlapWindow != 0) != (a.FlapStart != 0) {
+		c.errorf("flapWindow and flapStart must be used together")
+	}
+	if a.FlapWindow != 0 {
+		if a.Log {
+			c.errorf("flap detection can not be used on alerts with `log = true`.")
+		}
+		if !flapStopSet {
+			a.FlapStop = a.FlapStart / 2
+		}
+		if a.FlapStop >= a.FlapStart {
+			c.errorf("flapStop must be less than flapStart")
+		}
+	} else if flapStopSet {
+		c.errorf("flapStop can only be used with flapWindow and flapStart")
+	}
 	c.at(s)
 	if a.Crit == nil && a.Warn == nil {
 		c.errorf("neither crit or warn specified")
@@ -645,6 +954,9 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 				c.errorf("cannot use log with a chained notification")
 			}
 		}
+		if a.CritEscalation != nil || a.WarnEscalation != nil {
+			c.errorf("cannot use log with an escalation")
+		}
 		if a.Crit != nil && len(a.CritNotification.Notifications) == 0 {
 			c.errorf("log + crit specified, but no critNotification")
 		}
@@ -668,11 +980,106 @@ func (c *Conf) loadAlert(s *parse.SectionNode) {
 			c.errorf("critNotification specified, but no template")
 		}
 	}
+	if a.WarnEscalation != nil && a.Warn == nil {
+		c.errorf("warnEscalation specified, but no warn")
+	}
+	if a.CritEscalation != nil && a.Crit == nil {
+		c.errorf("critEscalation specified, but no crit")
+	}
+	if (a.CritEscalation != nil || a.WarnEscalation != nil) && a.Template == nil {
+		c.errorf("escalation specified, but no template")
+	}
 
 	a.ReturnType = ret
 	c.Alerts[name] = &a
 }
 
+// parseFor parses the value of a critFor, warnFor or recoverFor key, which is
+// either a number of consecutive checks or a duration.
+func (c *Conf) parseFor(key, v string) conf.For {
+	if n, err := strconv.Atoi(v); err == nil {
+		if n < 1 {
+			c.errorf("%s must be at least 1", key)
+		}
+		return conf.For{Checks: n}
+	}
+	od, err := opentsdb.ParseDuration(v)
+	if err != nil {
+		c.errorf("%s must be a number of checks or a duration: %v", key, err)
+	}
+	if od <= 0 {
+		c.errorf("%s must be greater than zero", key)
+	}
+	return conf.For{Duration: time.Duration(od)}
+}
+
+func (c *Conf) loadRecord(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Records[name]; ok {
+		c.errorf("duplicate record name: %s", name)
+	}
+	r := conf.Record{
+		Vars: make(map[string]string),
+		Name: name,
+	}
+	r.Text = s.RawText
+	r.Locator = c.newSectionLocator(s)
+	pairs := c.getPairs(s, r.Vars, sNormal)
+	for _, p := range pairs {
+		c.at(p.node)
+		v := p.val
+		switch p.key {
+		case "expr":
+			r.Expr = c.NewExpr(v)
+		case "metric":
+			if !opentsdb.ValidTSDBString(v) {
+				c.errorf("invalid metric name: %s", v)
+			}
+			r.Metric = v
+		case "tags":
+			tags, err := opentsdb.ParseTags(v)
+			if err != nil {
+				c.error(err)
+			}
+			if err := tags.Clean(); err != nil {
+				c.error(err)
+			}
+			r.Tags = tags
+		case "runEvery":
+			var err error
+			r.RunEvery, err = strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if r.RunEvery < 1 {
+				c.errorf("runEvery must be at least 1")
+			}
+		default:
+			c.errorf("unknown key %s", p.key)
+		}
+	}
+	c.at(s)
+	if r.Expr == nil {
+		c.errorf("no expr specified")
+	}
+	if r.Metric == "" {
+		c.errorf("no metric specified")
+	}
+	if !c.backends.OpenTSDB {
+		c.errorf("records are written to OpenTSDB, which is not configured")
+	}
+	tags, err := r.Expr.Root.Tags()
+	if err != nil {
+		c.error(err)
+	}
+	for k := range r.Tags {
+		if _, ok := tags[k]; ok {
+			c.errorf("tag %s is set by both tags and expr", k)
+		}
+	}
+	c.Records[name] = &r
+}
+
 func (c *Conf) loadNotification(s *parse.SectionNode) {
 	name := s.Name.Text
 	if _, ok := c.Notifications[name]; ok {
@@ -683,9 +1090,11 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 		ContentType:  "application/x-www-form-urlencoded",
 		Name:         name,
 		RunOnActions: true,
+		Retries:      3,
+		RetryBackoff: time.Minute,
 	}
 	n.Text = s.RawText
-	n.Locator = newSectionLocator(s)
+	n.Locator = c.newSectionLocator(s)
 	funcs := ttemplate.FuncMap{
 		"V": func(v string) string {
 			return c.Expand(v, n.Vars, false)
@@ -697,6 +1106,12 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 			}
 			return string(b)
 		},
+		"onCall": func() string {
+			if n.Rotation == nil {
+				return ""
+			}
+			return n.Rotation.OnCall(time.Now()).Address
+		},
 	}
 	c.Notifications[name] = &n
 	pairs := c.getPairs(s, n.Vars, sNormal)
@@ -727,6 +1142,22 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 			n.Get = get
 		case "print":
 			n.Print = true
+		case "rotation":
+			r, ok := c.Rotations[v]
+			if !ok {
+				c.errorf("unknown rotation %s", v)
+			}
+			n.Rotation = r
+		case "slack":
+			slack, err := url.Parse(v)
+			if err != nil {
+				c.error(err)
+			}
+			n.Slack = slack
+		case "pagerduty":
+			n.PagerDuty = v
+		case "opsgenie":
+			n.OpsGenie = v
 		case "contentType":
 			n.ContentType = v
 		case "next":
@@ -754,6 +1185,42 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 			n.RunOnActions = v == "true"
 		case "useBody":
 			n.UseBody = v == "true"
+		case "retries":
+			var err error
+			n.Retries, err = strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if n.Retries < 0 {
+				c.errorf("retries must not be negative")
+			}
+		case "maxPerHour":
+			var err error
+			n.MaxPerHour, err = strconv.Atoi(v)
+			if err != nil {
+				c.error(err)
+			}
+			if n.MaxPerHour < 1 {
+				c.errorf("maxPerHour must be at least 1")
+			}
+		case "digestInterval":
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			if d <= 0 {
+				c.errorf("digestInterval must be greater than zero")
+			}
+			n.DigestInterval = time.Duration(d)
+		case "retryBackoff":
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			if d <= 0 {
+				c.errorf("retryBackoff must be greater than zero")
+			}
+			n.RetryBackoff = time.Duration(d)
 		default:
 			c.errorf("unknown key %s", k)
 		}
@@ -762,6 +1229,168 @@ func (c *Conf) loadNotification(s *parse.SectionNode) {
 	if n.Timeout > 0 && n.Next == nil {
 		c.errorf("timeout specified without next")
 	}
+	if n.MaxPerHour > 0 && n.DigestInterval == 0 {
+		n.DigestInterval = 10 * time.Minute
+	}
+}
+
+func (c *Conf) getEscalation(name string) *conf.Escalation {
+	e, ok := c.Escalations[name]
+	if !ok {
+		c.errorf("unknown escalation %s", name)
+	}
+	return e
+}
+
+func (c *Conf) loadEscalation(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Escalations[name]; ok {
+		c.errorf("duplicate escalation name: %s", name)
+	}
+	e := conf.Escalation{
+		Name: name,
+	}
+	e.Text = s.RawText
+	e.Locator = c.newSectionLocator(s)
+	for _, n := range s.Nodes.Nodes {
+		c.at(n)
+		sn, ok := n.(*parse.SectionNode)
+		if !ok || sn.SectionType.Text != "level" {
+			c.errorf("unexpected node, expected level")
+		}
+		if sn.Name.Text != strconv.Itoa(len(e.Levels)+1) {
+			c.errorf("escalation levels must be numbered 1, 2, 3...")
+		}
+		l := &conf.EscalationLevel{
+			Repeat: 1,
+		}
+		saw := make(map[string]bool)
+		for _, pn := range sn.Nodes.Nodes {
+			c.at(pn)
+			p, ok := pn.(*parse.PairNode)
+			if !ok {
+				c.errorf("unexpected node")
+			}
+			k := p.Key.Text
+			c.seen(k, saw)
+			v := c.Expand(p.Val.Text, nil, false)
+			switch k {
+			case "notification":
+				l.NotificationName = v
+				not, ok := c.Notifications[v]
+				if !ok {
+					c.errorf("unknown notification %s", v)
+				}
+				l.Notification = not
+			case "delay":
+				d, err := opentsdb.ParseDuration(v)
+				if err != nil {
+					c.error(err)
+				}
+				l.Delay = time.Duration(d)
+			case "repeat":
+				if v == "untilAck" {
+					l.Repeat = 0
+					break
+				}
+				var err error
+				l.Repeat, err = strconv.Atoi(v)
+				if err != nil {
+					c.errorf("repeat must be a number or untilAck")
+				}
+				if l.Repeat < 1 {
+					c.errorf("repeat must be at least 1")
+				}
+			default:
+				c.errorf("unknown key %s", k)
+			}
+		}
+		c.at(sn)
+		if l.Notification == nil {
+			c.errorf("level %s has no notification", sn.Name.Text)
+		}
+		if l.Repeat != 1 && l.Delay <= 0 {
+			c.errorf("a repeated level requires a delay")
+		}
+		e.Levels = append(e.Levels, l)
+	}
+	c.at(s)
+	if len(e.Levels) == 0 {
+		c.errorf("escalation has no levels")
+	}
+	for i, l := range e.Levels[:len(e.Levels)-1] {
+		if l.Repeat == 0 {
+			c.errorf("only the last level can repeat untilAck")
+		}
+		if l.Delay <= 0 {
+			c.errorf("level %d requires a delay before the next level", i+1)
+		}
+	}
+	c.Escalations[name] = &e
+}
+
+func (c *Conf) loadRotation(s *parse.SectionNode) {
+	name := s.Name.Text
+	if _, ok := c.Rotations[name]; ok {
+		c.errorf("duplicate rotation name: %s", name)
+	}
+	r := conf.Rotation{
+		Name:     name,
+		Timezone: time.UTC,
+	}
+	r.Text = s.RawText
+	r.Locator = c.newSectionLocator(s)
+	var start *nodePair
+	pairs := c.getPairs(s, nil, sNormal)
+	for i, p := range pairs {
+		c.at(p.node)
+		v := p.val
+		switch p.key {
+		case "members":
+			members, err := mail.ParseAddressList(v)
+			if err != nil {
+				c.error(err)
+			}
+			r.Members = members
+		case "start":
+			// Parsed below, in the timezone of the rotation.
+			start = &pairs[i]
+		case "shift":
+			d, err := opentsdb.ParseDuration(v)
+			if err != nil {
+				c.error(err)
+			}
+			if d <= 0 {
+				c.errorf("shift must be greater than zero")
+			}
+			r.Shift = time.Duration(d)
+		case "timezone":
+			loc, err := time.LoadLocation(v)
+			if err != nil {
+				c.error(err)
+			}
+			r.Timezone = loc
+		default:
+			c.errorf("unknown key %s", p.key)
+		}
+	}
+	c.at(s)
+	if len(r.Members) == 0 {
+		c.errorf("rotation has no members")
+	}
+	if r.Shift == 0 {
+		c.errorf("rotation has no shift")
+	}
+	if start == nil {
+		c.errorf("rotation has no start")
+	}
+	c.at(start.node)
+	t, err := time.ParseInLocation("2006-01-02 15:04", start.val, r.Timezone)
+	if err != nil {
+		c.errorf("start must be formatted as 2006-01-02 15:04")
+	}
+	r.Start = t
+	c.Rotations[name] = &r
 }
 
 var exRE = regexp.MustCompile(`\$(?:[\w.]+|\{[\w.]+\})`)
@@ -791,7 +1420,7 @@ func (c *Conf) Expand(v string, vars map[string]string, ignoreBadExpand bool) st
 func (c *Conf) seen(v string, m map[string]bool) {
 	if m[v] {
 		switch v {
-		case "squelch", "critNotification", "warnNotification", "graphiteHeader":
+		case "squelch", "critNotification", "warnNotification", "graphiteHeader", "activeWindow":
 			// ignore
 		default:
 			c.errorf("duplicate key: %s", v)
@@ -1018,6 +1647,13 @@ func (c *Conf) GetFuncs(backends conf.EnabledBackends) map[string]eparse.Func {
 	if backends.Annotate {
 		merge(expr.Annotate)
 	}
+	if backends.Prometheus {
+		merge(expr.Prometheus)
+	}
+	if backends.Loki {
+		merge(expr.Loki)
+	}
+	merge(c.userFuncs)
 	return funcs
 }
 
@@ -1095,6 +1731,10 @@ func (c *Conf) GetUnknownTemplate() *conf.Template {
 	return c.UnknownTemplate
 }
 
+func (c *Conf) GetDigestTemplate() *conf.Template {
+	return c.DigestTemplate
+}
+
 func (c *Conf) GetTemplate(s string) *conf.Template {
 	return c.Templates[s]
 }
@@ -1107,6 +1747,14 @@ func (c *Conf) GetAlert(s string) *conf.Alert {
 	return c.Alerts[s]
 }
 
+func (c *Conf) GetRecords() map[string]*conf.Record {
+	return c.Records
+}
+
+func (c *Conf) GetRecord(s string) *conf.Record {
+	return c.Records[s]
+}
+
 func (c *Conf) GetNotifications() map[string]*conf.Notification {
 	return c.Notifications
 }
@@ -1115,10 +1763,18 @@ func (c *Conf) GetNotification(s string) *conf.Notification {
 	return c.Notifications[s]
 }
 
+func (c *Conf) GetEscalation(s string) *conf.Escalation {
+	return c.Escalations[s]
+}
+
 func (c *Conf) GetMacro(s string) *conf.Macro {
 	return c.Macros[s]
 }
 
+func (c *Conf) GetFunc(s string) *conf.Func {
+	return c.Funcs[s]
+}
+
 func (c *Conf) GetLookup(s string) *conf.Lookup {
 	return c.Lookups[s]
 }
@@ -1131,6 +1787,25 @@ func (c *Conf) GetRawText() string {
 	return c.RawText
 }
 
+// GetFileNames returns the names of the files of the configuration, in load
+// order. The first file is the one returned by GetRawText.
+func (c *Conf) GetFileNames() []string {
+	names := make([]string, len(c.Files))
+	for i, f := range c.Files {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// GetRawFile returns the text of the file of the configuration named name.
+func (c *Conf) GetRawFile(name string) (string, error) {
+	f := c.getFile(name)
+	if f == nil {
+		return "", fmt.Errorf("unknown file %s", name)
+	}
+	return f.RawText, nil
+}
+
 func (c *Conf) SetReload(reload func() error) {
 	c.reload = reload
 }
@@ -1143,15 +1818,23 @@ func (c *Conf) SetSaveHook(sh conf.SaveHook) {
 	c.saveHook = sh
 }
 
-func (c *Conf) callSaveHook(file, user, message string, args ...string) error {
+func (c *Conf) callSaveHook(files []string, user, message string, args ...string) error {
 	if c.saveHook == nil {
 		return nil
 	}
-	return c.saveHook(file, user, message, args...)
+	return c.saveHook(files, user, message, args...)
 }
 
 func (c *Conf) genHash() {
-	c.Hash = conf.GenHash(c.RawText)
+	if len(c.Files) == 1 {
+		c.Hash = conf.GenHash(c.RawText)
+		return
+	}
+	var b bytes.Buffer
+	for _, f := range c.Files {
+		fmt.Fprintf(&b, "%s\x00%s\x00", f.Name, f.RawText)
+	}
+	c.Hash = conf.GenHash(b.String())
 }
 
 func (c *Conf) GetHash() string {
@@ -1,12 +1,18 @@
 package rule
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/expr"
+	"bosun.org/models"
 )
 
 func TestPrint(t *testing.T) {
@@ -72,6 +78,11 @@ func TestInvalid(t *testing.T) {
 		"depends-no-overlap": `conf: depends-no-overlap:1:0: at <alert broken {\n	dep...>: Depends and crit/warn must share at least one tag.`,
 		"log-no-notification": `conf: log-no-notification:1:0: at <alert a {\n	crit = 1...>: log + crit specified, but no critNotification`,
 		"crit-notification-no-template": `conf: crit-notification-no-template:5:0: at <alert a {\n	crit = 1...>: critNotification specified, but no template`,
+		"func-unused-param": `conf: func-unused-param:1:0: at <func f(a, b) {\n	exp...>: func f: parameter b is not used`,
+		"func-builtin-name": `conf: func-builtin-name:1:0: at <func avg(a) {\n	expr...>: func avg: name already used by a built-in function`,
+		"record-duplicate-tag": `conf: record-duplicate-tag:1:0: at <record r {\n	expr = ...>: tag a is set by both tags and expr`,
+		"schedule-run-every":   `conf: schedule-run-every:4:1: at <runEvery = 5>: runEvery can not be used with schedule`,
+		"escalation-repeat-not-last": `conf: escalation-repeat-not-last:5:0: at <escalation e {\n	lev...>: only the last level can repeat untilAck`,
 	}
 	for fname, reason := range names {
 		path := filepath.Join("invalid", fname)
@@ -89,3 +100,323 @@ func TestInvalid(t *testing.T) {
 		}
 	}
 }
+
+func TestFuncs(t *testing.T) {
+	c, err := NewConf("funcs", conf.EnabledBackends{OpenTSDB: true}, `
+		$window = "5m"
+		func cpu(host) {
+			$q = q("avg:rate:os.cpu{host=$host}", $window, "")
+			expr = avg($q)
+		}
+		func peak(s, scale) {
+			expr = max($s) * $scale
+		}
+		func tagged(host, v) {
+			expr = peak(series("host=$host", 0, $v), 2)
+		}
+		alert cpu {
+			crit = cpu("ny-web01") > 80
+		}
+		alert peak {
+			crit = tagged("ny-web02", 3) > 5
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := map[string][]models.FuncType{
+		"cpu":    {models.TypeString, models.TypeNumberSet},
+		"peak":   {models.TypeSeriesSet, models.TypeNumberSet, models.TypeNumberSet},
+		"tagged": {models.TypeString, models.TypeScalar, models.TypeNumberSet},
+	}
+	for name, expect := range types {
+		f := c.Funcs[name]
+		if f == nil {
+			t.Errorf("missing func %s", name)
+			continue
+		}
+		got := append(append([]models.FuncType{}, f.Args...), f.Return)
+		if !reflect.DeepEqual(got, expect) {
+			t.Errorf("%s: got types %v, expected %v", name, got, expect)
+		}
+		if _, ok := c.GetFuncs(conf.EnabledBackends{})[name]; !ok {
+			t.Errorf("%s: missing from GetFuncs", name)
+		}
+	}
+	tags, err := c.Alerts["cpu"].Crit.Root.Tags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tags.String() != "host" {
+		t.Errorf("cpu: got tags %v, expected host", tags)
+	}
+	res, _, err := c.Alerts["peak"].Crit.Execute(&expr.Backends{}, &expr.BosunProviders{}, nil, time.Now(), 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Results) != 1 {
+		t.Fatalf("got %v results, expected 1", len(res.Results))
+	}
+	if r := res.Results[0]; r.Group.String() != "{host=ny-web02}" || r.Value != expr.Number(1) {
+		t.Errorf("got %v %v, expected {host=ny-web02} 1", r.Group, r.Value)
+	}
+}
+
+func writeConfFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "bosun-rule")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, text := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestFlapStop(t *testing.T) {
+	c, err := NewConf("flap", conf.EnabledBackends{}, `
+		alert half {
+			crit = 1
+			flapWindow = 1h
+			flapStart = 6
+		}
+		alert zero {
+			crit = 1
+			flapWindow = 1h
+			flapStart = 6
+			flapStop = 0
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, expect := range map[string]int{"half": 3, "zero": 0} {
+		if got := c.Alerts[name].FlapStop; got != expect {
+			t.Errorf("%s: got flapStop %d, expected %d", name, got, expect)
+		}
+	}
+	_, err = NewConf("flap", conf.EnabledBackends{}, "alert a {\n\tcrit = 1\n\tflapStop = 0\n}\n")
+	if err == nil || !strings.Contains(err.Error(), "flapStop can only be used with flapWindow and flapStart") {
+		t.Errorf("expected error for flapStop without flapWindow, got %v", err)
+	}
+}
+
+func TestInclude(t *testing.T) {
+	dir := writeConfFiles(t, map[string]string{
+		"bosun.conf": `
+			include "teams/*.conf"
+			include "notifications.conf"
+			alert root {
+				crit = 1
+			}
+		`,
+		"notifications.conf": `
+			notification ops {
+				email = ops@example.com
+			}
+		`,
+		"teams/db.conf": `
+			include "../notifications.conf"
+			alert db {
+				crit = 1
+				critNotification = ops
+				template = t
+			}
+		`,
+		"teams/web.conf": `
+			template t {
+				subject = web
+			}
+			alert web {
+				crit = 1
+			}
+		`,
+	})
+	defer os.RemoveAll(dir)
+	c, err := ParseFile(filepath.Join(dir, "bosun.conf"), conf.EnabledBackends{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, name := range c.GetFileNames() {
+		rel, _ := filepath.Rel(dir, name)
+		names = append(names, rel)
+	}
+	expect := []string{"bosun.conf", "teams/db.conf", "notifications.conf", "teams/web.conf"}
+	if !reflect.DeepEqual(names, expect) {
+		t.Errorf("got files %v, expected %v", names, expect)
+	}
+	for name, file := range map[string]string{"root": "bosun.conf", "db": "teams/db.conf", "web": "teams/web.conf"} {
+		a := c.Alerts[name]
+		if a == nil {
+			t.Errorf("missing alert %s", name)
+			continue
+		}
+		l := a.Locator.(Location)
+		if l.File != filepath.Join(dir, file) {
+			t.Errorf("%s: got file %s, expected %s", name, l.File, file)
+		}
+	}
+	if l := c.Alerts["web"].Locator.(Location); l.Line != 5 {
+		t.Errorf("web: got line %d, expected 5", l.Line)
+	}
+
+	// the rule directory is the teams directory itself
+	_, err = ParseFile(filepath.Join(dir, "teams"), conf.EnabledBackends{})
+	if err == nil || !strings.Contains(err.Error(), "outside of the rule directory") {
+		t.Errorf("expected include outside of the teams directory to fail, got %v", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "teams/zz.conf"), []byte("alert web {\n\tcrit = 1\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ParseFile(filepath.Join(dir, "bosun.conf"), conf.EnabledBackends{})
+	if err == nil || !strings.Contains(err.Error(), filepath.Join(dir, "teams/zz.conf")+":1:0: ") {
+		t.Errorf("expected error naming teams/zz.conf, got %v", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "teams/db.conf"), []byte("alert db {\n\tcrit = 1\n\t"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ParseFile(filepath.Join(dir, "bosun.conf"), conf.EnabledBackends{})
+	if err == nil || !strings.Contains(err.Error(), filepath.Join(dir, "teams/db.conf")) {
+		t.Errorf("expected parse error naming teams/db.conf, got %v", err)
+	}
+}
+
+func TestIncludeRestricted(t *testing.T) {
+	dir := writeConfFiles(t, map[string]string{
+		"rules/bosun.conf": `
+			include "notifications.conf"
+			alert a {
+				crit = 1
+			}
+		`,
+		"rules/notifications.conf": `
+			notification ops {
+				email = ops@example.com
+			}
+		`,
+		"rules/other.conf": `
+			notification other {
+				email = other@example.com
+			}
+		`,
+		"secret.txt": "db_password = hunter2\n",
+	})
+	defer os.RemoveAll(dir)
+	root := filepath.Join(dir, "rules/bosun.conf")
+	for _, path := range []string{"../secret.txt", filepath.Join(dir, "secret.txt"), "../*.txt"} {
+		if err := ioutil.WriteFile(root, []byte(fmt.Sprintf("include %q\n", path)), 0644); err != nil {
+			t.Fatal(err)
+		}
+		_, err := ParseFile(root, conf.EnabledBackends{})
+		if err == nil || !strings.Contains(err.Error(), "outside of the rule directory") || strings.Contains(err.Error(), "hunter2") {
+			t.Errorf("%s: expected include to be refused, got %v", path, err)
+		}
+	}
+	_, err := NewConf("test", conf.EnabledBackends{}, fmt.Sprintf("include %q\n", filepath.Join(dir, "rules/other.conf")))
+	if err == nil || !strings.Contains(err.Error(), "only allowed in the files of the rule directory") {
+		t.Errorf("expected include of submitted text to be refused, got %v", err)
+	}
+
+	if err := ioutil.WriteFile(root, []byte("include \"notifications.conf\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := ParseFile(root, conf.EnabledBackends{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc, err := c.ParseText("include \"notifications.conf\"\ntemplate t {\n\tsubject = b\n}\nalert b {\n\tcrit = 1\n\tcritNotification = ops\n\ttemplate = t\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tc.Alerts["b"] == nil || tc.Notifications["ops"] == nil {
+		t.Errorf("expected alert b notifying ops, got alerts %v", tc.Alerts)
+	}
+	_, err = c.ParseText("include \"other.conf\"\n")
+	if err == nil || !strings.Contains(err.Error(), "not a file of the rule configuration") {
+		t.Errorf("expected include of a file that is not loaded to be refused, got %v", err)
+	}
+}
+
+func TestParseTextFiles(t *testing.T) {
+	dir := writeConfFiles(t, map[string]string{
+		"a.conf": "alert a {\n\tcrit = 1\n}\n",
+		"b.conf": "alert b {\n\tcrit = 1\n}\n",
+	})
+	defer os.RemoveAll(dir)
+	c, err := ParseFile(dir, conf.EnabledBackends{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc, err := c.ParseText("alert a {\n\tcrit = 2\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tc.Alerts["a"] == nil || tc.Alerts["b"] == nil || tc.Alerts["a"].Crit.Text != "2" {
+		t.Errorf("expected alert a changed and alert b kept, got alerts %v", tc.Alerts)
+	}
+	tc, err = c.ParseRawFile(filepath.Join(dir, "b.conf"), "alert c {\n\tcrit = 1\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tc.Alerts["a"] == nil || tc.Alerts["b"] != nil || tc.Alerts["c"] == nil {
+		t.Errorf("expected alerts a and c, got alerts %v", tc.Alerts)
+	}
+	if _, err := c.ParseRawFile(filepath.Join(dir, "b.conf"), "alert a {\n\tcrit = 1\n}\n"); err == nil {
+		t.Error("expected error for alert a defined in both files")
+	}
+	if _, err := c.ParseRawFile(filepath.Join(dir, "c.conf"), ""); err == nil {
+		t.Error("expected error for unknown file")
+	}
+}
+
+func TestBulkEditFiles(t *testing.T) {
+	dir := writeConfFiles(t, map[string]string{
+		"a.conf": "alert a {\n\tcrit = 1\n}\n",
+		"b.conf": "alert b {\n\tcrit = 1\n}\n",
+	})
+	defer os.RemoveAll(dir)
+	c, err := ParseFile(dir, conf.EnabledBackends{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved []string
+	c.SetSaveHook(func(files []string, user, message string, args ...string) error {
+		saved = files
+		return nil
+	})
+	c.SetReload(func() error { return nil })
+	bFile := filepath.Join(dir, "b.conf")
+	err = c.BulkEdit(conf.BulkEditRequest{
+		{Type: "alert", Name: "b", Text: "alert b {\n\tcrit = 2\n}"},
+		{Type: "alert", Name: "c", Text: "alert c {\n\tcrit = 3\n}", File: bFile},
+	}, "user", "edit b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(saved, []string{bFile}) {
+		t.Errorf("got changed files %v, expected %v", saved, []string{bFile})
+	}
+	b, err := ioutil.ReadFile(bFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expect := "alert b {\n\tcrit = 2\n}\n\nalert c {\n\tcrit = 3\n}\n"; string(b) != expect {
+		t.Errorf("got b.conf %q, expected %q", b, expect)
+	}
+	diff, err := c.RawFileDiff(bFile, string(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(diff, "+\tcrit = 2") {
+		t.Errorf("unexpected diff: %s", diff)
+	}
+}
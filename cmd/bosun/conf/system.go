@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"time"
 
+	"bosun.org/cmd/bosun/database"
 	"bosun.org/cmd/bosun/expr"
 	"bosun.org/graphite"
 	"bosun.org/opentsdb"
@@ -40,14 +41,22 @@ type SystemConf struct {
 
 	SMTPConf SMTPConf
 
-	OpenTSDBConf OpenTSDBConf
-	GraphiteConf GraphiteConf
-	InfluxConf   InfluxConf
-	ElasticConf  ElasticConf
-	LogStashConf LogStashConf
+	OpenTSDBConf   OpenTSDBConf
+	GraphiteConf   GraphiteConf
+	InfluxConf     InfluxConf
+	ElasticConf    ElasticConf
+	LokiConf       LokiConf
+	LogStashConf   LogStashConf
+	PrometheusConf PrometheusConf
 
 	AnnotateConf AnnotateConf
 
+	QueryCacheConf QueryCacheConf
+
+	RetentionConf RetentionConf
+
+	Webhooks []*Webhook
+
 	AuthConf *AuthConf
 
 	EnableSave      bool
@@ -62,12 +71,14 @@ type SystemConf struct {
 // and the parse errors can be thrown for query functions that are used when the backend
 // is not enabled
 type EnabledBackends struct {
-	OpenTSDB bool
-	Graphite bool
-	Influx   bool
-	Elastic  bool
-	Logstash bool
-	Annotate bool
+	OpenTSDB   bool
+	Graphite   bool
+	Influx     bool
+	Elastic    bool
+	Logstash   bool
+	Annotate   bool
+	Prometheus bool
+	Loki       bool
 }
 
 // EnabledBackends returns and EnabledBackends struct which contains fields
@@ -80,6 +91,8 @@ func (sc *SystemConf) EnabledBackends() EnabledBackends {
 	b.Logstash = len(sc.LogStashConf.Hosts) != 0
 	b.Elastic = len(sc.ElasticConf.Hosts) != 0
 	b.Annotate = len(sc.AnnotateConf.Hosts) != 0
+	b.Prometheus = sc.PrometheusConf.URL != ""
+	b.Loki = sc.LokiConf.URL != ""
 	return b
 }
 
@@ -115,6 +128,11 @@ type ElasticConf struct {
 	Hosts expr.ElasticHosts
 }
 
+// LokiConf contains configuration for a Loki server that Bosun can query
+type LokiConf struct {
+	URL string // Base URL of the Loki HTTP API: http://loki:3100
+}
+
 // InfluxConf contains configuration for an influx host that Bosun can query
 type InfluxConf struct {
 	URL       string
@@ -126,6 +144,66 @@ type InfluxConf struct {
 	Precision string
 }
 
+// QueryCacheConf configures a cache of query results that is shared by all
+// alert checks. Without it, results are only shared by the checks of a single
+// check cycle.
+type QueryCacheConf struct {
+	MaxEntries int      // Maximum number of cached query results. Setting it enables the cache
+	TTL        Duration // Time for which a cached result may be used by later checks
+	BackendTTL BackendTTL
+}
+
+// BackendTTL overrides the query cache TTL for the results of a backend
+type BackendTTL struct {
+	OpenTSDB   *Duration
+	Graphite   *Duration
+	Influx     *Duration
+	Elastic    *Duration
+	Logstash   *Duration
+	Prometheus *Duration
+	Loki       *Duration
+}
+
+// Map returns the TTLs that are set, keyed by the backend names used by the
+// query cache.
+func (b BackendTTL) Map() map[string]time.Duration {
+	m := make(map[string]time.Duration)
+	for name, d := range map[string]*Duration{
+		"opentsdb":   b.OpenTSDB,
+		"graphite":   b.Graphite,
+		"influx":     b.Influx,
+		"elastic":    b.Elastic,
+		"logstash":   b.Logstash,
+		"prometheus": b.Prometheus,
+		"loki":       b.Loki,
+	} {
+		if d != nil {
+			m[name] = d.Duration
+		}
+	}
+	return m
+}
+
+// RetentionConf configures how long incidents are kept. Nothing is dropped or
+// deleted unless it is set.
+type RetentionConf struct {
+	ClosedIncidentDays int      // Days closed incidents are kept after they end
+	MaxEvents          int      // Maximum number of events kept per incident, the oldest are dropped
+	RenderedTemplates  Duration // Time after the end of an incident its rendered templates are dropped
+	ArchiveDir         string   // Directory incidents are exported to as gzipped JSON before they are deleted
+	Interval           Duration // Time between runs of the retention job: 1h
+}
+
+// Enabled reports whether anything is dropped or deleted.
+func (r RetentionConf) Enabled() bool {
+	return r.ClosedIncidentDays > 0 || r.MaxEvents > 0 || r.RenderedTemplates.Duration > 0
+}
+
+// PrometheusConf contains configuration for a Prometheus server that Bosun can query
+type PrometheusConf struct {
+	URL string // Base URL of the Prometheus HTTP API: http://prometheus:9090
+}
+
 // DBConf stores the connection information for Bosun's internal storage
 type DBConf struct {
 	RedisHost     string
@@ -134,6 +212,12 @@ type DBConf struct {
 
 	LedisDir      string
 	LedisBindAddr string
+
+	// SQLDataSource is the connection string of a Postgres database, or the
+	// file of a SQLite database. If it is set, the SQL database is used
+	// instead of Redis or Ledis.
+	SQLDriver     string // postgres (default) or sqlite3, which needs cgo
+	SQLDataSource string
 }
 
 // SMTPConf contains information for the mail server for which bosun will
@@ -213,6 +297,9 @@ func newSystemConf() *SystemConf {
 			ResponseLimit: 1 << 20, // 1MB
 			Version:       opentsdb.Version2_1,
 		},
+		RetentionConf: RetentionConf{
+			Interval: Duration{Duration: time.Hour},
+		},
 		SearchSince:      Duration{time.Duration(opentsdb.Day) * 3},
 		UnknownThreshold: 5,
 	}
@@ -245,6 +332,14 @@ func loadSystemConfig(conf string, isFileName bool) (*SystemConf, error) {
 		return sc, fmt.Errorf("undecoded fields in system configuration: %v", decodeMeta.Undecoded())
 	}
 	sc.md = decodeMeta
+	if sc.DBConf.SQLDataSource != "" {
+		if err := database.CheckSQLDriver(sc.GetSQLDriver()); err != nil {
+			return sc, err
+		}
+	}
+	for _, w := range sc.Webhooks {
+		w.setDefaults()
+	}
 	// clear default http listen if not explicitly specified
 	if !decodeMeta.IsDefined("HTTPListen") && decodeMeta.IsDefined("HTTPSListen") {
 		sc.HTTPListen = ""
@@ -314,6 +409,20 @@ func (sc *SystemConf) GetLedisBindAddr() string {
 	return sc.DBConf.LedisBindAddr
 }
 
+// GetSQLDriver returns the driver of the SQL database: postgres or sqlite3
+func (sc *SystemConf) GetSQLDriver() string {
+	if sc.DBConf.SQLDriver == "" {
+		return "postgres"
+	}
+	return sc.DBConf.SQLDriver
+}
+
+// GetSQLDataSource returns the data source of the SQL database. If this is set
+// than the SQL database will be used instead of Redis or Ledis.
+func (sc *SystemConf) GetSQLDataSource() string {
+	return sc.DBConf.SQLDataSource
+}
+
 // GetRedisHost returns the host to use for Redis. If this is set than Redis
 // will be used instead of Ledis.
 func (sc *SystemConf) GetRedisHost() string {
@@ -400,7 +509,8 @@ func (sc *SystemConf) GetCommandHookPath() string {
 }
 
 // GetRuleFilePath returns the path to the file containing contains rules
-// rules include Alerts, Macros, Notifications, Templates, and Global Variables
+// rules include Alerts, Macros, Notifications, Templates, and Global Variables.
+// If the path is a directory, the rules are the .conf files of the directory.
 func (sc *SystemConf) GetRuleFilePath() string {
 	return sc.RuleFilePath
 }
@@ -485,6 +595,43 @@ func (sc *SystemConf) GetInfluxContext() client.HTTPConfig {
 	return c
 }
 
+// GetPrometheusContext returns the Prometheus host which contains all the information
+// needed to run PromQL queries. It is empty if Prometheus is not configured.
+func (sc *SystemConf) GetPrometheusContext() expr.PrometheusHost {
+	return expr.PrometheusHost(sc.PrometheusConf.URL)
+}
+
+// GetLokiContext returns the Loki host which contains all the information
+// needed to run LogQL queries. It is empty if Loki is not configured.
+func (sc *SystemConf) GetLokiContext() expr.LokiHost {
+	return expr.LokiHost(sc.LokiConf.URL)
+}
+
+// GetQueryCacheConf returns the configuration of the shared query cache
+func (sc *SystemConf) GetQueryCacheConf() QueryCacheConf {
+	return sc.QueryCacheConf
+}
+
+// GetRetentionConf returns the configuration of incident retention
+func (sc *SystemConf) GetRetentionConf() RetentionConf {
+	return sc.RetentionConf
+}
+
+// GetWebhooks returns the webhooks that are posted incident events
+func (sc *SystemConf) GetWebhooks() []*Webhook {
+	return sc.Webhooks
+}
+
+// GetWebhook returns the webhook named name, or nil if there is none
+func (sc *SystemConf) GetWebhook(name string) *Webhook {
+	for _, w := range sc.Webhooks {
+		if w.Name == name {
+			return w
+		}
+	}
+	return nil
+}
+
 func (sc *SystemConf) GetAnnotateContext() annotate.Client {
 	return annotate.NewClient(fmt.Sprintf("http://%v/api", sc.HTTPListen)) // TODO Fix for HTTPS
 }
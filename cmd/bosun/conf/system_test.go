@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"bosun.org/cmd/bosun/database"
 	"bosun.org/cmd/bosun/expr"
 	"bosun.org/opentsdb"
 
@@ -42,6 +43,17 @@ func TestSystemToml(t *testing.T) {
 	assert.Equal(t, sc.ElasticConf, ElasticConf{
 		Hosts: expr.ElasticHosts{"http://ny-lselastic01.example.com:9200", "http://ny-lselastic02.example.com:9200"},
 	})
+	assert.Equal(t, sc.LokiConf, LokiConf{
+		URL: "http://ny-loki01:3100",
+	})
+	assert.Equal(t, sc.PrometheusConf, PrometheusConf{
+		URL: "http://ny-prometheus01:9090",
+	})
+	assert.Equal(t, sc.QueryCacheConf, QueryCacheConf{
+		MaxEntries: 10000,
+		TTL:        Duration{time.Minute},
+		BackendTTL: BackendTTL{Graphite: &Duration{time.Minute * 5}},
+	})
 	assert.Equal(t, sc.AnnotateConf, AnnotateConf{
 		Hosts: []string{"http://ny-lselastic01.example.com:9200", "http://ny-lselastic02.example.com:9200"},
 	})
@@ -51,6 +63,27 @@ func TestSystemToml(t *testing.T) {
 		LedisBindAddr: "127.0.0.1:9565", // Default
 
 	}, "DBConf does not match")
+	assert.Equal(t, sc.RetentionConf, RetentionConf{
+		ClosedIncidentDays: 90,
+		MaxEvents:          1000,
+		RenderedTemplates:  Duration{time.Hour * 24 * 30},
+		ArchiveDir:         "/var/lib/bosun/archive",
+		Interval:           Duration{time.Hour}, // Default
+	})
+	defaultRetries, noRetries := 3, 0
+	assert.Equal(t, sc.Webhooks, []*Webhook{{
+		Name:         "tickets",
+		URL:          "https://tickets.example.com/bosun",
+		Events:       []string{"open", "escalate", "close"},
+		Secret:       "aSecret",
+		Retries:      &defaultRetries, // Default
+		RetryBackoff: Duration{time.Second * 30},
+	}, {
+		Name:         "audit",
+		URL:          "https://audit.example.com/bosun",
+		Retries:      &noRetries,
+		RetryBackoff: Duration{time.Minute}, // Default
+	}})
 	assert.Equal(t, sc.SMTPConf, SMTPConf{
 		EmailFrom: "bosun@example.com",
 		Host:      "mail.example.com",
@@ -61,3 +94,26 @@ func TestSystemToml(t *testing.T) {
 		UnsafeSSL: true,
 	})
 }
+
+func TestSystemSQLDriver(t *testing.T) {
+	load := func(driver string) error {
+		_, err := loadSystemConfig("[DBConf]\nSQLDataSource = \"bosun.db\"\nSQLDriver = \""+driver+"\"\n", false)
+		return err
+	}
+	if err := load(""); err != nil {
+		t.Errorf("default driver: %v", err)
+	}
+	if err := load("postgres"); err != nil {
+		t.Errorf("postgres: %v", err)
+	}
+	if err := load("mysql"); err == nil {
+		t.Error("expected an error for an unknown driver")
+	}
+	err := load("sqlite3")
+	if database.CheckSQLDriver("sqlite3") == nil && err != nil {
+		t.Errorf("sqlite3: %v", err)
+	}
+	if database.CheckSQLDriver("sqlite3") != nil && err == nil {
+		t.Error("expected an error for sqlite3 without cgo")
+	}
+}
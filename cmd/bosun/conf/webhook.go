@@ -0,0 +1,162 @@
+package conf
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// The incident events posted to webhooks.
+const (
+	WebhookOpen     = "open"
+	WebhookEscalate = "escalate"
+	WebhookAck      = "ack"
+	WebhookClose    = "close"
+	WebhookForget   = "forget"
+)
+
+var webhookEvents = []string{WebhookOpen, WebhookEscalate, WebhookAck, WebhookClose, WebhookForget}
+
+// defaultWebhookRetries is the number of retries of webhooks that do not set
+// Retries.
+const defaultWebhookRetries = 3
+
+// Webhook is an HTTP endpoint that is posted events of all incidents, as a
+// JSON WebhookPayload.
+type Webhook struct {
+	Name         string
+	URL          string
+	Events       []string // Events to post: all if empty
+	Secret       string   `json:"-"` // Key of the HMAC-SHA256 signature of the body, sent in the X-Bosun-Signature header
+	Retries      *int     // Number of retries of a failed post: 3 if not set
+	RetryBackoff Duration // Time before the first retry, doubled for each other one: 1m if not set
+}
+
+// WebhookPayload is the body of the posts to webhooks.
+type WebhookPayload struct {
+	Event    string
+	Time     time.Time
+	Incident *models.IncidentState
+}
+
+// validate checks the configuration of w.
+func (w *Webhook) validate() error {
+	if w.Name == "" {
+		return fmt.Errorf("webhooks must have a Name")
+	}
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return fmt.Errorf("bad URL of webhook %s: %v", w.Name, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("URL of webhook %s must be http or https", w.Name)
+	}
+events:
+	for _, e := range w.Events {
+		for _, known := range webhookEvents {
+			if e == known {
+				continue events
+			}
+		}
+		return fmt.Errorf("unknown event %s of webhook %s, must be one of %v", e, w.Name, webhookEvents)
+	}
+	if (w.Retries != nil && *w.Retries < 0) || w.RetryBackoff.Duration < 0 {
+		return fmt.Errorf("Retries and RetryBackoff of webhook %s must not be negative", w.Name)
+	}
+	return nil
+}
+
+// setDefaults sets the retries of w that are not configured.
+func (w *Webhook) setDefaults() {
+	if w.Retries == nil {
+		retries := defaultWebhookRetries
+		w.Retries = &retries
+	}
+	if w.RetryBackoff.Duration == 0 {
+		w.RetryBackoff.Duration = time.Minute
+	}
+}
+
+// Wants reports whether event is to be posted to w.
+func (w *Webhook) Wants(event string) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// NewDelivery returns a delivery of event of st to w. It is to be sent with
+// Deliver.
+func (w *Webhook) NewDelivery(event string, st *models.IncidentState) *models.Delivery {
+	now := time.Now().UTC()
+	d := &models.Delivery{
+		Notification: w.Name,
+		Action:       ActionWebhook,
+		AlertKey:     string(st.AlertKey),
+		IncidentId:   st.Id,
+		Subject:      fmt.Sprintf("%s: %s", event, st.Subject),
+		Created:      now,
+		NextAttempt:  now,
+	}
+	b, err := json.Marshal(&WebhookPayload{Event: event, Time: now, Incident: st})
+	if err != nil {
+		slog.Errorln(err)
+	}
+	d.Payload = b
+	return d
+}
+
+// Deliver posts the payload of d to w.
+func (w *Webhook) Deliver(d *models.Delivery, c SystemConfProvider) error {
+	req, err := http.NewRequest("POST", w.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.Secret != "" {
+		req.Header.Set("X-Bosun-Signature", "sha256="+w.Signature(d.Payload))
+	}
+	resp, err := deliveryClient.Do(req)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		return badResponse(ActionWebhook, resp)
+	}
+	slog.Infof("posted %s to webhook %s. Response code %d.", d.Subject, w.Name, resp.StatusCode)
+	return nil
+}
+
+// Signature returns the hex encoded HMAC-SHA256 of body with the secret of w.
+func (w *Webhook) Signature(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(w.Secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// RetryDelay returns how long to wait before the next attempt of a post to w
+// that failed attempts times, and false after its retries.
+func (w *Webhook) RetryDelay(attempts int) (time.Duration, bool) {
+	retries := defaultWebhookRetries
+	if w.Retries != nil {
+		retries = *w.Retries
+	}
+	return retryDelay(retries, w.RetryBackoff.Duration, attempts)
+}
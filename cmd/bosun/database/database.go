@@ -1,6 +1,7 @@
 //Package database implements all persistent data access for bosun.
 //Internally it runs ledisdb locally, but uses a redis client to access all data.
 //Thus it should be able to migrate to a remote redis instance with minimal effort.
+//Alternatively the data may be kept in SQLite or Postgres, see NewSQLDataAccess.
 package database
 
 import (
@@ -16,6 +17,7 @@ import (
 	"github.com/siddontang/ledisdb/config"
 	"github.com/siddontang/ledisdb/server"
 
+	"github.com/captncraig/easyauth/providers/token"
 	"github.com/captncraig/easyauth/providers/token/redisStore"
 )
 
@@ -23,7 +25,6 @@ var SchemaVersion = int64(1)
 
 // Core data access interface for everything sched needs
 type DataAccess interface {
-	RedisConnector
 	Metadata() MetadataDataAccess
 	Configs() ConfigDataAccess
 	Search() SearchDataAccess
@@ -31,6 +32,8 @@ type DataAccess interface {
 	State() StateDataAccess
 	Silence() SilenceDataAccess
 	Notifications() NotificationDataAccess
+	Outbox() OutboxDataAccess
+	Tokens() token.TokenDataAccess
 	Migrate() error
 }
 
@@ -126,6 +129,10 @@ func (d *dataAccess) Get() redis.Conn {
 
 var _ redisStore.Connector = (*dataAccess)(nil) //just a compile time interface check
 
+func (d *dataAccess) Tokens() token.TokenDataAccess {
+	return redisStore.New(d)
+}
+
 //gets name of function that called the currently executing function.
 func myCallerName() string {
 	fpcs := make([]uintptr, 1)
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -17,10 +18,16 @@ pendingNotifications: ZSET timestamp ak:notification
 
 notsByAlert:alert SET of notifications possible per alert. used to clear alerts by alert key
 
+escalations: HASH of ak to json of its escalation state
+
+pendingEscalations: ZSET timestamp ak
+
 */
 
 const (
 	pendingNotificationsKey = "pendingNotifications"
+	escalationsKey          = "escalations"
+	pendingEscalationsKey   = "pendingEscalations"
 )
 
 func notsByAlertKeyKey(ak models.AlertKey) string {
@@ -36,9 +43,21 @@ type NotificationDataAccess interface {
 	//Clear all notifications due on or before a given timestamp. Intended is to use the max returned from GetDueNotifications once you have processed them.
 	ClearNotificationsBefore(time.Time) error
 
+	//Clear all notifications and the escalation of an alert key.
 	ClearNotifications(ak models.AlertKey) error
 
+	//Get the time the next notification or escalation is due.
 	GetNextNotificationTime() (time.Time, error)
+
+	//Save the escalation of an alert key, replacing any it had. It is due at its Due time.
+	SetEscalation(e *models.EscalationState) error
+
+	GetEscalation(ak models.AlertKey) (*models.EscalationState, error)
+
+	//Get escalations that are currently due or past due.
+	GetDueEscalations() ([]*models.EscalationState, error)
+
+	ClearEscalation(ak models.AlertKey) error
 }
 
 func (d *dataAccess) Notifications() NotificationDataAccess {
@@ -96,6 +115,10 @@ func (d *dataAccess) ClearNotifications(ak models.AlertKey) error {
 		return slog.Wrap(err)
 	}
 
+	if err := d.clearEscalation(ak, conn); err != nil {
+		return err
+	}
+
 	if len(nots) == 0 {
 		return nil
 	}
@@ -113,14 +136,99 @@ func (d *dataAccess) GetNextNotificationTime() (time.Time, error) {
 	conn := d.Get()
 	defer conn.Close()
 
-	m, err := redis.Int64Map(conn.Do("ZRANGE", pendingNotificationsKey, 0, 0, "WITHSCORES"))
-	if err != nil {
-		return time.Time{}, slog.Wrap(err)
-	}
 	// default time is one hour from now if no pending notifications exist
 	t := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
-	for _, i := range m {
-		t = time.Unix(i, 0).UTC()
+	for _, key := range []string{pendingNotificationsKey, pendingEscalationsKey} {
+		m, err := redis.Int64Map(conn.Do("ZRANGE", key, 0, 0, "WITHSCORES"))
+		if err != nil {
+			return time.Time{}, slog.Wrap(err)
+		}
+		for _, i := range m {
+			if next := time.Unix(i, 0).UTC(); next.Before(t) {
+				t = next
+			}
+		}
 	}
 	return t, nil
 }
+
+func (d *dataAccess) SetEscalation(e *models.EscalationState) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err := conn.Do("HSET", escalationsKey, e.AlertKey, b); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = conn.Do("ZADD", pendingEscalationsKey, e.Due.UTC().Unix(), e.AlertKey)
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetEscalation(ak models.AlertKey) (*models.EscalationState, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := redis.Bytes(conn.Do("HGET", escalationsKey, ak))
+	if err == redis.ErrNil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	e := &models.EscalationState{}
+	if err := json.Unmarshal(b, e); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return e, nil
+}
+
+func (d *dataAccess) GetDueEscalations() ([]*models.EscalationState, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	aks, err := redis.Strings(conn.Do("ZRANGEBYSCORE", pendingEscalationsKey, 0, time.Now().UTC().Unix()))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	if len(aks) == 0 {
+		return nil, nil
+	}
+	args := []interface{}{escalationsKey}
+	for _, ak := range aks {
+		args = append(args, ak)
+	}
+	jsons, err := redis.ByteSlices(conn.Do("HMGET", args...))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	escalations := []*models.EscalationState{}
+	for _, b := range jsons {
+		if b == nil {
+			continue
+		}
+		e := &models.EscalationState{}
+		if err := json.Unmarshal(b, e); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		escalations = append(escalations, e)
+	}
+	return escalations, nil
+}
+
+func (d *dataAccess) ClearEscalation(ak models.AlertKey) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	return d.clearEscalation(ak, conn)
+}
+
+func (d *dataAccess) clearEscalation(ak models.AlertKey, conn redis.Conn) error {
+	if _, err := conn.Do("HDEL", escalationsKey, ak); err != nil {
+		return slog.Wrap(err)
+	}
+	_, err := conn.Do("ZREM", pendingEscalationsKey, ak)
+	return slog.Wrap(err)
+}
@@ -0,0 +1,234 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+/*
+
+outbox: HASH of delivery id to json of delivery
+
+outboxDue: ZSET of next attempt time to id of deliveries that are pending or retrying
+
+outboxByCreated: ZSET of created time to id of all deliveries. used to list and purge them
+
+outboxByIncident:id: SET of ids of the deliveries of an incident
+
+maxDeliveryId: counter for delivery ids
+
+*/
+
+const (
+	outboxKey          = "outbox"
+	outboxDueKey       = "outboxDue"
+	outboxByCreatedKey = "outboxByCreated"
+)
+
+func outboxByIncidentKey(id int64) string {
+	return fmt.Sprintf("outboxByIncident:%d", id)
+}
+
+type OutboxDataAccess interface {
+	// AddDelivery saves a new delivery and sets its Id.
+	AddDelivery(d *models.Delivery) error
+
+	// UpdateDelivery saves d. It is due at its NextAttempt unless it is done.
+	UpdateDelivery(d *models.Delivery) error
+
+	GetDelivery(id int64) (*models.Delivery, error)
+
+	// Get deliveries that are due at or before a given time.
+	GetDueDeliveries(time.Time) ([]*models.Delivery, error)
+
+	// GetNextDeliveryTime returns the time the next delivery is due, or the
+	// zero time if there is none.
+	GetNextDeliveryTime() (time.Time, error)
+
+	// Get deliveries created at or after a given time, oldest first.
+	ListDeliveries(since time.Time) ([]*models.Delivery, error)
+
+	GetIncidentDeliveries(incidentId int64) ([]*models.Delivery, error)
+
+	// Delete deliveries that are done and were created before a given time.
+	PurgeDeliveries(before time.Time) error
+}
+
+func (d *dataAccess) Outbox() OutboxDataAccess {
+	return d
+}
+
+func (d *dataAccess) AddDelivery(del *models.Delivery) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	id, err := redis.Int64(conn.Do("INCR", "maxDeliveryId"))
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	del.Id = id
+	if _, err := conn.Do("ZADD", outboxByCreatedKey, del.Created.UTC().Unix(), id); err != nil {
+		return slog.Wrap(err)
+	}
+	if del.IncidentId != 0 {
+		if _, err := conn.Do("SADD", outboxByIncidentKey(del.IncidentId), id); err != nil {
+			return slog.Wrap(err)
+		}
+	}
+	return d.updateDelivery(del, conn)
+}
+
+func (d *dataAccess) UpdateDelivery(del *models.Delivery) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	return d.updateDelivery(del, conn)
+}
+
+func (d *dataAccess) updateDelivery(del *models.Delivery, conn redis.Conn) error {
+	b, err := json.Marshal(del)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if _, err := conn.Do("HSET", outboxKey, del.Id, b); err != nil {
+		return slog.Wrap(err)
+	}
+	if del.IsDone() {
+		_, err = conn.Do("ZREM", outboxDueKey, del.Id)
+	} else {
+		_, err = conn.Do("ZADD", outboxDueKey, del.NextAttempt.UTC().Unix(), del.Id)
+	}
+	return slog.Wrap(err)
+}
+
+func (d *dataAccess) GetDelivery(id int64) (*models.Delivery, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	b, err := redis.Bytes(conn.Do("HGET", outboxKey, id))
+	if err == redis.ErrNil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	del := &models.Delivery{}
+	if err := json.Unmarshal(b, del); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return del, nil
+}
+
+func (d *dataAccess) GetDueDeliveries(t time.Time) ([]*models.Delivery, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	ids, err := int64s(conn.Do("ZRANGEBYSCORE", outboxDueKey, "-inf", t.UTC().Unix()))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return getDeliveries(ids, conn)
+}
+
+func (d *dataAccess) GetNextDeliveryTime() (time.Time, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	m, err := redis.Int64Map(conn.Do("ZRANGE", outboxDueKey, 0, 0, "WITHSCORES"))
+	if err != nil {
+		return time.Time{}, slog.Wrap(err)
+	}
+	var t time.Time
+	for _, i := range m {
+		t = time.Unix(i, 0).UTC()
+	}
+	return t, nil
+}
+
+func (d *dataAccess) ListDeliveries(since time.Time) ([]*models.Delivery, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	ids, err := int64s(conn.Do("ZRANGEBYSCORE", outboxByCreatedKey, since.UTC().Unix(), "+inf"))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return getDeliveries(ids, conn)
+}
+
+func (d *dataAccess) GetIncidentDeliveries(incidentId int64) ([]*models.Delivery, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	ids, err := int64s(conn.Do("SMEMBERS", outboxByIncidentKey(incidentId)))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return getDeliveries(ids, conn)
+}
+
+func (d *dataAccess) PurgeDeliveries(before time.Time) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	ids, err := int64s(conn.Do("ZRANGEBYSCORE", outboxByCreatedKey, "-inf", "("+fmt.Sprint(before.UTC().Unix())))
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	dels, err := getDeliveries(ids, conn)
+	if err != nil {
+		return err
+	}
+	for _, del := range dels {
+		if !del.IsDone() {
+			continue
+		}
+		if _, err := conn.Do("HDEL", outboxKey, del.Id); err != nil {
+			return slog.Wrap(err)
+		}
+		if _, err := conn.Do("ZREM", outboxByCreatedKey, del.Id); err != nil {
+			return slog.Wrap(err)
+		}
+		if del.IncidentId != 0 {
+			if _, err := conn.Do("SREM", outboxByIncidentKey(del.IncidentId), del.Id); err != nil {
+				return slog.Wrap(err)
+			}
+		}
+	}
+	return nil
+}
+
+// getDeliveries returns the deliveries of ids in order. Ids that are missing
+// are skipped.
+func getDeliveries(ids []int64, conn redis.Conn) ([]*models.Delivery, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	args := make([]interface{}, len(ids)+1)
+	args[0] = outboxKey
+	for i, id := range ids {
+		args[i+1] = id
+	}
+	jsons, err := redis.ByteSlices(conn.Do("HMGET", args...))
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	dels := make([]*models.Delivery, 0, len(jsons))
+	for _, b := range jsons {
+		if b == nil {
+			continue
+		}
+		del := &models.Delivery{}
+		if err := json.Unmarshal(b, del); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		dels = append(dels, del)
+	}
+	return dels, nil
+}
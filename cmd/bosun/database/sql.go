@@ -0,0 +1,318 @@
+package database
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"bosun.org/slog"
+
+	"github.com/captncraig/easyauth/providers/token"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+/*
+The SQL data access keeps the same data as the redis one in tables. Most
+objects are stored as json, with the columns they are looked up by next to
+them. Incidents keep their events in a column of their own, so that they can
+be listed without them.
+
+ids - name -> last id given out, for incidents, deliveries and alert errors
+incidents - incidents by id, with their alert key, open flag and event count
+rendered_templates - json encoded RenderedTemplates by incident id
+alert_keys - last touched time and unknown and unevaluated flags by alert key
+silences - json of silences by id, with their end time
+pending_notifications - due time by alert key and notification
+escalations - json of the escalation of an alert key, with its due time
+deliveries - json of deliveries by id. next_attempt is null once done
+failing_alerts, alert_errors - failing alerts, and coalesced error events
+metric_metadata, tag_metadata - metadata, with tag_metadata_index holding each
+	tag of the tag set of a tag metadata, for subset lookups
+search_* - the search data, by the same keys as in redis
+temp_configs, access_tokens - saved configs and auth tokens
+
+Times are unix timestamps.
+*/
+
+// sqlSchemaVersion is the version of the tables of the SQL data access.
+const sqlSchemaVersion = 1
+
+type sqlDataAccess struct {
+	db       *sql.DB
+	postgres bool
+}
+
+// CheckSQLDriver returns an error if driver is not a SQL driver bosun can use:
+// postgres, or sqlite3 if bosun was built with cgo.
+func CheckSQLDriver(driver string) error {
+	switch driver {
+	case "postgres":
+		return nil
+	case "sqlite3":
+		if !sqliteSupported {
+			return fmt.Errorf("SQL driver sqlite3 requires cgo, but bosun was built with CGO_ENABLED=0: use postgres or a cgo build")
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown SQL driver %s: must be postgres or sqlite3", driver)
+}
+
+// NewSQLDataAccess returns a data access that keeps the data in a SQL
+// database. driver is "postgres", the default, or "sqlite3". dataSource is the
+// connection string of a Postgres database, or the file of a SQLite database.
+func NewSQLDataAccess(driver, dataSource string) (DataAccess, error) {
+	if driver == "" {
+		driver = "postgres"
+	}
+	if err := CheckSQLDriver(driver); err != nil {
+		return nil, err
+	}
+	d := &sqlDataAccess{postgres: driver == "postgres"}
+	if !d.postgres {
+		// SQLite locks the whole database to write, so a single connection
+		// avoids busy errors. WAL with normal sync does not sync each write.
+		sep := "?"
+		if strings.Contains(dataSource, "?") {
+			sep = "&"
+		}
+		dataSource += sep + "_busy_timeout=10000&_journal_mode=WAL&_synchronous=NORMAL"
+	}
+	db, err := sql.Open(driver, dataSource)
+	if err != nil {
+		return nil, err
+	}
+	if !d.postgres {
+		db.SetMaxOpenConns(1)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	d.db = db
+	return d, nil
+}
+
+// Close closes the database.
+func (d *sqlDataAccess) Close() error {
+	return d.db.Close()
+}
+
+// q returns query, written with ? placeholders, in the dialect of the
+// database: postgres numbers them.
+func (d *sqlDataAccess) q(query string) string {
+	if !d.postgres {
+		return query
+	}
+	var b bytes.Buffer
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// exec runs a statement outside of a transaction.
+func (d *sqlDataAccess) exec(query string, args ...interface{}) (sql.Result, error) {
+	r, err := d.db.Exec(d.q(query), args...)
+	return r, slog.Wrap(err)
+}
+
+// transact runs f in a transaction, which is committed if f succeeds.
+func (d *sqlDataAccess) transact(f func(tx *sql.Tx) error) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	if err := f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return slog.Wrap(tx.Commit())
+}
+
+// nextId returns the next id of name, which is above any id given to
+// setMinId.
+func (d *sqlDataAccess) nextId(tx *sql.Tx, name string) (int64, error) {
+	if err := d.setMinId(tx, name, 0); err != nil {
+		return 0, err
+	}
+	if _, err := tx.Exec(d.q("UPDATE ids SET value = value + 1 WHERE name = ?"), name); err != nil {
+		return 0, slog.Wrap(err)
+	}
+	var id int64
+	err := tx.QueryRow(d.q("SELECT value FROM ids WHERE name = ?"), name).Scan(&id)
+	return id, slog.Wrap(err)
+}
+
+// setMinId makes sure the ids given out for name are above id.
+func (d *sqlDataAccess) setMinId(tx *sql.Tx, name string, id int64) error {
+	_, err := tx.Exec(d.q(`INSERT INTO ids (name, value) VALUES (?, ?)
+		ON CONFLICT (name) DO UPDATE SET value = excluded.value WHERE ids.value < excluded.value`), name, id)
+	return slog.Wrap(err)
+}
+
+// boolInt returns b as the integer it is stored as.
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+var sqlTables = []string{
+	`CREATE TABLE IF NOT EXISTS schema_version (version BIGINT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS ids (name TEXT PRIMARY KEY, value BIGINT NOT NULL)`,
+
+	`CREATE TABLE IF NOT EXISTS incidents (
+		id BIGINT PRIMARY KEY,
+		alert_key TEXT NOT NULL,
+		alert TEXT NOT NULL,
+		open INTEGER NOT NULL,
+		num_events INTEGER NOT NULL,
+		state TEXT NOT NULL,
+		events TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS incidents_alert_key ON incidents (alert_key, id)`,
+	`CREATE INDEX IF NOT EXISTS incidents_open ON incidents (open)`,
+	`CREATE TABLE IF NOT EXISTS rendered_templates (incident_id BIGINT PRIMARY KEY, templates TEXT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS alert_keys (
+		alert_key TEXT PRIMARY KEY,
+		alert TEXT NOT NULL,
+		touched BIGINT,
+		unknown INTEGER NOT NULL DEFAULT 0,
+		unevaluated INTEGER NOT NULL DEFAULT 0
+	)`,
+	`CREATE INDEX IF NOT EXISTS alert_keys_alert ON alert_keys (alert, touched)`,
+
+	`CREATE TABLE IF NOT EXISTS silences (id TEXT PRIMARY KEY, end_time BIGINT NOT NULL, silence TEXT NOT NULL)`,
+	`CREATE INDEX IF NOT EXISTS silences_end_time ON silences (end_time)`,
+
+	`CREATE TABLE IF NOT EXISTS pending_notifications (
+		alert_key TEXT NOT NULL,
+		notification TEXT NOT NULL,
+		due BIGINT NOT NULL,
+		PRIMARY KEY (alert_key, notification)
+	)`,
+	`CREATE INDEX IF NOT EXISTS pending_notifications_due ON pending_notifications (due)`,
+	`CREATE TABLE IF NOT EXISTS escalations (alert_key TEXT PRIMARY KEY, due BIGINT NOT NULL, escalation TEXT NOT NULL)`,
+	`CREATE INDEX IF NOT EXISTS escalations_due ON escalations (due)`,
+
+	`CREATE TABLE IF NOT EXISTS deliveries (
+		id BIGINT PRIMARY KEY,
+		created BIGINT NOT NULL,
+		incident_id BIGINT NOT NULL,
+		next_attempt BIGINT,
+		delivery TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS deliveries_created ON deliveries (created)`,
+	`CREATE INDEX IF NOT EXISTS deliveries_incident_id ON deliveries (incident_id)`,
+	`CREATE INDEX IF NOT EXISTS deliveries_next_attempt ON deliveries (next_attempt)`,
+
+	`CREATE TABLE IF NOT EXISTS failing_alerts (alert TEXT PRIMARY KEY)`,
+	`CREATE TABLE IF NOT EXISTS alert_errors (
+		id BIGINT PRIMARY KEY,
+		alert TEXT NOT NULL,
+		first_time BIGINT NOT NULL,
+		last_time BIGINT NOT NULL,
+		count INTEGER NOT NULL,
+		message TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS alert_errors_alert ON alert_errors (alert, id)`,
+
+	`CREATE TABLE IF NOT EXISTS metric_metadata (
+		metric TEXT PRIMARY KEY,
+		description TEXT NOT NULL DEFAULT '',
+		unit TEXT NOT NULL DEFAULT '',
+		rate TEXT NOT NULL DEFAULT '',
+		last_touched BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS tag_metadata (
+		tags TEXT NOT NULL,
+		name TEXT NOT NULL,
+		value TEXT NOT NULL,
+		last_touched BIGINT NOT NULL,
+		PRIMARY KEY (tags, name)
+	)`,
+	`CREATE TABLE IF NOT EXISTS tag_metadata_index (
+		tag_key TEXT NOT NULL,
+		tag_value TEXT NOT NULL,
+		tags TEXT NOT NULL,
+		name TEXT NOT NULL,
+		PRIMARY KEY (tag_key, tag_value, tags, name)
+	)`,
+
+	`CREATE TABLE IF NOT EXISTS search_metrics (metric TEXT PRIMARY KEY, last_seen BIGINT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS search_tag_metrics (
+		tag_key TEXT NOT NULL,
+		tag_value TEXT NOT NULL,
+		metric TEXT NOT NULL,
+		last_seen BIGINT NOT NULL,
+		PRIMARY KEY (tag_key, tag_value, metric)
+	)`,
+	`CREATE TABLE IF NOT EXISTS search_metric_tag_keys (
+		metric TEXT NOT NULL,
+		tag_key TEXT NOT NULL,
+		last_seen BIGINT NOT NULL,
+		PRIMARY KEY (metric, tag_key)
+	)`,
+	`CREATE TABLE IF NOT EXISTS search_tag_values (
+		metric TEXT NOT NULL,
+		tag_key TEXT NOT NULL,
+		tag_value TEXT NOT NULL,
+		last_seen BIGINT NOT NULL,
+		PRIMARY KEY (metric, tag_key, tag_value)
+	)`,
+	`CREATE TABLE IF NOT EXISTS search_tag_sets (
+		metric TEXT NOT NULL,
+		tag_set TEXT NOT NULL,
+		last_seen BIGINT NOT NULL,
+		PRIMARY KEY (metric, tag_set)
+	)`,
+	`CREATE TABLE IF NOT EXISTS search_last_infos (id INTEGER PRIMARY KEY, last_infos TEXT NOT NULL)`,
+
+	`CREATE TABLE IF NOT EXISTS temp_configs (hash TEXT PRIMARY KEY, config TEXT NOT NULL, expires BIGINT NOT NULL)`,
+	`CREATE TABLE IF NOT EXISTS access_tokens (hash TEXT PRIMARY KEY, token TEXT NOT NULL, last_used BIGINT)`,
+}
+
+// Migrate creates the tables that do not exist yet.
+func (d *sqlDataAccess) Migrate() error {
+	slog.Infoln("checking migrations")
+	return d.transact(func(tx *sql.Tx) error {
+		for _, t := range sqlTables {
+			if _, err := tx.Exec(t); err != nil {
+				return slog.Wrap(err)
+			}
+		}
+		var version int64
+		err := tx.QueryRow("SELECT version FROM schema_version").Scan(&version)
+		if err == sql.ErrNoRows {
+			_, err = tx.Exec(d.q("INSERT INTO schema_version (version) VALUES (?)"), sqlSchemaVersion)
+			return slog.Wrap(err)
+		}
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		if version > sqlSchemaVersion {
+			return fmt.Errorf("database schema version %d is newer than %d, the version of this bosun", version, sqlSchemaVersion)
+		}
+		return nil
+	})
+}
+
+func (d *sqlDataAccess) Metadata() MetadataDataAccess          { return d }
+func (d *sqlDataAccess) Configs() ConfigDataAccess             { return d }
+func (d *sqlDataAccess) Search() SearchDataAccess              { return d }
+func (d *sqlDataAccess) Errors() ErrorDataAccess               { return d }
+func (d *sqlDataAccess) State() StateDataAccess                { return d }
+func (d *sqlDataAccess) Silence() SilenceDataAccess            { return d }
+func (d *sqlDataAccess) Notifications() NotificationDataAccess { return d }
+func (d *sqlDataAccess) Outbox() OutboxDataAccess              { return d }
+func (d *sqlDataAccess) Tokens() token.TokenDataAccess         { return d }
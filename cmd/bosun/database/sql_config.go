@@ -0,0 +1,100 @@
+package database
+
+import (
+	"crypto/md5"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"bosun.org/slog"
+
+	"github.com/captncraig/easyauth/providers/token"
+)
+
+func (d *sqlDataAccess) SaveTempConfig(text string) (string, error) {
+	sig := md5.Sum([]byte(text))
+	b64 := base64.StdEncoding.EncodeToString(sig[0:8])
+	now := time.Now().UTC().Unix()
+	err := d.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(d.q("DELETE FROM temp_configs WHERE expires < ?"), now); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err := tx.Exec(d.q(`INSERT INTO temp_configs (hash, config, expires) VALUES (?, ?, ?)
+			ON CONFLICT (hash) DO UPDATE SET config = excluded.config, expires = excluded.expires`), b64, text, now+configLifetime)
+		return slog.Wrap(err)
+	})
+	return b64, err
+}
+
+func (d *sqlDataAccess) GetTempConfig(hash string) (string, error) {
+	now := time.Now().UTC().Unix()
+	var text string
+	err := d.db.QueryRow(d.q("SELECT config FROM temp_configs WHERE hash = ? AND expires >= ?"), hash, now).Scan(&text)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("temp config %s not found", hash)
+	}
+	if err != nil {
+		return "", slog.Wrap(err)
+	}
+	_, err = d.exec("UPDATE temp_configs SET expires = ? WHERE hash = ?", now+configLifetime, hash)
+	return text, err
+}
+
+func (d *sqlDataAccess) LookupToken(hash string) (*token.Token, error) {
+	var val string
+	err := d.db.QueryRow(d.q("SELECT token FROM access_tokens WHERE hash = ?"), hash).Scan(&val)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("Token not found")
+	}
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	tok := &token.Token{}
+	if err = json.Unmarshal([]byte(val), tok); err != nil {
+		return nil, err
+	}
+	d.exec("UPDATE access_tokens SET last_used = ? WHERE hash = ?", time.Now().UTC().Unix(), hash) //eat failure here
+	return tok, nil
+}
+
+func (d *sqlDataAccess) StoreToken(t *token.Token) error {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	_, err = d.exec(`INSERT INTO access_tokens (hash, token) VALUES (?, ?)
+		ON CONFLICT (hash) DO UPDATE SET token = excluded.token`, t.Hash, string(data))
+	return err
+}
+
+func (d *sqlDataAccess) RevokeToken(hash string) error {
+	_, err := d.exec("DELETE FROM access_tokens WHERE hash = ?", hash)
+	return err
+}
+
+func (d *sqlDataAccess) ListTokens() ([]*token.Token, error) {
+	rows, err := d.db.Query("SELECT token, last_used FROM access_tokens")
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	toks := []*token.Token{}
+	for rows.Next() {
+		var tok string
+		var lastUsed sql.NullInt64
+		if err := rows.Scan(&tok, &lastUsed); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		t := &token.Token{}
+		if err = json.Unmarshal([]byte(tok), t); err != nil {
+			return nil, err
+		}
+		if lastUsed.Valid {
+			t.LastUsed = time.Unix(lastUsed.Int64, 0).UTC()
+		}
+		toks = append(toks, t)
+	}
+	return toks, slog.Wrap(rows.Err())
+}
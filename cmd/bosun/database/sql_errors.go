@@ -0,0 +1,126 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+func (d *sqlDataAccess) MarkAlertSuccess(name string) error {
+	_, err := d.exec("DELETE FROM failing_alerts WHERE alert = ?", name)
+	return err
+}
+
+// MarkAlertFailure records an error of an alert. Consecutive failures with the
+// same message are coalesced into one error event.
+func (d *sqlDataAccess) MarkAlertFailure(name string, msg string) error {
+	now := time.Now().UTC().Truncate(time.Second).Unix()
+	return d.transact(func(tx *sql.Tx) error {
+		var failing int
+		if err := tx.QueryRow(d.q("SELECT COUNT(*) FROM failing_alerts WHERE alert = ?"), name).Scan(&failing); err != nil {
+			return slog.Wrap(err)
+		}
+		if failing == 0 {
+			if _, err := tx.Exec(d.q("INSERT INTO failing_alerts (alert) VALUES (?)"), name); err != nil {
+				return slog.Wrap(err)
+			}
+		} else {
+			var id int64
+			var last string
+			err := tx.QueryRow(d.q("SELECT id, message FROM alert_errors WHERE alert = ? ORDER BY id DESC LIMIT 1"), name).Scan(&id, &last)
+			if err != nil && err != sql.ErrNoRows {
+				return slog.Wrap(err)
+			}
+			if err == nil && last == msg {
+				_, err = tx.Exec(d.q("UPDATE alert_errors SET count = count + 1, last_time = ? WHERE id = ?"), now, id)
+				return slog.Wrap(err)
+			}
+		}
+		id, err := d.nextId(tx, "alert_errors")
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(d.q("INSERT INTO alert_errors (id, alert, first_time, last_time, count, message) VALUES (?, ?, ?, ?, 1, ?)"),
+			id, name, now, now, msg)
+		return slog.Wrap(err)
+	})
+}
+
+func (d *sqlDataAccess) GetFailingAlertCounts() (int, int, error) {
+	var failing, events int
+	if err := d.db.QueryRow("SELECT COUNT(*) FROM failing_alerts").Scan(&failing); err != nil {
+		return 0, 0, slog.Wrap(err)
+	}
+	if err := d.db.QueryRow("SELECT COALESCE(SUM(count), 0) FROM alert_errors").Scan(&events); err != nil {
+		return 0, 0, slog.Wrap(err)
+	}
+	return failing, events, nil
+}
+
+func (d *sqlDataAccess) GetFailingAlerts() (map[string]bool, error) {
+	rows, err := d.db.Query("SELECT alert FROM failing_alerts")
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	r := map[string]bool{}
+	for rows.Next() {
+		var a string
+		if err := rows.Scan(&a); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		r[a] = true
+	}
+	return r, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) IsAlertFailing(name string) (bool, error) {
+	var failing int
+	err := d.db.QueryRow(d.q("SELECT COUNT(*) FROM failing_alerts WHERE alert = ?"), name).Scan(&failing)
+	return failing > 0, slog.Wrap(err)
+}
+
+// GetFullErrorHistory returns the error events of each alert, most recent
+// first.
+func (d *sqlDataAccess) GetFullErrorHistory() (map[string][]*models.AlertError, error) {
+	rows, err := d.db.Query("SELECT alert, first_time, last_time, count, message FROM alert_errors ORDER BY alert, id DESC")
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	results := map[string][]*models.AlertError{}
+	for rows.Next() {
+		var alert string
+		var first, last int64
+		ae := &models.AlertError{}
+		if err := rows.Scan(&alert, &first, &last, &ae.Count, &ae.Message); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		ae.FirstTime = time.Unix(first, 0).UTC()
+		ae.LastTime = time.Unix(last, 0).UTC()
+		results[alert] = append(results[alert], ae)
+	}
+	return results, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) ClearAlert(name string) error {
+	return d.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(d.q("DELETE FROM failing_alerts WHERE alert = ?"), name); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err := tx.Exec(d.q("DELETE FROM alert_errors WHERE alert = ?"), name)
+		return slog.Wrap(err)
+	})
+}
+
+func (d *sqlDataAccess) ClearAll() error {
+	return d.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec("DELETE FROM failing_alerts"); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err := tx.Exec("DELETE FROM alert_errors")
+		return slog.Wrap(err)
+	})
+}
@@ -0,0 +1,280 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+func (d *sqlDataAccess) InsertNotification(ak models.AlertKey, notification string, dueAt time.Time) error {
+	_, err := d.exec(`INSERT INTO pending_notifications (alert_key, notification, due) VALUES (?, ?, ?)
+		ON CONFLICT (alert_key, notification) DO UPDATE SET due = excluded.due`, string(ak), notification, dueAt.UTC().Unix())
+	return err
+}
+
+func (d *sqlDataAccess) GetDueNotifications() (map[models.AlertKey]map[string]time.Time, error) {
+	rows, err := d.db.Query(d.q("SELECT alert_key, notification, due FROM pending_notifications WHERE due <= ?"), time.Now().UTC().Unix())
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	results := map[models.AlertKey]map[string]time.Time{}
+	for rows.Next() {
+		var ak, not string
+		var due int64
+		if err := rows.Scan(&ak, &not, &due); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		if results[models.AlertKey(ak)] == nil {
+			results[models.AlertKey(ak)] = map[string]time.Time{}
+		}
+		results[models.AlertKey(ak)][not] = time.Unix(due, 0).UTC()
+	}
+	return results, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) ClearNotificationsBefore(t time.Time) error {
+	_, err := d.exec("DELETE FROM pending_notifications WHERE due <= ?", t.UTC().Unix())
+	return err
+}
+
+func (d *sqlDataAccess) ClearNotifications(ak models.AlertKey) error {
+	return d.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(d.q("DELETE FROM escalations WHERE alert_key = ?"), string(ak)); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err := tx.Exec(d.q("DELETE FROM pending_notifications WHERE alert_key = ?"), string(ak))
+		return slog.Wrap(err)
+	})
+}
+
+func (d *sqlDataAccess) GetNextNotificationTime() (time.Time, error) {
+	// default time is one hour from now if no pending notifications exist
+	t := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	for _, table := range []string{"pending_notifications", "escalations"} {
+		var due sql.NullInt64
+		if err := d.db.QueryRow("SELECT MIN(due) FROM " + table).Scan(&due); err != nil {
+			return time.Time{}, slog.Wrap(err)
+		}
+		if !due.Valid {
+			continue
+		}
+		if next := time.Unix(due.Int64, 0).UTC(); next.Before(t) {
+			t = next
+		}
+	}
+	return t, nil
+}
+
+func (d *sqlDataAccess) SetEscalation(e *models.EscalationState) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = d.exec(`INSERT INTO escalations (alert_key, due, escalation) VALUES (?, ?, ?)
+		ON CONFLICT (alert_key) DO UPDATE SET due = excluded.due, escalation = excluded.escalation`,
+		string(e.AlertKey), e.Due.UTC().Unix(), string(b))
+	return err
+}
+
+func (d *sqlDataAccess) GetEscalation(ak models.AlertKey) (*models.EscalationState, error) {
+	var b []byte
+	err := d.db.QueryRow(d.q("SELECT escalation FROM escalations WHERE alert_key = ?"), string(ak)).Scan(&b)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	e := &models.EscalationState{}
+	if err := json.Unmarshal(b, e); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return e, nil
+}
+
+func (d *sqlDataAccess) GetDueEscalations() ([]*models.EscalationState, error) {
+	rows, err := d.db.Query(d.q("SELECT escalation FROM escalations WHERE due <= ? ORDER BY due"), time.Now().UTC().Unix())
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	var escalations []*models.EscalationState
+	for rows.Next() {
+		var b []byte
+		if err := rows.Scan(&b); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		e := &models.EscalationState{}
+		if err := json.Unmarshal(b, e); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		escalations = append(escalations, e)
+	}
+	return escalations, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) ClearEscalation(ak models.AlertKey) error {
+	_, err := d.exec("DELETE FROM escalations WHERE alert_key = ?", string(ak))
+	return err
+}
+
+func (d *sqlDataAccess) AddDelivery(del *models.Delivery) error {
+	return d.transact(func(tx *sql.Tx) error {
+		id, err := d.nextId(tx, "deliveries")
+		if err != nil {
+			return err
+		}
+		del.Id = id
+		return d.saveDelivery(tx, del)
+	})
+}
+
+func (d *sqlDataAccess) UpdateDelivery(del *models.Delivery) error {
+	return d.transact(func(tx *sql.Tx) error {
+		return d.saveDelivery(tx, del)
+	})
+}
+
+// saveDelivery saves del, which is due at its NextAttempt unless it is done.
+func (d *sqlDataAccess) saveDelivery(tx *sql.Tx, del *models.Delivery) error {
+	b, err := json.Marshal(del)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	var next sql.NullInt64
+	if !del.IsDone() {
+		next = sql.NullInt64{Int64: del.NextAttempt.UTC().Unix(), Valid: true}
+	}
+	_, err = tx.Exec(d.q(`INSERT INTO deliveries (id, created, incident_id, next_attempt, delivery) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET next_attempt = excluded.next_attempt, delivery = excluded.delivery`),
+		del.Id, del.Created.UTC().Unix(), del.IncidentId, next, string(b))
+	return slog.Wrap(err)
+}
+
+// queryDeliveries returns the deliveries of a query selecting them.
+func (d *sqlDataAccess) queryDeliveries(query string, args ...interface{}) ([]*models.Delivery, error) {
+	rows, err := d.db.Query(d.q(query), args...)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	var dels []*models.Delivery
+	for rows.Next() {
+		var b []byte
+		if err := rows.Scan(&b); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		del := &models.Delivery{}
+		if err := json.Unmarshal(b, del); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		dels = append(dels, del)
+	}
+	return dels, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) GetDelivery(id int64) (*models.Delivery, error) {
+	dels, err := d.queryDeliveries("SELECT delivery FROM deliveries WHERE id = ?", id)
+	if err != nil || len(dels) == 0 {
+		return nil, err
+	}
+	return dels[0], nil
+}
+
+func (d *sqlDataAccess) GetDueDeliveries(t time.Time) ([]*models.Delivery, error) {
+	return d.queryDeliveries("SELECT delivery FROM deliveries WHERE next_attempt <= ? ORDER BY next_attempt, id", t.UTC().Unix())
+}
+
+func (d *sqlDataAccess) GetNextDeliveryTime() (time.Time, error) {
+	var next sql.NullInt64
+	if err := d.db.QueryRow("SELECT MIN(next_attempt) FROM deliveries").Scan(&next); err != nil {
+		return time.Time{}, slog.Wrap(err)
+	}
+	if !next.Valid {
+		return time.Time{}, nil
+	}
+	return time.Unix(next.Int64, 0).UTC(), nil
+}
+
+func (d *sqlDataAccess) ListDeliveries(since time.Time) ([]*models.Delivery, error) {
+	return d.queryDeliveries("SELECT delivery FROM deliveries WHERE created >= ? ORDER BY created, id", since.UTC().Unix())
+}
+
+func (d *sqlDataAccess) GetIncidentDeliveries(incidentId int64) ([]*models.Delivery, error) {
+	return d.queryDeliveries("SELECT delivery FROM deliveries WHERE incident_id = ? ORDER BY id", incidentId)
+}
+
+func (d *sqlDataAccess) PurgeDeliveries(before time.Time) error {
+	_, err := d.exec("DELETE FROM deliveries WHERE created < ? AND next_attempt IS NULL", before.UTC().Unix())
+	return err
+}
+
+// querySilences returns the silences of a query selecting them.
+func (d *sqlDataAccess) querySilences(query string, args ...interface{}) ([]*models.Silence, error) {
+	rows, err := d.db.Query(d.q(query), args...)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	var silences []*models.Silence
+	for rows.Next() {
+		var b []byte
+		if err := rows.Scan(&b); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		s := &models.Silence{}
+		if err := json.Unmarshal(b, s); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		silences = append(silences, s)
+	}
+	return silences, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) GetActiveSilences() ([]*models.Silence, error) {
+	now := time.Now().UTC()
+	silences, err := d.querySilences("SELECT silence FROM silences WHERE end_time >= ?", now.Unix())
+	if err != nil || len(silences) == 0 {
+		return nil, err
+	}
+	filtered := make([]*models.Silence, 0, len(silences))
+	for _, s := range silences {
+		if s.Start.After(now) {
+			continue
+		}
+		filtered = append(filtered, s)
+	}
+	return filtered, nil
+}
+
+func (d *sqlDataAccess) AddSilence(s *models.Silence) error {
+	dat, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	_, err = d.exec(`INSERT INTO silences (id, end_time, silence) VALUES (?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET end_time = excluded.end_time, silence = excluded.silence`,
+		s.ID(), s.End.UTC().Unix(), string(dat))
+	return err
+}
+
+func (d *sqlDataAccess) DeleteSilence(id string) error {
+	_, err := d.exec("DELETE FROM silences WHERE id = ?", id)
+	return err
+}
+
+func (d *sqlDataAccess) ListSilences(endingAfter int64) (map[string]*models.Silence, error) {
+	silences, err := d.querySilences("SELECT silence FROM silences WHERE end_time >= ?", endingAfter)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]*models.Silence, len(silences))
+	for _, s := range silences {
+		m[s.ID()] = s
+	}
+	return m, nil
+}
@@ -0,0 +1,217 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+)
+
+// metricMetaColumns maps the metric metadata fields to their columns.
+var metricMetaColumns = map[string]string{
+	"desc": "description",
+	"unit": "unit",
+	"rate": "rate",
+}
+
+func (d *sqlDataAccess) PutMetricMetadata(metric string, field string, value string) error {
+	col, ok := metricMetaColumns[field]
+	if !ok {
+		return fmt.Errorf("Unknown metric metadata field: %s", field)
+	}
+	_, err := d.exec(`INSERT INTO metric_metadata (metric, `+col+`, last_touched) VALUES (?, ?, ?)
+		ON CONFLICT (metric) DO UPDATE SET `+col+` = excluded.`+col+`, last_touched = excluded.last_touched`,
+		metric, value, time.Now().UTC().Unix())
+	return err
+}
+
+func (d *sqlDataAccess) GetMetricMetadata(metric string) (*MetricMetadata, error) {
+	mm := &MetricMetadata{}
+	err := d.db.QueryRow(d.q("SELECT description, unit, rate, last_touched FROM metric_metadata WHERE metric = ?"), metric).
+		Scan(&mm.Desc, &mm.Unit, &mm.Rate, &mm.LastTouched)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return mm, nil
+}
+
+func (d *sqlDataAccess) PutTagMetadata(tags opentsdb.TagSet, name string, value string, updated time.Time) error {
+	key := tags.Tags()
+	return d.transact(func(tx *sql.Tx) error {
+		_, err := tx.Exec(d.q(`INSERT INTO tag_metadata (tags, name, value, last_touched) VALUES (?, ?, ?, ?)
+			ON CONFLICT (tags, name) DO UPDATE SET value = excluded.value, last_touched = excluded.last_touched`),
+			key, name, value, updated.UTC().Unix())
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		for tagK, tagV := range tags {
+			_, err := tx.Exec(d.q(`INSERT INTO tag_metadata_index (tag_key, tag_value, tags, name) VALUES (?, ?, ?, ?)
+				ON CONFLICT (tag_key, tag_value, tags, name) DO NOTHING`), tagK, tagV, key, name)
+			if err != nil {
+				return slog.Wrap(err)
+			}
+		}
+		return nil
+	})
+}
+
+func (d *sqlDataAccess) DeleteTagMetadata(tags opentsdb.TagSet, name string) error {
+	key := tags.Tags()
+	return d.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(d.q("DELETE FROM tag_metadata WHERE tags = ? AND name = ?"), key, name); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err := tx.Exec(d.q("DELETE FROM tag_metadata_index WHERE tags = ? AND name = ?"), key, name)
+		return slog.Wrap(err)
+	})
+}
+
+// GetTagMetadata returns the metadata of the tag sets that tags is a subset
+// of: those that have every tag of tags in the index.
+func (d *sqlDataAccess) GetTagMetadata(tags opentsdb.TagSet, name string) ([]*TagMetadata, error) {
+	var where []string
+	var args []interface{}
+	for tagK, tagV := range tags {
+		where = append(where, "(tag_key = ? AND tag_value = ?)")
+		args = append(args, tagK, tagV)
+	}
+	query := "SELECT m.tags, m.name, m.value, m.last_touched FROM tag_metadata m"
+	if len(where) > 0 {
+		query += ` JOIN (SELECT tags, name FROM tag_metadata_index WHERE ` + strings.Join(where, " OR ") + `
+			GROUP BY tags, name HAVING COUNT(*) = ?) i ON m.tags = i.tags AND m.name = i.name`
+		args = append(args, len(where))
+	}
+	if name != "" {
+		query += " WHERE m.name = ?"
+		args = append(args, name)
+	}
+	rows, err := d.db.Query(d.q(query), args...)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	data := []*TagMetadata{}
+	for rows.Next() {
+		var tags string
+		obj := &TagMetadata{}
+		if err := rows.Scan(&tags, &obj.Name, &obj.Value, &obj.LastTouched); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		if obj.Tags, err = opentsdb.ParseTags(tags); err != nil {
+			return nil, err
+		}
+		data = append(data, obj)
+	}
+	return data, slog.Wrap(rows.Err())
+}
+
+// queryTimes returns the map of name to time of a query selecting them.
+func (d *sqlDataAccess) queryTimes(query string, args ...interface{}) (map[string]int64, error) {
+	rows, err := d.db.Query(d.q(query), args...)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	result := make(map[string]int64)
+	for rows.Next() {
+		var name string
+		var t int64
+		if err := rows.Scan(&name, &t); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		result[name] = t
+	}
+	return result, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) AddMetricForTag(tagK, tagV, metric string, time int64) error {
+	_, err := d.exec(`INSERT INTO search_tag_metrics (tag_key, tag_value, metric, last_seen) VALUES (?, ?, ?, ?)
+		ON CONFLICT (tag_key, tag_value, metric) DO UPDATE SET last_seen = excluded.last_seen`, tagK, tagV, metric, time)
+	return err
+}
+
+func (d *sqlDataAccess) GetMetricsForTag(tagK, tagV string) (map[string]int64, error) {
+	return d.queryTimes("SELECT metric, last_seen FROM search_tag_metrics WHERE tag_key = ? AND tag_value = ?", tagK, tagV)
+}
+
+func (d *sqlDataAccess) AddTagKeyForMetric(metric, tagK string, time int64) error {
+	_, err := d.exec(`INSERT INTO search_metric_tag_keys (metric, tag_key, last_seen) VALUES (?, ?, ?)
+		ON CONFLICT (metric, tag_key) DO UPDATE SET last_seen = excluded.last_seen`, metric, tagK, time)
+	return err
+}
+
+func (d *sqlDataAccess) GetTagKeysForMetric(metric string) (map[string]int64, error) {
+	return d.queryTimes("SELECT tag_key, last_seen FROM search_metric_tag_keys WHERE metric = ?", metric)
+}
+
+func (d *sqlDataAccess) AddMetric(metric string, time int64) error {
+	_, err := d.exec(`INSERT INTO search_metrics (metric, last_seen) VALUES (?, ?)
+		ON CONFLICT (metric) DO UPDATE SET last_seen = excluded.last_seen`, metric, time)
+	return err
+}
+
+func (d *sqlDataAccess) GetAllMetrics() (map[string]int64, error) {
+	return d.queryTimes("SELECT metric, last_seen FROM search_metrics")
+}
+
+func (d *sqlDataAccess) AddTagValue(metric, tagK, tagV string, time int64) error {
+	_, err := d.exec(`INSERT INTO search_tag_values (metric, tag_key, tag_value, last_seen) VALUES (?, ?, ?, ?)
+		ON CONFLICT (metric, tag_key, tag_value) DO UPDATE SET last_seen = excluded.last_seen`, metric, tagK, tagV, time)
+	return err
+}
+
+func (d *sqlDataAccess) GetTagValues(metric, tagK string) (map[string]int64, error) {
+	return d.queryTimes("SELECT tag_value, last_seen FROM search_tag_values WHERE metric = ? AND tag_key = ?", metric, tagK)
+}
+
+func (d *sqlDataAccess) AddMetricTagSet(metric, tagSet string, time int64) error {
+	_, err := d.exec(`INSERT INTO search_tag_sets (metric, tag_set, last_seen) VALUES (?, ?, ?)
+		ON CONFLICT (metric, tag_set) DO UPDATE SET last_seen = excluded.last_seen`, metric, tagSet, time)
+	return err
+}
+
+func (d *sqlDataAccess) GetMetricTagSets(metric string, tags opentsdb.TagSet) (map[string]int64, error) {
+	mtss, err := d.queryTimes("SELECT tag_set, last_seen FROM search_tag_sets WHERE metric = ?", metric)
+	if err != nil {
+		return nil, err
+	}
+	for mts := range mtss {
+		ts, err := opentsdb.ParseTags(mts)
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		if !ts.Subset(tags) {
+			delete(mtss, mts)
+		}
+	}
+	return mtss, nil
+}
+
+func (d *sqlDataAccess) BackupLastInfos(m map[string]map[string]*LastInfo) error {
+	dat, err := json.Marshal(m)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = d.exec(`INSERT INTO search_last_infos (id, last_infos) VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE SET last_infos = excluded.last_infos`, string(dat))
+	return err
+}
+
+func (d *sqlDataAccess) LoadLastInfos() (map[string]map[string]*LastInfo, error) {
+	var b []byte
+	if err := d.db.QueryRow("SELECT last_infos FROM search_last_infos WHERE id = 1").Scan(&b); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	var m map[string]map[string]*LastInfo
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return m, nil
+}
@@ -0,0 +1,315 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+const incidentColumns = "state, events"
+
+// scanIncident reads an incident from its state and events columns.
+func scanIncident(row interface {
+	Scan(...interface{}) error
+}) (*models.IncidentState, error) {
+	var state, events []byte
+	if err := row.Scan(&state, &events); err != nil {
+		return nil, err
+	}
+	s := &models.IncidentState{}
+	if err := json.Unmarshal(state, s); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	if err := json.Unmarshal(events, &s.Events); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return s, nil
+}
+
+// queryIncidents returns the incidents of a query selecting incidentColumns.
+func (d *sqlDataAccess) queryIncidents(query string, args ...interface{}) ([]*models.IncidentState, error) {
+	rows, err := d.db.Query(d.q(query), args...)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	var incidents []*models.IncidentState
+	for rows.Next() {
+		s, err := scanIncident(rows)
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		incidents = append(incidents, s)
+	}
+	return incidents, slog.Wrap(rows.Err())
+}
+
+// queryAlertKeys returns the alert keys of a query selecting them.
+func (d *sqlDataAccess) queryAlertKeys(query string, args ...interface{}) ([]models.AlertKey, error) {
+	rows, err := d.db.Query(d.q(query), args...)
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	defer rows.Close()
+	aks := []models.AlertKey{}
+	for rows.Next() {
+		var ak string
+		if err := rows.Scan(&ak); err != nil {
+			return nil, slog.Wrap(err)
+		}
+		aks = append(aks, models.AlertKey(ak))
+	}
+	return aks, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) SetRenderedTemplates(incidentId int64, rt *models.RenderedTemplates) error {
+	data, err := json.Marshal(rt)
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	_, err = d.exec(`INSERT INTO rendered_templates (incident_id, templates) VALUES (?, ?)
+		ON CONFLICT (incident_id) DO UPDATE SET templates = excluded.templates`, incidentId, string(data))
+	return err
+}
+
+func (d *sqlDataAccess) GetRenderedTemplates(incidentId int64) (*models.RenderedTemplates, error) {
+	var b []byte
+	err := d.db.QueryRow(d.q("SELECT templates FROM rendered_templates WHERE incident_id = ?"), incidentId).Scan(&b)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("no rendered templates for incident %d", incidentId)
+	}
+	if err != nil {
+		return nil, slog.Wrap(err)
+	}
+	renderedT := &models.RenderedTemplates{}
+	if err = json.Unmarshal(b, renderedT); err != nil {
+		return nil, slog.Wrap(err)
+	}
+	return renderedT, nil
+}
+
+func (d *sqlDataAccess) DeleteRenderedTemplates(incidentId int64) (bool, error) {
+	r, err := d.exec("DELETE FROM rendered_templates WHERE incident_id = ?", incidentId)
+	if err != nil {
+		return false, err
+	}
+	n, err := r.RowsAffected()
+	return n > 0, slog.Wrap(err)
+}
+
+func (d *sqlDataAccess) TouchAlertKey(ak models.AlertKey, t time.Time) error {
+	_, err := d.exec(`INSERT INTO alert_keys (alert_key, alert, touched) VALUES (?, ?, ?)
+		ON CONFLICT (alert_key) DO UPDATE SET touched = excluded.touched`, string(ak), ak.Name(), t.UTC().Unix())
+	return err
+}
+
+func (d *sqlDataAccess) GetUntouchedSince(alert string, time int64) ([]models.AlertKey, error) {
+	return d.queryAlertKeys("SELECT alert_key FROM alert_keys WHERE alert = ? AND touched <= ? ORDER BY touched", alert, time)
+}
+
+func (d *sqlDataAccess) SetUnevaluated(ak models.AlertKey, uneval bool) error {
+	_, err := d.exec(`INSERT INTO alert_keys (alert_key, alert, unevaluated) VALUES (?, ?, ?)
+		ON CONFLICT (alert_key) DO UPDATE SET unevaluated = excluded.unevaluated`, string(ak), ak.Name(), boolInt(uneval))
+	return err
+}
+
+func (d *sqlDataAccess) GetUnknownAndUnevalAlertKeys(alert string) ([]models.AlertKey, []models.AlertKey, error) {
+	unknown, err := d.queryAlertKeys("SELECT alert_key FROM alert_keys WHERE alert = ? AND unknown = 1", alert)
+	if err != nil {
+		return nil, nil, err
+	}
+	unevals, err := d.queryAlertKeys("SELECT alert_key FROM alert_keys WHERE alert = ? AND unevaluated = 1", alert)
+	if err != nil {
+		return nil, nil, err
+	}
+	return unknown, unevals, nil
+}
+
+func (d *sqlDataAccess) GetOpenIncident(ak models.AlertKey) (*models.IncidentState, error) {
+	inc, err := d.GetLatestIncident(ak)
+	if err != nil || inc == nil || !inc.Open {
+		return nil, err
+	}
+	return inc, nil
+}
+
+func (d *sqlDataAccess) GetLatestIncident(ak models.AlertKey) (*models.IncidentState, error) {
+	row := d.db.QueryRow(d.q("SELECT "+incidentColumns+" FROM incidents WHERE alert_key = ? ORDER BY id DESC LIMIT 1"), string(ak))
+	s, err := scanIncident(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return s, slog.Wrap(err)
+}
+
+func (d *sqlDataAccess) GetAllOpenIncidents() ([]*models.IncidentState, error) {
+	return d.queryIncidents("SELECT " + incidentColumns + " FROM incidents WHERE open = 1")
+}
+
+func (d *sqlDataAccess) GetAllIncidentsByAlertKey(ak models.AlertKey) ([]*models.IncidentState, error) {
+	return d.queryIncidents("SELECT "+incidentColumns+" FROM incidents WHERE alert_key = ? ORDER BY id DESC", string(ak))
+}
+
+// GetIncidentSummaries returns count incidents from start, most recent first,
+// without their events. It also returns the number of incidents.
+func (d *sqlDataAccess) GetIncidentSummaries(start, count int) ([]*models.IncidentSummary, int, error) {
+	var total int
+	if err := d.db.QueryRow("SELECT COUNT(*) FROM incidents").Scan(&total); err != nil {
+		return nil, 0, slog.Wrap(err)
+	}
+	rows, err := d.db.Query(d.q("SELECT state, num_events FROM incidents ORDER BY id DESC LIMIT ? OFFSET ?"), count, start)
+	if err != nil {
+		return nil, 0, slog.Wrap(err)
+	}
+	defer rows.Close()
+	var summaries []*models.IncidentSummary
+	for rows.Next() {
+		var state []byte
+		sum := &models.IncidentSummary{IncidentState: &models.IncidentState{}}
+		if err := rows.Scan(&state, &sum.NumEvents); err != nil {
+			return nil, 0, slog.Wrap(err)
+		}
+		if err := json.Unmarshal(state, sum.IncidentState); err != nil {
+			return nil, 0, slog.Wrap(err)
+		}
+		summaries = append(summaries, sum)
+	}
+	return summaries, total, slog.Wrap(rows.Err())
+}
+
+func (d *sqlDataAccess) GetIncidentState(incidentId int64) (*models.IncidentState, error) {
+	row := d.db.QueryRow(d.q("SELECT "+incidentColumns+" FROM incidents WHERE id = ?"), incidentId)
+	s, err := scanIncident(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("incident %d not found", incidentId)
+	}
+	return s, slog.Wrap(err)
+}
+
+func (d *sqlDataAccess) UpdateIncidentState(s *models.IncidentState) (int64, error) {
+	return d.save(s, false)
+}
+
+func (d *sqlDataAccess) ImportIncidentState(s *models.IncidentState) error {
+	_, err := d.save(s, true)
+	return err
+}
+
+func (d *sqlDataAccess) save(s *models.IncidentState, isImport bool) (int64, error) {
+	// the events are kept apart from the state, so that incidents can be
+	// listed without them.
+	state := *s
+	state.Events = nil
+	events := s.Events
+	if events == nil {
+		events = []models.Event{}
+	}
+	eventData, err := json.Marshal(events)
+	if err != nil {
+		return s.Id, slog.Wrap(err)
+	}
+	err = d.transact(func(tx *sql.Tx) error {
+		//if id is still zero, assign new id.
+		if s.Id == 0 {
+			id, err := d.nextId(tx, "incidents")
+			if err != nil {
+				return err
+			}
+			s.Id = id
+		} else if isImport {
+			if err := d.setMinId(tx, "incidents", s.Id); err != nil {
+				return err
+			}
+		}
+		state.Id = s.Id
+		stateData, err := json.Marshal(&state)
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		_, err = tx.Exec(d.q(`INSERT INTO incidents (id, alert_key, alert, open, num_events, state, events) VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET alert_key = excluded.alert_key, alert = excluded.alert, open = excluded.open,
+			num_events = excluded.num_events, state = excluded.state, events = excluded.events`),
+			s.Id, string(s.AlertKey), s.Alert, boolInt(s.Open), len(events), string(stateData), string(eventData))
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		_, err = tx.Exec(d.q(`INSERT INTO alert_keys (alert_key, alert, unknown, unevaluated) VALUES (?, ?, ?, ?)
+			ON CONFLICT (alert_key) DO UPDATE SET unknown = excluded.unknown, unevaluated = excluded.unevaluated`),
+			string(s.AlertKey), s.AlertKey.Name(), boolInt(s.CurrentStatus == models.StUnknown), boolInt(s.Unevaluated))
+		return slog.Wrap(err)
+	})
+	return s.Id, err
+}
+
+// The nucular option. Delete all we know about this alert key
+func (d *sqlDataAccess) Forget(ak models.AlertKey) error {
+	return d.transact(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(d.q("DELETE FROM alert_keys WHERE alert_key = ?"), string(ak)); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err := tx.Exec(d.q("DELETE FROM incidents WHERE alert_key = ?"), string(ak))
+		return slog.Wrap(err)
+	})
+}
+
+// DeleteIncident deletes an incident and its rendered templates.
+func (d *sqlDataAccess) DeleteIncident(incidentId int64) error {
+	return d.transact(func(tx *sql.Tx) error {
+		r, err := tx.Exec(d.q("DELETE FROM incidents WHERE id = ?"), incidentId)
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		if n, err := r.RowsAffected(); err != nil {
+			return slog.Wrap(err)
+		} else if n == 0 {
+			return fmt.Errorf("incident %d not found", incidentId)
+		}
+		_, err = tx.Exec(d.q("DELETE FROM rendered_templates WHERE incident_id = ?"), incidentId)
+		return slog.Wrap(err)
+	})
+}
+
+// TrimIncidentEvents drops the oldest events of an incident so that it has at
+// most max. It returns the number of events dropped. The incident is locked
+// while its events are trimmed.
+func (d *sqlDataAccess) TrimIncidentEvents(incidentId int64, max int) (int, error) {
+	n := 0
+	err := d.transact(func(tx *sql.Tx) error {
+		// lock the row before reading it.
+		r, err := tx.Exec(d.q("UPDATE incidents SET id = id WHERE id = ?"), incidentId)
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		if rows, err := r.RowsAffected(); err != nil {
+			return slog.Wrap(err)
+		} else if rows == 0 {
+			return fmt.Errorf("incident %d not found", incidentId)
+		}
+		var data []byte
+		if err := tx.QueryRow(d.q("SELECT events FROM incidents WHERE id = ?"), incidentId).Scan(&data); err != nil {
+			return slog.Wrap(err)
+		}
+		var events []models.Event
+		if err := json.Unmarshal(data, &events); err != nil {
+			return slog.Wrap(err)
+		}
+		if n = len(events) - max; n <= 0 {
+			n = 0
+			return nil
+		}
+		events = events[n:]
+		if data, err = json.Marshal(events); err != nil {
+			return slog.Wrap(err)
+		}
+		_, err = tx.Exec(d.q("UPDATE incidents SET events = ?, num_events = ? WHERE id = ?"), string(data), len(events), incidentId)
+		return slog.Wrap(err)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
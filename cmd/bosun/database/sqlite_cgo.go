@@ -0,0 +1,6 @@
+// +build cgo
+
+package database
+
+// sqliteSupported is whether the sqlite3 driver works: it needs cgo.
+const sqliteSupported = true
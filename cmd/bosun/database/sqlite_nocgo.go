@@ -0,0 +1,7 @@
+// +build !cgo
+
+package database
+
+// sqliteSupported is whether the sqlite3 driver works: without cgo it is a
+// stub that fails to open any database.
+const sqliteSupported = false
@@ -58,6 +58,7 @@ type StateDataAccess interface {
 	GetOpenIncident(ak models.AlertKey) (*models.IncidentState, error)
 	GetLatestIncident(ak models.AlertKey) (*models.IncidentState, error)
 	GetAllOpenIncidents() ([]*models.IncidentState, error)
+	GetIncidentSummaries(start, count int) ([]*models.IncidentSummary, int, error)
 	GetIncidentState(incidentId int64) (*models.IncidentState, error)
 
 	GetAllIncidentsByAlertKey(ak models.AlertKey) ([]*models.IncidentState, error)
@@ -69,6 +70,9 @@ type StateDataAccess interface {
 	GetRenderedTemplates(incidentId int64) (*models.RenderedTemplates, error)
 
 	Forget(ak models.AlertKey) error
+	DeleteIncident(incidentId int64) error
+	DeleteRenderedTemplates(incidentId int64) (bool, error)
+	TrimIncidentEvents(incidentId int64, max int) (int, error)
 	SetUnevaluated(ak models.AlertKey, uneval bool) error
 	GetUnknownAndUnevalAlertKeys(alert string) ([]models.AlertKey, []models.AlertKey, error)
 }
@@ -192,10 +196,75 @@ func (d *dataAccess) GetAllIncidentsByAlertKey(ak models.AlertKey) ([]*models.In
 	return d.incidentMultiGet(conn, ids)
 }
 
+// GetIncidentSummaries returns the incidents of count entries of the list of
+// all incidents from start, most recent first, without their events. It also
+// returns the length of the list. Entries of incidents that were forgotten or
+// deleted are skipped, and the list may have several entries of an imported
+// incident, so there are often fewer incidents than entries.
+func (d *dataAccess) GetIncidentSummaries(start, count int) ([]*models.IncidentSummary, int, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	total, err := redis.Int(conn.Do("LLEN", "allIncidents"))
+	if err != nil {
+		return nil, 0, slog.Wrap(err)
+	}
+	entries, err := redis.Strings(conn.Do("LRANGE", "allIncidents", start, start+count-1))
+	if err != nil {
+		return nil, 0, slog.Wrap(err)
+	}
+	ids, err := incidentEntryIds(entries)
+	if err != nil || len(ids) == 0 {
+		return nil, total, err
+	}
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, incidentStateKey(id))
+	}
+	jsons, err := redis.Strings(conn.Do("MGET", args...))
+	if err != nil {
+		return nil, 0, slog.Wrap(err)
+	}
+	summaries := make([]*models.IncidentSummary, 0, len(jsons))
+	for _, j := range jsons {
+		if j == "" {
+			continue
+		}
+		// Events shadows the events of the incident, so that they are
+		// counted without being kept.
+		var v struct {
+			models.IncidentState
+			Events []struct{}
+		}
+		if err = json.Unmarshal([]byte(j), &v); err != nil {
+			return nil, 0, slog.Wrap(err)
+		}
+		summaries = append(summaries, &models.IncidentSummary{IncidentState: &v.IncidentState, NumEvents: len(v.Events)})
+	}
+	return summaries, total, nil
+}
+
+// incidentEntryIds returns the incident ids of entries of the allIncidents
+// list, which are "incidentId:timestamp:ak", without repeating any.
+func incidentEntryIds(entries []string) ([]int64, error) {
+	ids := make([]int64, 0, len(entries))
+	seen := make(map[int64]bool, len(entries))
+	for _, e := range entries {
+		id, err := strconv.ParseInt(strings.SplitN(e, ":", 2)[0], 10, 64)
+		if err != nil {
+			return nil, slog.Wrap(err)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
 // In general one should not use the redis KEYS command. So this is only used
-// in migration. If we want to use a proper index of all incidents
-// then issues with allIncidents must be fixed. Currently it is planned
-// to remove allIncidents in a future commit
+// in migration. GetIncidentSummaries uses the allIncidents list instead, which
+// keeps entries of forgotten incidents and may repeat imported ones.
 func (d *dataAccess) getAllIncidentIdsByKeys() ([]int64, error) {
 	conn := d.Get()
 	defer conn.Close()
@@ -392,6 +461,150 @@ func (d *dataAccess) Forget(ak models.AlertKey) error {
 	})
 }
 
+// DeleteIncident deletes a closed incident and its rendered templates, and
+// removes it from the incidents of its alert key. The allIncidents list keeps
+// the entries of deleted incidents, which readers skip, until they reach the
+// tail of the list: ledis can not remove list elements by value.
+func (d *dataAccess) DeleteIncident(incidentId int64) error {
+	conn := d.Get()
+	defer conn.Close()
+
+	s, err := d.getIncident(incidentId, conn)
+	if err != nil {
+		return err
+	}
+	key := incidentsForAlertKeyKey(s.AlertKey)
+	ids, err := int64s(conn.Do("LRANGE", key, 0, -1))
+	if err != nil {
+		return slog.Wrap(err)
+	}
+	args := []interface{}{key}
+	for _, id := range ids {
+		if id != s.Id {
+			args = append(args, id)
+		}
+	}
+	err = d.transact(conn, func() error {
+		if _, err := conn.Do("DEL", incidentStateKey(s.Id), renderedTemplatesKey(s.Id)); err != nil {
+			return slog.Wrap(err)
+		}
+		if _, err := conn.Do(d.LCLEAR(), key); err != nil {
+			return slog.Wrap(err)
+		}
+		if len(args) > 1 {
+			if _, err := conn.Do("RPUSH", args...); err != nil {
+				return slog.Wrap(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return d.trimDeletedIncidents(conn, "allIncidents")
+}
+
+// trimDeletedIncidents pops the entries of deleted incidents off the tail of
+// the list at key. Entries are incident ids, optionally followed by a colon
+// and more data.
+func (d *dataAccess) trimDeletedIncidents(conn redis.Conn, key string) error {
+	for {
+		entry, err := redis.String(conn.Do("LINDEX", key, -1))
+		if err == redis.ErrNil {
+			return nil
+		}
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		id, err := strconv.ParseInt(strings.SplitN(entry, ":", 2)[0], 10, 64)
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		exists, err := redis.Bool(conn.Do("EXISTS", incidentStateKey(id)))
+		if err != nil {
+			return slog.Wrap(err)
+		}
+		if exists {
+			return nil
+		}
+		if _, err := conn.Do("RPOP", key); err != nil {
+			return slog.Wrap(err)
+		}
+	}
+}
+
+// DeleteRenderedTemplates deletes the rendered templates of an incident. It
+// reports whether there were any.
+func (d *dataAccess) DeleteRenderedTemplates(incidentId int64) (bool, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	// ledis DEL counts the keys given rather than those deleted
+	exists, err := redis.Bool(conn.Do("EXISTS", renderedTemplatesKey(incidentId)))
+	if err != nil || !exists {
+		return false, slog.Wrap(err)
+	}
+	if _, err := conn.Do("DEL", renderedTemplatesKey(incidentId)); err != nil {
+		return false, slog.Wrap(err)
+	}
+	return true, nil
+}
+
+// TrimIncidentEvents drops the oldest events of an incident so that it has at
+// most max. It returns the number of events dropped. Unlike
+// UpdateIncidentState it does not touch the open incident of the alert key, so
+// it may be used on closed incidents. With redis the incident is watched, and
+// the events are trimmed again if it changed meanwhile. Ledis has no
+// transactions: callers must not change the incident at the same time.
+func (d *dataAccess) TrimIncidentEvents(incidentId int64, max int) (int, error) {
+	conn := d.Get()
+	defer conn.Close()
+
+	for {
+		if d.isRedis {
+			if _, err := conn.Do("WATCH", incidentStateKey(incidentId)); err != nil {
+				return 0, slog.Wrap(err)
+			}
+		}
+		s, err := d.getIncident(incidentId, conn)
+		if err != nil {
+			return 0, err
+		}
+		n := len(s.Events) - max
+		if n <= 0 {
+			if d.isRedis {
+				_, err = conn.Do("UNWATCH")
+			}
+			return 0, slog.Wrap(err)
+		}
+		s.Events = s.Events[n:]
+		data, err := json.Marshal(s)
+		if err != nil {
+			return 0, slog.Wrap(err)
+		}
+		if !d.isRedis {
+			if _, err := conn.Do("SET", incidentStateKey(s.Id), data); err != nil {
+				return 0, slog.Wrap(err)
+			}
+			return n, nil
+		}
+		if err := conn.Send("MULTI"); err != nil {
+			return 0, slog.Wrap(err)
+		}
+		if err := conn.Send("SET", incidentStateKey(s.Id), data); err != nil {
+			return 0, slog.Wrap(err)
+		}
+		// EXEC replies nil if the incident changed since WATCH.
+		reply, err := conn.Do("EXEC")
+		if err != nil {
+			return 0, slog.Wrap(err)
+		}
+		if reply != nil {
+			return n, nil
+		}
+	}
+}
+
 func (d *dataAccess) GetUnknownAndUnevalAlertKeys(alert string) ([]models.AlertKey, []models.AlertKey, error) {
 	conn := d.Get()
 	defer conn.Close()
@@ -2,6 +2,7 @@ package dbtest
 
 import (
 	"fmt"
+	"log"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -12,15 +13,23 @@ import (
 	"bosun.org/cmd/bosun/database"
 )
 
-// data access object to use for all unit tests. Pointed at ephemeral ledis, or redis server passed in with --redis=addr
+// data access object to use for all unit tests. The tests run against each
+// backend in turn: ephemeral ledis, or redis server passed in with --redis=addr,
+// sqlite, and postgres if a connection string is passed in with --postgres.
 var testData database.DataAccess
 
 func TestMain(m *testing.M) {
 	rand.Seed(time.Now().UnixNano())
-	var closeF func()
-	testData, closeF = StartTestRedis(9993)
-	status := m.Run()
-	closeF()
+	status := 0
+	for _, backend := range TestBackends() {
+		log.Println("Testing backend", backend)
+		var closeF func()
+		testData, closeF = StartTestBackend(backend, 9993)
+		if s := m.Run(); s != 0 {
+			status = s
+		}
+		closeF()
+	}
 	os.Exit(status)
 }
 
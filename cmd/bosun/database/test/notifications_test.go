@@ -66,3 +66,45 @@ func TestNotifications_RoundTrip(t *testing.T) {
 		t.Fatalf("wrong next time. %s != %s", next, future)
 	}
 }
+
+func TestNotifications_Escalation(t *testing.T) {
+	nd := testData.Notifications()
+	ak := models.AlertKey("escak{foo=a}")
+	due := time.Now().UTC().Add(-time.Minute).Truncate(time.Second)
+
+	es, err := nd.GetEscalation(ak)
+	check(t, err)
+	if es != nil {
+		t.Fatalf("expected no escalation, got %v", es)
+	}
+
+	check(t, nd.SetEscalation(&models.EscalationState{AlertKey: ak, Escalation: "e", Level: 1, Notified: 2, Due: due}))
+	es, err = nd.GetEscalation(ak)
+	check(t, err)
+	if es == nil || es.Escalation != "e" || es.Level != 1 || es.Notified != 2 || !es.Due.Equal(due) {
+		t.Fatalf("unexpected escalation %v", es)
+	}
+	next, err := nd.GetNextNotificationTime()
+	check(t, err)
+	if next != due {
+		t.Fatalf("wrong next time. %s != %s", next, due)
+	}
+	dues, err := nd.GetDueEscalations()
+	check(t, err)
+	if len(dues) != 1 || dues[0].AlertKey != ak {
+		t.Fatalf("expected escalation of %s to be due, got %v", ak, dues)
+	}
+
+	// clearing the notifications of an alert key ends its escalation
+	check(t, nd.ClearNotifications(ak))
+	dues, err = nd.GetDueEscalations()
+	check(t, err)
+	if len(dues) != 0 {
+		t.Fatalf("expected no due escalations, got %v", dues)
+	}
+	es, err = nd.GetEscalation(ak)
+	check(t, err)
+	if es != nil {
+		t.Fatalf("expected no escalation, got %v", es)
+	}
+}
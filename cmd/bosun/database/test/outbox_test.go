@@ -0,0 +1,80 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/models"
+)
+
+func TestOutbox_RoundTrip(t *testing.T) {
+	od := testData.Outbox()
+	now := time.Now().UTC().Truncate(time.Second)
+	old := now.Add(-48 * time.Hour)
+
+	next, err := od.GetNextDeliveryTime()
+	check(t, err)
+	if !next.IsZero() {
+		t.Fatalf("expected no next delivery time, got %s", next)
+	}
+
+	sent := &models.Delivery{Notification: "n", Action: "post", AlertKey: "a{}", IncidentId: 42, Created: old, NextAttempt: old}
+	retrying := &models.Delivery{Notification: "n", Action: "email", AlertKey: "a{}", IncidentId: 42, Created: old, NextAttempt: now.Add(time.Hour)}
+	pending := &models.Delivery{Notification: "n", Action: "get", AlertKey: "b{}", Created: now, NextAttempt: now}
+	for _, d := range []*models.Delivery{sent, retrying, pending} {
+		check(t, od.AddDelivery(d))
+	}
+	if sent.Id == 0 || sent.Id == retrying.Id || retrying.Id == pending.Id {
+		t.Fatalf("bad delivery ids %d, %d, %d", sent.Id, retrying.Id, pending.Id)
+	}
+
+	next, err = od.GetNextDeliveryTime()
+	check(t, err)
+	if next != old {
+		t.Fatalf("wrong next time. %s != %s", next, old)
+	}
+
+	sent.Status = models.DeliverySent
+	sent.Attempts = 1
+	check(t, od.UpdateDelivery(sent))
+	retrying.Status = models.DeliveryRetrying
+	retrying.LastError = "bad response"
+	check(t, od.UpdateDelivery(retrying))
+
+	due, err := od.GetDueDeliveries(now)
+	check(t, err)
+	if len(due) != 1 || due[0].Id != pending.Id {
+		t.Fatalf("expected only delivery %d to be due, got %v", pending.Id, due)
+	}
+
+	d, err := od.GetDelivery(retrying.Id)
+	check(t, err)
+	if d == nil || d.Status != models.DeliveryRetrying || d.LastError != "bad response" {
+		t.Fatalf("unexpected delivery %v", d)
+	}
+
+	incident, err := od.GetIncidentDeliveries(42)
+	check(t, err)
+	if len(incident) != 2 {
+		t.Fatalf("expected 2 deliveries of incident, got %d", len(incident))
+	}
+
+	recent, err := od.ListDeliveries(now.Add(-time.Hour))
+	check(t, err)
+	if len(recent) != 1 || recent[0].Id != pending.Id {
+		t.Fatalf("expected only delivery %d to be recent, got %v", pending.Id, recent)
+	}
+
+	// only the sent delivery is old and done
+	check(t, od.PurgeDeliveries(now.Add(-time.Hour)))
+	d, err = od.GetDelivery(sent.Id)
+	check(t, err)
+	if d != nil {
+		t.Fatalf("expected delivery %d to be purged", sent.Id)
+	}
+	incident, err = od.GetIncidentDeliveries(42)
+	check(t, err)
+	if len(incident) != 1 || incident[0].Id != retrying.Id {
+		t.Fatalf("expected only delivery %d of incident, got %v", retrying.Id, incident)
+	}
+}
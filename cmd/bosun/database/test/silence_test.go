@@ -37,3 +37,50 @@ func TestSilence(t *testing.T) {
 	}
 
 }
+
+func TestRecurringSilence(t *testing.T) {
+	sd := testData.Silence()
+
+	rec, err := models.ParseRecurrence("0 1 * * Sun", "2h", "America/New_York")
+	check(t, err)
+	silence := &models.Silence{
+		Start:      time.Now().Add(-48 * time.Hour),
+		End:        time.Now().Add(30 * 24 * time.Hour),
+		Alert:      "Recurring",
+		Recurrence: rec,
+	}
+	check(t, sd.AddSilence(silence))
+
+	silences, err := sd.ListSilences(time.Now().Unix())
+	check(t, err)
+	var got *models.Silence
+	for _, s := range silences {
+		if s.Alert == "Recurring" {
+			got = s
+		}
+	}
+	if got == nil || got.Recurrence == nil {
+		t.Fatalf("Expected stored recurring silence. Got %v.", got)
+	}
+	// 2016-01-03 is a Sunday; 01:00 in New York is 06:00 UTC.
+	got.Start = time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	got.End = time.Date(2016, 2, 1, 0, 0, 0, 0, time.UTC)
+	for _, c := range []struct {
+		t      time.Time
+		active bool
+	}{
+		{time.Date(2016, 1, 3, 5, 59, 0, 0, time.UTC), false},
+		{time.Date(2016, 1, 3, 6, 0, 0, 0, time.UTC), true},
+		{time.Date(2016, 1, 3, 7, 59, 0, 0, time.UTC), true},
+		{time.Date(2016, 1, 3, 8, 0, 0, 0, time.UTC), false},
+		{time.Date(2016, 1, 10, 7, 0, 0, 0, time.UTC), true},
+		{time.Date(2016, 2, 7, 7, 0, 0, 0, time.UTC), false},
+	} {
+		if active := got.ActiveAt(c.t); active != c.active {
+			t.Errorf("%v: expected active %v, got %v", c.t, c.active, active)
+		}
+	}
+	if _, err := models.ParseRecurrence("0 1 * * Sun", "0s", ""); err == nil {
+		t.Error("expected error for zero duration")
+	}
+}
@@ -0,0 +1,148 @@
+package dbtest
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/database"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+// incidentIds returns the ids of the incidents of alert, read from the
+// summaries of all incidents in pages of two.
+func incidentIds(t *testing.T, sd database.StateDataAccess, alert string) []int64 {
+	var found []int64
+	for start, total := 0, 1; start < total; start += 2 {
+		var summaries []*models.IncidentSummary
+		var err error
+		summaries, total, err = sd.GetIncidentSummaries(start, 2)
+		check(t, err)
+		for _, s := range summaries {
+			if s.Alert == alert {
+				found = append(found, s.Id)
+			}
+		}
+	}
+	return found
+}
+
+func TestState_ListIncidents(t *testing.T) {
+	sd := testData.State()
+	alert := randString(8)
+	akA := models.NewAlertKey(alert, opentsdb.TagSet{"host": "a"})
+	akB := models.NewAlertKey(alert, opentsdb.TagSet{"host": "b"})
+	now := time.Now().UTC()
+
+	var ids []int64
+	for i, ak := range []models.AlertKey{akA, akB, akA} {
+		id, err := sd.UpdateIncidentState(&models.IncidentState{
+			AlertKey: ak,
+			Alert:    alert,
+			Start:    now.Add(time.Duration(i) * time.Minute),
+		})
+		check(t, err)
+		ids = append(ids, id)
+	}
+
+	found := incidentIds(t, sd, alert)
+	if len(found) != 3 || found[0] != ids[2] || found[1] != ids[1] || found[2] != ids[0] {
+		t.Fatalf("expected incidents %v most recent first, got %v", ids, found)
+	}
+
+	check(t, sd.Forget(akA))
+	found = incidentIds(t, sd, alert)
+	if len(found) != 1 || found[0] != ids[1] {
+		t.Fatalf("expected only incident %d after forgetting %s, got %v", ids[1], akA, found)
+	}
+}
+
+func TestState_GetIncidentSummaries(t *testing.T) {
+	sd := testData.State()
+	alert := randString(8)
+	ak := models.NewAlertKey(alert, opentsdb.TagSet{"host": "a"})
+	events := []models.Event{{Status: models.StWarning}, {Status: models.StNormal}}
+	var ids []int64
+	for i := 0; i < 3; i++ {
+		id, err := sd.UpdateIncidentState(&models.IncidentState{
+			AlertKey: ak,
+			Alert:    alert,
+			Start:    time.Now().UTC(),
+			Events:   events[:i],
+		})
+		check(t, err)
+		ids = append(ids, id)
+	}
+
+	summaries, total, err := sd.GetIncidentSummaries(0, 2)
+	check(t, err)
+	if total < 3 {
+		t.Fatalf("expected at least 3 entries, got %d", total)
+	}
+	if len(summaries) != 2 || summaries[0].Id != ids[2] || summaries[1].Id != ids[1] {
+		t.Fatalf("expected incidents %d and %d first, got %v", ids[2], ids[1], summaries)
+	}
+	if summaries[0].NumEvents != 2 || summaries[0].Events != nil {
+		t.Errorf("expected 2 events counted but not loaded, got %d and %v", summaries[0].NumEvents, summaries[0].Events)
+	}
+	summaries, _, err = sd.GetIncidentSummaries(2, 1)
+	check(t, err)
+	if len(summaries) != 1 || summaries[0].Id != ids[0] || summaries[0].NumEvents != 0 {
+		t.Fatalf("expected incident %d with no events, got %v", ids[0], summaries)
+	}
+}
+
+func TestState_DeleteIncident(t *testing.T) {
+	sd := testData.State()
+	alert := randString(8)
+	ak := models.NewAlertKey(alert, opentsdb.TagSet{"host": "a"})
+	now := time.Now().UTC()
+
+	var ids []int64
+	for i := 0; i < 2; i++ {
+		id, err := sd.UpdateIncidentState(&models.IncidentState{
+			AlertKey: ak,
+			Alert:    alert,
+			Start:    now.Add(time.Duration(i) * time.Minute),
+			Events:   []models.Event{{Status: models.StWarning}, {Status: models.StCritical}, {Status: models.StNormal}},
+		})
+		check(t, err)
+		check(t, sd.SetRenderedTemplates(id, &models.RenderedTemplates{Body: "body"}))
+		ids = append(ids, id)
+	}
+
+	deleted, err := sd.DeleteRenderedTemplates(ids[1])
+	check(t, err)
+	if !deleted {
+		t.Fatal("expected rendered templates to be dropped")
+	}
+	deleted, err = sd.DeleteRenderedTemplates(ids[1])
+	check(t, err)
+	if deleted {
+		t.Fatal("expected no rendered templates to drop")
+	}
+
+	dropped, err := sd.TrimIncidentEvents(ids[1], 1)
+	check(t, err)
+	s, err := sd.GetIncidentState(ids[1])
+	check(t, err)
+	if dropped != 2 || len(s.Events) != 1 || s.Events[0].Status != models.StNormal {
+		t.Fatalf("expected 2 events dropped and the last kept, got %d dropped and %v", dropped, s.Events)
+	}
+
+	check(t, sd.DeleteIncident(ids[0]))
+	if _, err := sd.GetIncidentState(ids[0]); err == nil {
+		t.Fatalf("expected incident %d to be deleted", ids[0])
+	}
+	if _, err := sd.GetRenderedTemplates(ids[0]); err == nil {
+		t.Fatalf("expected rendered templates of incident %d to be deleted", ids[0])
+	}
+	incidents, err := sd.GetAllIncidentsByAlertKey(ak)
+	check(t, err)
+	if len(incidents) != 1 || incidents[0].Id != ids[1] {
+		t.Fatalf("expected only incident %d of %s, got %v", ids[1], ak, incidents)
+	}
+	if found := incidentIds(t, sd, alert); len(found) != 1 || found[0] != ids[1] {
+		t.Fatalf("expected only incident %d to be listed, got %v", ids[1], found)
+	}
+}
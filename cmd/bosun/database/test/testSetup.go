@@ -1,11 +1,13 @@
 package dbtest
 
 import (
+	"database/sql"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"bosun.org/cmd/bosun/database"
@@ -13,23 +15,77 @@ import (
 
 var flagReddisHost = flag.String("redis", "", "redis server to test against")
 var flagFlushRedis = flag.Bool("flush", false, "flush database before tests. DANGER!")
+var flagPostgres = flag.String("postgres", "", "postgres connection string to test against. Tests run in a new schema that is dropped afterwards")
+var flagBackend = flag.String("backend", "", "backend to test against: ledis, redis, sqlite3 or postgres. Defaults to redis if -redis is given, else ledis")
 
-func StartTestRedis(port int) (database.DataAccess, func()) {
+// StartTestDataAccess starts the data access chosen by the -backend flag. port
+// is used by ledis. The returned function stops it.
+func StartTestDataAccess(port int) (database.DataAccess, func()) {
 	flag.Parse()
-	// For redis tests we just point at an external server.
+	backend := *flagBackend
+	if backend == "" {
+		backend = "ledis"
+		if *flagReddisHost != "" {
+			backend = "redis"
+		}
+	}
+	return StartTestBackend(backend, port)
+}
+
+// TestBackends returns the backends the flags allow to test: ledis, or redis if
+// -redis is given, sqlite3 in cgo builds, and postgres if -postgres is given.
+func TestBackends() []string {
+	flag.Parse()
+	backends := []string{"ledis"}
 	if *flagReddisHost != "" {
-		testData := database.NewDataAccess(*flagReddisHost, true, 0, "")
-		if *flagFlushRedis {
-			log.Println("FLUSHING REDIS")
-			c := testData.(database.RedisConnector).Get()
-			defer c.Close()
-			_, err := c.Do("FLUSHDB")
-			if err != nil {
-				log.Fatal(err)
-			}
+		backends[0] = "redis"
+	}
+	if database.CheckSQLDriver("sqlite3") == nil {
+		backends = append(backends, "sqlite3")
+	}
+	if *flagPostgres != "" {
+		backends = append(backends, "postgres")
+	}
+	return backends
+}
+
+// StartTestBackend starts a data access of backend. port is used by ledis. The
+// returned function stops it.
+func StartTestBackend(backend string, port int) (database.DataAccess, func()) {
+	flag.Parse()
+	switch backend {
+	case "redis":
+		return startTestRedis()
+	case "ledis":
+		return startTestLedis(port)
+	case "sqlite3":
+		return startTestSQLite(port)
+	case "postgres":
+		return startTestPostgres()
+	}
+	log.Fatalf("unknown test backend %s", backend)
+	return nil, nil
+}
+
+func startTestRedis() (database.DataAccess, func()) {
+	// For redis tests we just point at an external server.
+	if *flagReddisHost == "" {
+		log.Fatal("the redis backend needs -redis")
+	}
+	testData := database.NewDataAccess(*flagReddisHost, true, 0, "")
+	if *flagFlushRedis {
+		log.Println("FLUSHING REDIS")
+		c := testData.(database.RedisConnector).Get()
+		defer c.Close()
+		_, err := c.Do("FLUSHDB")
+		if err != nil {
+			log.Fatal(err)
 		}
-		return testData, func() {}
 	}
+	return testData, func() {}
+}
+
+func startTestLedis(port int) (database.DataAccess, func()) {
 	// To test ledis, start a local instance in a new tmp dir. We will attempt to delete it when we're done.
 	addr := fmt.Sprintf("127.0.0.1:%d", port)
 	testPath := filepath.Join(os.TempDir(), "bosun_ledis_test", fmt.Sprintf("%d-%d", time.Now().UnixNano(), port))
@@ -44,3 +100,65 @@ func StartTestRedis(port int) (database.DataAccess, func()) {
 		os.RemoveAll(testPath)
 	}
 }
+
+func startTestSQLite(port int) (database.DataAccess, func()) {
+	testPath := filepath.Join(os.TempDir(), "bosun_sqlite_test", fmt.Sprintf("%d-%d", time.Now().UnixNano(), port))
+	if err := os.MkdirAll(testPath, 0755); err != nil {
+		log.Fatal(err)
+	}
+	log.Println("Test sqlite at", testPath)
+	testData, err := database.NewSQLDataAccess("sqlite3", filepath.Join(testPath, "bosun.db"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := testData.Migrate(); err != nil {
+		log.Fatal(err)
+	}
+	return testData, func() {
+		testData.(interface {
+			Close() error
+		}).Close()
+		os.RemoveAll(testPath)
+	}
+}
+
+func startTestPostgres() (database.DataAccess, func()) {
+	if *flagPostgres == "" {
+		log.Fatal("the postgres backend needs -postgres")
+	}
+	// Each run gets a schema of its own, so that runs do not see the data of
+	// earlier ones.
+	db, err := sql.Open("postgres", *flagPostgres)
+	if err != nil {
+		log.Fatal(err)
+	}
+	schema := fmt.Sprintf("bosun_test_%d", time.Now().UnixNano())
+	if _, err := db.Exec("CREATE SCHEMA " + schema); err != nil {
+		log.Fatal(err)
+	}
+	log.Println("Test postgres in schema", schema)
+	dataSource := *flagPostgres + " search_path=" + schema
+	if strings.HasPrefix(*flagPostgres, "postgres://") || strings.HasPrefix(*flagPostgres, "postgresql://") {
+		sep := "?"
+		if strings.Contains(*flagPostgres, "?") {
+			sep = "&"
+		}
+		dataSource = *flagPostgres + sep + "search_path=" + schema
+	}
+	testData, err := database.NewSQLDataAccess("postgres", dataSource)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := testData.Migrate(); err != nil {
+		log.Fatal(err)
+	}
+	return testData, func() {
+		testData.(interface {
+			Close() error
+		}).Close()
+		if _, err := db.Exec("DROP SCHEMA " + schema + " CASCADE"); err != nil {
+			log.Println(err)
+		}
+		db.Close()
+	}
+}
@@ -0,0 +1,39 @@
+package dbtest
+
+import (
+	"testing"
+
+	"github.com/captncraig/easyauth/providers/token"
+)
+
+func TestTokens_RoundTrip(t *testing.T) {
+	td := testData.Tokens()
+	hash := randString(16)
+	check(t, td.StoreToken(&token.Token{Hash: hash, User: "bob", Role: 3, Description: "test"}))
+
+	tok, err := td.LookupToken(hash)
+	check(t, err)
+	if tok.User != "bob" || tok.Role != 3 || tok.Description != "test" {
+		t.Fatalf("wrong token: %+v", tok)
+	}
+
+	toks, err := td.ListTokens()
+	check(t, err)
+	var found *token.Token
+	for _, tok := range toks {
+		if tok.Hash == hash {
+			found = tok
+		}
+	}
+	if found == nil {
+		t.Fatal("token not listed")
+	}
+	if found.LastUsed.IsZero() {
+		t.Fatal("expected the lookup to set the last used time")
+	}
+
+	check(t, td.RevokeToken(hash))
+	if _, err := td.LookupToken(hash); err == nil {
+		t.Fatal("expected revoked token not to be found")
+	}
+}
@@ -312,6 +312,23 @@ func (r *ElasticRequest) CacheKey() (string, error) {
 	return fmt.Sprintf("%v\n%s", r.Indices, b), nil
 }
 
+// elasticCacheKey returns key, the cache key of req, with the start and end
+// times of req replaced by their offsets from now, so that the same query of a
+// later check can use the cached result.
+func elasticCacheKey(key string, req *ElasticRequest, now time.Time) string {
+	for _, t := range []struct {
+		name string
+		t    *time.Time
+	}{{"start", req.Start}, {"end", req.End}} {
+		if t.t == nil {
+			continue
+		}
+		rel := fmt.Sprintf("%s:%d", t.name, t.t.Unix()-now.Unix())
+		key = strings.Replace(key, t.t.Format(time.RFC3339Nano), rel, -1)
+	}
+	return key
+}
+
 // timeESRequest execute the elasticsearch query (which may set or hit cache) and returns
 // the search results.
 func timeESRequest(e *State, T miniprofiler.Timer, req *ElasticRequest) (resp *elastic.SearchResult, err error) {
@@ -334,7 +351,7 @@ func timeESRequest(e *State, T miniprofiler.Timer, req *ElasticRequest) (resp *e
 			return e.ElasticHosts.Query(req)
 		}
 		var val interface{}
-		val, err = e.Cache.Get(key, getFn)
+		val, err = e.Cache.GetRelative("elastic", elasticCacheKey(key, req, e.now), e.now, getFn)
 		resp = val.(*elastic.SearchResult)
 	})
 	return
@@ -0,0 +1,35 @@
+package expr
+
+import (
+	"testing"
+	"time"
+
+	elastic "gopkg.in/olivere/elastic.v3"
+)
+
+func TestElasticCacheKey(t *testing.T) {
+	indexer := ESIndexer{
+		TimeField: "@timestamp",
+		Generate: func(start, end *time.Time) []string {
+			return []string{"logs"}
+		},
+	}
+	key := func(now time.Time, sduration string) string {
+		req, err := ESBaseQuery(now, indexer, elastic.NewTermQuery("host", "a"), sduration, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		k, err := req.CacheKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return elasticCacheKey(k, req, now)
+	}
+	now := time.Date(2018, 1, 1, 12, 0, 0, 0, time.UTC)
+	if a, b := key(now, "5m"), key(now.Add(time.Minute), "5m"); a != b {
+		t.Errorf("keys of the same query at different times differ: %s and %s", a, b)
+	}
+	if a, b := key(now, "5m"), key(now, "10m"); a == b {
+		t.Errorf("keys of different queries are the same: %s", a)
+	}
+}
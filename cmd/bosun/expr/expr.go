@@ -31,6 +31,8 @@ type State struct {
 	unjoinedOk         bool
 	autods             int
 	vValue             float64
+	// trace is the current node of the evaluation tree, nil unless tracing
+	trace *Trace
 
 	*Backends
 
@@ -45,6 +47,10 @@ type State struct {
 	elasticQueries []elastic.SearchSource
 	// OpenTSDB
 	tsdbQueries []opentsdb.Request
+	// Prometheus
+	prometheusQueries []PrometheusRequest
+	// Loki
+	lokiQueries []LokiRequest
 }
 
 type Backends struct {
@@ -53,6 +59,8 @@ type Backends struct {
 	LogstashHosts   LogstashElasticHosts
 	ElasticHosts    ElasticHosts
 	InfluxConfig    client.HTTPConfig
+	PrometheusHost  PrometheusHost
+	LokiHost        LokiHost
 	AnnotateContext annotate.Client
 }
 
@@ -95,12 +103,17 @@ func New(expr string, funcs ...map[string]parse.Func) (*Expr, error) {
 // Execute applies a parse expression to the specified OpenTSDB context, and
 // returns one result per group. T may be nil to ignore timings.
 func (e *Expr) Execute(backends *Backends, providers *BosunProviders, T miniprofiler.Timer, now time.Time, autods int, unjoinedOk bool) (r *Results, queries []opentsdb.Request, err error) {
+	s := newState(e, backends, providers, now, autods, unjoinedOk)
+	return e.ExecuteState(s, T)
+}
+
+func newState(e *Expr, backends *Backends, providers *BosunProviders, now time.Time, autods int, unjoinedOk bool) *State {
 	if providers.Squelched == nil {
 		providers.Squelched = func(tags opentsdb.TagSet) bool {
 			return false
 		}
 	}
-	s := &State{
+	return &State{
 		Expr:           e,
 		now:            now,
 		autods:         autods,
@@ -108,9 +121,10 @@ func (e *Expr) Execute(backends *Backends, providers *BosunProviders, T miniprof
 		Backends:       backends,
 		BosunProviders: providers,
 	}
-	return e.ExecuteState(s, T)
 }
 
+// ExecuteState evaluates the expression in s. If s was created by
+// ExecuteTrace, the evaluation tree is added to the trace of s.
 func (e *Expr) ExecuteState(s *State, T miniprofiler.Timer) (r *Results, queries []opentsdb.Request, err error) {
 	defer errRecover(&err)
 	if T == nil {
@@ -271,6 +285,9 @@ type Results struct {
 	IgnoreOtherUnjoined bool
 	// If non nil, will set any NaN value to it.
 	NaNValue *float64
+	// If set, series operations with this set fill timestamps missing from
+	// one of the series with this method instead of dropping them.
+	SeriesFill string
 }
 
 // Equal inspects if two results have the same content
@@ -410,12 +427,17 @@ func (e *State) union(a, b *Results, expression string) []*Union {
 	for _, ra := range a.Results {
 		for _, rb := range b.Results {
 
+			reason := joinSubset
 			if ra.Group.Equal(rb.Group) || len(ra.Group) == 0 || len(rb.Group) == 0 {
 				g := ra.Group
 				if len(ra.Group) == 0 {
 					g = rb.Group
 				}
 				group = g
+				reason = joinEqual
+				if !ra.Group.Equal(rb.Group) {
+					reason = joinUngrouped
+				}
 			} else if len(ra.Group) == len(rb.Group) {
 				continue
 			} else if ra.Group.Subset(rb.Group) {
@@ -425,6 +447,7 @@ func (e *State) union(a, b *Results, expression string) []*Union {
 			} else {
 				continue
 			}
+			e.traceJoin(ra.Group, rb.Group, group, reason)
 			delete(am, ra)
 			delete(bm, rb)
 			u := &Union{
@@ -446,6 +469,7 @@ func (e *State) union(a, b *Results, expression string) []*Union {
 					Group: r.Group,
 				}
 				e.AddComputation(r, expression, fmt.Sprintf(unjoinedGroup, u.B))
+				e.traceJoin(r.Group, nil, r.Group, joinUnjoined)
 				u.ExtendComputations(r)
 				us = append(us, u)
 			}
@@ -458,6 +482,7 @@ func (e *State) union(a, b *Results, expression string) []*Union {
 					Group: r.Group,
 				}
 				e.AddComputation(r, expression, fmt.Sprintf(unjoinedGroup, u.A))
+				e.traceJoin(nil, r.Group, r.Group, joinUnjoined)
 				u.ExtendComputations(r)
 				us = append(us, u)
 			}
@@ -467,22 +492,24 @@ func (e *State) union(a, b *Results, expression string) []*Union {
 }
 
 func (e *State) walk(node parse.Node, T miniprofiler.Timer) *Results {
-	var res *Results
-	switch node := node.(type) {
-	case *parse.NumberNode:
-		res = wrap(node.Float64)
-	case *parse.BinaryNode:
-		res = e.walkBinary(node, T)
-	case *parse.UnaryNode:
-		res = e.walkUnary(node, T)
-	case *parse.FuncNode:
-		res = e.walkFunc(node, T)
-	case *parse.ExprNode:
-		res = e.walkExpr(node, T)
-	default:
-		panic(fmt.Errorf("expr: unknown node type"))
-	}
-	return res
+	return e.traceNode(node, T, func(T miniprofiler.Timer) *Results {
+		var res *Results
+		switch node := node.(type) {
+		case *parse.NumberNode:
+			res = wrap(node.Float64)
+		case *parse.BinaryNode:
+			res = e.walkBinary(node, T)
+		case *parse.UnaryNode:
+			res = e.walkUnary(node, T)
+		case *parse.FuncNode:
+			res = e.walkFunc(node, T)
+		case *parse.ExprNode:
+			res = e.walkExpr(node, T)
+		default:
+			panic(fmt.Errorf("expr: unknown node type"))
+		}
+		return res
+	})
 }
 
 func (e *State) walkExpr(node *parse.ExprNode, T miniprofiler.Timer) *Results {
@@ -501,6 +528,10 @@ func (e *State) walkBinary(node *parse.BinaryNode, T miniprofiler.Timer) *Result
 	res := Results{
 		IgnoreUnjoined:      ar.IgnoreUnjoined || br.IgnoreUnjoined,
 		IgnoreOtherUnjoined: ar.IgnoreOtherUnjoined || br.IgnoreOtherUnjoined,
+		SeriesFill:          ar.SeriesFill,
+	}
+	if res.SeriesFill == "" {
+		res.SeriesFill = br.SeriesFill
 	}
 	T.Step("walkBinary: "+node.OpStr, func(T miniprofiler.Timer) {
 		u := e.union(ar, br, node.String())
@@ -560,6 +591,20 @@ func (e *State) walkBinary(node *parse.BinaryNode, T miniprofiler.Timer) *Result
 					value = s
 				case Series:
 					s := make(Series)
+					if res.SeriesFill != "" {
+						sa, sb := NewSortedSeries(at), NewSortedSeries(bt)
+						for _, ts := range []Series{at, bt} {
+							for k := range ts {
+								av, aok := fillValue(at, sa, k, res.SeriesFill)
+								bv, bok := fillValue(bt, sb, k, res.SeriesFill)
+								if aok && bok {
+									s[k] = operate(node.OpStr, av, bv)
+								}
+							}
+						}
+						value = s
+						break
+					}
 					for k, av := range at {
 						if bv, ok := bt[k]; ok {
 							s[k] = operate(node.OpStr, av, bv)
@@ -713,16 +758,15 @@ func (e *State) walkFunc(node *parse.FuncNode, T miniprofiler.Timer) *Results {
 			switch t := a.(type) {
 			case *parse.StringNode:
 				v = t.Text
+				e.traceLeaf(t, String(t.Text))
 			case *parse.NumberNode:
 				v = t.Float64
-			case *parse.FuncNode:
-				v = extract(e.walkFunc(t, T))
-			case *parse.UnaryNode:
-				v = extract(e.walkUnary(t, T))
-			case *parse.BinaryNode:
-				v = extract(e.walkBinary(t, T))
+				e.traceLeaf(t, Scalar(t.Float64))
+			case *parse.FuncNode, *parse.UnaryNode, *parse.BinaryNode:
+				v = extract(e.walk(t, T))
 			case *parse.ExprNode:
 				v = e.walkExpr(t, T)
+				e.traceLeaf(t, String(t.Tree.String()))
 			default:
 				panic(fmt.Errorf("expr: unknown func arg type"))
 			}
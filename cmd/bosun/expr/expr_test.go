@@ -97,6 +97,8 @@ func TestExprParse(t *testing.T) {
 		{`avg(q("avg:m{a=*}", "1m", ""))`, true, "a"},
 		{`avg(q("avg:m{a=*,b=1}", "1m", ""))`, true, "a,b"},
 		{`avg(q("avg:m{a=*,b=1}", "1m", "")) + 1`, true, "a,b"},
+		{`aggr(q("avg:m{dc=*,host=*}", "1m", ""), "dc, host", "max")`, true, "dc,host"},
+		{`aggr(q("avg:m{dc=*,host=*}", "1m", ""), "", "sum")`, true, ""},
 	}
 
 	for _, et := range exprTests {
@@ -116,6 +118,13 @@ func TestExprParse(t *testing.T) {
 			}
 		}
 	}
+	e, err := New(`aggr(q("avg:m{dc=*,host=*}", "1m", ""), "rack", "sum")`, TSDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Root.Tags(); err == nil {
+		t.Error("expected error for aggr by a tag key not in the query")
+	}
 }
 
 var queryTime = time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
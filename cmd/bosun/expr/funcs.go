@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -60,6 +61,23 @@ func tagTranspose(args []parse.Node) (parse.Tags, error) {
 	return tags, nil
 }
 
+// tagAggr returns the tags of aggr: its group keys, which unlike those of t
+// may be separated by spaces as well as a comma.
+func tagAggr(args []parse.Node) (parse.Tags, error) {
+	tags := make(parse.Tags)
+	for _, k := range strings.Split(args[1].(*parse.StringNode).Text, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			tags[k] = struct{}{}
+		}
+	}
+	if atags, err := args[0].Tags(); err != nil {
+		return nil, err
+	} else if !tags.Subset(atags) {
+		return nil, fmt.Errorf("aggr tags (%v) must be a subset of first argument's tags (%v)", tags, atags)
+	}
+	return tags, nil
+}
+
 func tagRename(args []parse.Node) (parse.Tags, error) {
 	tags, err := tagFirst(args)
 	if err != nil {
@@ -83,6 +101,12 @@ func tagRename(args []parse.Node) (parse.Tags, error) {
 	return tags, nil
 }
 
+// IsBuiltin reports whether name is a built-in expression function.
+func IsBuiltin(name string) bool {
+	_, ok := builtins[name]
+	return ok
+}
+
 var builtins = map[string]parse.Func{
 	// Reduction functions
 
@@ -116,6 +140,12 @@ var builtins = map[string]parse.Func{
 		Tags:   tagFirst,
 		F:      First,
 	},
+	"anomaly": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString, models.TypeScalar},
+		Return: models.TypeNumberSet,
+		Tags:   tagFirst,
+		F:      Anomaly,
+	},
 	"forecastlr": {
 		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeNumberSet},
 		Return: models.TypeNumberSet,
@@ -182,6 +212,12 @@ var builtins = map[string]parse.Func{
 		Tags:   tagFirst,
 		F:      Streak,
 	},
+	"zscore": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString},
+		Return: models.TypeNumberSet,
+		Tags:   tagFirst,
+		F:      ZScore,
+	},
 
 	// Group functions
 	"addtags": {
@@ -190,6 +226,12 @@ var builtins = map[string]parse.Func{
 		Tags:   tagRename,
 		F:      AddTags,
 	},
+	"aggr": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   tagAggr,
+		F:      Aggr,
+	},
 	"rename": {
 		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString},
 		Return: models.TypeSeriesSet,
@@ -244,6 +286,12 @@ var builtins = map[string]parse.Func{
 		Tags:   tagFirst,
 		F:      Des,
 	},
+	"hw": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeScalar, models.TypeScalar, models.TypeScalar, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   tagFirst,
+		F:      HW,
+	},
 	"dropge": {
 		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeNumberSet},
 		Return: models.TypeSeriesSet,
@@ -291,6 +339,12 @@ var builtins = map[string]parse.Func{
 		Tags:   tagFirst,
 		F:      Filter,
 	},
+	"interpolate": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   tagFirst,
+		F:      Interpolate,
+	},
 	"limit": {
 		Args:   []models.FuncType{models.TypeNumberSet, models.TypeScalar},
 		Return: models.TypeNumberSet,
@@ -318,6 +372,18 @@ var builtins = map[string]parse.Func{
 		Tags:   tagFirst,
 		F:      Sort,
 	},
+	"resample": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString, models.TypeString, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   tagFirst,
+		F:      Resample,
+	},
+	"align": {
+		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeSeriesSet},
+		Return: models.TypeSeriesSet,
+		Tags:   tagFirst,
+		F:      Align,
+	},
 	"shift": {
 		Args:   []models.FuncType{models.TypeSeriesSet, models.TypeString},
 		Return: models.TypeSeriesSet,
@@ -375,6 +441,10 @@ func V(e *State, T miniprofiler.Timer) (*Results, error) {
 
 func Map(e *State, T miniprofiler.Timer, series *Results, expr *Results) (*Results, error) {
 	newExpr := Expr{expr.Results[0].Value.Value().(NumberExpr).Tree}
+	// The expression is evaluated once per point, which would bloat a trace.
+	trace := e.trace
+	e.trace = nil
+	defer func() { e.trace = trace }()
 	for _, result := range series.Results {
 		newSeries := make(Series)
 		for t, v := range result.Value.Value().(Series) {
@@ -699,6 +769,138 @@ func Shift(e *State, T miniprofiler.Timer, series *Results, d string) (*Results,
 	return series, nil
 }
 
+// Resample buckets each series into intervals aligned to the epoch, reduces
+// the values in each bucket with reducer (avg, last or sum) and fills empty
+// buckets between the first and last point with fill: "none" to leave them
+// out, "nan", "zero", "ffill" to repeat the previous bucket, or "linear" to
+// interpolate between the surrounding buckets.
+func Resample(e *State, T miniprofiler.Timer, series *Results, interval, reducer, fill string) (*Results, error) {
+	d, err := opentsdb.ParseDuration(interval)
+	if err != nil {
+		return nil, err
+	}
+	step := time.Duration(d)
+	if step <= 0 {
+		return nil, fmt.Errorf("resample: interval must be greater than zero")
+	}
+	switch reducer {
+	case "avg", "last", "sum":
+	default:
+		return nil, fmt.Errorf("resample: reducer must be avg, last or sum, got %q", reducer)
+	}
+	switch fill {
+	case "none", "nan", "zero", "ffill", "linear":
+	default:
+		return nil, fmt.Errorf("resample: fill must be none, nan, zero, ffill or linear, got %q", fill)
+	}
+	for _, res := range series.Results {
+		sorted := NewSortedSeries(res.Value.Value().(Series))
+		buckets := make(Series)
+		counts := make(map[time.Time]int)
+		for _, p := range sorted {
+			b := p.T.Truncate(step)
+			switch reducer {
+			case "avg", "sum":
+				buckets[b] += p.V
+			case "last":
+				buckets[b] = p.V
+			}
+			counts[b]++
+		}
+		if reducer == "avg" {
+			for b, n := range counts {
+				buckets[b] /= float64(n)
+			}
+		}
+		if fill != "none" && len(sorted) > 0 {
+			sb := NewSortedSeries(buckets)
+			last := sorted[len(sorted)-1].T.Truncate(step)
+			for b := sorted[0].T.Truncate(step); b.Before(last); b = b.Add(step) {
+				if _, ok := buckets[b]; ok {
+					continue
+				}
+				switch fill {
+				case "nan":
+					buckets[b] = math.NaN()
+				case "zero":
+					buckets[b] = 0
+				default:
+					buckets[b], _ = fillValue(buckets, sb, b, fill)
+				}
+			}
+		}
+		res.Value = buckets
+	}
+	return series, nil
+}
+
+// Align returns the values of each series in a at the timestamps of the
+// matching series in b, linearly interpolating between the points of a.
+// Timestamps of b outside of the range of a are left out.
+func Align(e *State, T miniprofiler.Timer, a, b *Results) (*Results, error) {
+	r := new(Results)
+	for _, u := range e.union(a, b, "align") {
+		as, aok := u.A.(Series)
+		bs, bok := u.B.(Series)
+		if !aok || !bok {
+			continue
+		}
+		sorted := NewSortedSeries(as)
+		s := make(Series)
+		for k := range bs {
+			if v, ok := fillValue(as, sorted, k, "linear"); ok {
+				s[k] = v
+			}
+		}
+		r.Results = append(r.Results, &Result{
+			Value:        s,
+			Group:        u.Group,
+			Computations: u.Computations,
+		})
+	}
+	return r, nil
+}
+
+// Interpolate marks series so that arithmetic with another series fills in
+// timestamps that only one of them has, instead of dropping them. method is
+// "linear" or "ffill".
+func Interpolate(e *State, T miniprofiler.Timer, series *Results, method string) (*Results, error) {
+	switch method {
+	case "linear", "ffill":
+	default:
+		return nil, fmt.Errorf("interpolate: method must be linear or ffill, got %q", method)
+	}
+	series.SeriesFill = method
+	return series, nil
+}
+
+// fillValue returns the value of s at t. If s has no point at t, it is
+// filled by method: "ffill" uses the previous point and "linear" interpolates
+// between the previous and next point. sorted must be s sorted by time.
+// The boolean is false if no value can be given for t.
+func fillValue(s Series, sorted SortableSeries, t time.Time, method string) (float64, bool) {
+	if v, ok := s[t]; ok {
+		return v, true
+	}
+	i := sort.Search(len(sorted), func(i int) bool { return !sorted[i].T.Before(t) })
+	if i == 0 {
+		return 0, false
+	}
+	prev := sorted[i-1]
+	switch method {
+	case "ffill":
+		return prev.V, true
+	case "linear":
+		if i == len(sorted) {
+			return 0, false
+		}
+		next := sorted[i]
+		frac := float64(t.Sub(prev.T)) / float64(next.T.Sub(prev.T))
+		return prev.V + (next.V-prev.V)*frac, true
+	}
+	return 0, false
+}
+
 func Duration(e *State, T miniprofiler.Timer, d string) (*Results, error) {
 	duration, err := opentsdb.ParseDuration(d)
 	if err != nil {
@@ -927,6 +1129,155 @@ func Des(e *State, T miniprofiler.Timer, series *Results, alpha float64, beta fl
 	return series
 }
 
+// HW returns the values predicted by additive Holt-Winters (triple exponential
+// smoothing) for each point of the series after its first season. alpha, beta
+// and gamma are the smoothing factors for the level, trend and seasonal
+// components. season is the duration of a season, which is converted to a
+// number of points using the median interval between points. Series that are
+// too short to forecast, such as those of new hosts, are left out of the
+// results; it is an error only if none can be forecast.
+func HW(e *State, T miniprofiler.Timer, series *Results, alpha, beta, gamma float64, season string) (*Results, error) {
+	for _, f := range []float64{alpha, beta, gamma} {
+		if f < 0 || f > 1 {
+			return nil, fmt.Errorf("hw: alpha, beta and gamma must be between 0 and 1")
+		}
+	}
+	d, err := opentsdb.ParseDuration(season)
+	if err != nil {
+		return nil, err
+	}
+	var forecast ResultSlice
+	for _, res := range series.Results {
+		sorted := NewSortedSeries(res.Value.Value().(Series))
+		step := medianInterval(sorted)
+		if step <= 0 {
+			err = fmt.Errorf("hw: series %s needs at least two points", res.Group)
+			continue
+		}
+		l := int(time.Duration(d) / step)
+		if l < 2 {
+			err = fmt.Errorf("hw: season %s must span at least two points (interval of %s is %v)", season, res.Group, step)
+			continue
+		}
+		if len(sorted) < 2*l {
+			err = fmt.Errorf("hw: series %s needs at least two seasons of data (%d points, has %d)", res.Group, 2*l, len(sorted))
+			continue
+		}
+		res.Value = holtWinters(sorted, alpha, beta, gamma, l)
+		forecast = append(forecast, res)
+	}
+	if len(forecast) == 0 && len(series.Results) > 0 {
+		return nil, err
+	}
+	series.Results = forecast
+	return series, nil
+}
+
+// holtWinters returns the one step ahead predictions of additive Holt-Winters
+// with a season of l points. The level and trend are initialized from the first
+// two seasons, and the seasonal components from the first season.
+func holtWinters(sorted SortableSeries, alpha, beta, gamma float64, l int) Series {
+	var s1, s2 float64
+	for i := 0; i < l; i++ {
+		s1 += sorted[i].V
+		s2 += sorted[i+l].V
+	}
+	s1 /= float64(l)
+	s2 /= float64(l)
+	level := s1
+	trend := (s2 - s1) / float64(l)
+	seasonal := make([]float64, l)
+	for i := 0; i < l; i++ {
+		seasonal[i] = sorted[i].V - level
+	}
+	predicted := make(Series)
+	for i := l; i < len(sorted); i++ {
+		y := sorted[i].V
+		si := i % l
+		predicted[sorted[i].T] = level + trend + seasonal[si]
+		lastLevel := level
+		level = alpha*(y-seasonal[si]) + (1-alpha)*(level+trend)
+		trend = beta*(level-lastLevel) + (1-beta)*trend
+		seasonal[si] = gamma*(y-level) + (1-gamma)*seasonal[si]
+	}
+	return predicted
+}
+
+// medianInterval returns the median duration between consecutive points.
+func medianInterval(sorted SortableSeries) time.Duration {
+	if len(sorted) < 2 {
+		return 0
+	}
+	intervals := make([]float64, len(sorted)-1)
+	for i := 1; i < len(sorted); i++ {
+		intervals[i-1] = float64(sorted[i].T.Sub(sorted[i-1].T))
+	}
+	sort.Float64s(intervals)
+	return time.Duration(intervals[len(intervals)/2])
+}
+
+// ZScore returns how many standard deviations the last point of each series is
+// from the mean of the points in the window before it.
+func ZScore(e *State, T miniprofiler.Timer, series *Results, window string) (*Results, error) {
+	d, err := opentsdb.ParseDuration(window)
+	if err != nil {
+		return nil, err
+	}
+	return reduce(e, T, series, func(dps Series, args ...float64) float64 {
+		sorted := NewSortedSeries(dps)
+		return zscore(sorted, len(sorted)-1, time.Duration(d))
+	})
+}
+
+// Anomaly returns the number of consecutive points at the end of each series
+// that are more than sigma standard deviations from the mean of the points in the
+// window before each of them.
+func Anomaly(e *State, T miniprofiler.Timer, series *Results, window string, sigma float64) (*Results, error) {
+	d, err := opentsdb.ParseDuration(window)
+	if err != nil {
+		return nil, err
+	}
+	return reduce(e, T, series, func(dps Series, args ...float64) float64 {
+		sorted := NewSortedSeries(dps)
+		count := 0
+		for i := len(sorted) - 1; i >= 0; i-- {
+			z := zscore(sorted, i, time.Duration(d))
+			if math.IsNaN(z) || math.Abs(z) <= sigma {
+				break
+			}
+			count++
+		}
+		return float64(count)
+	})
+}
+
+// zscore returns the standard score of the i-th point of sorted relative to the
+// points within window before it. NaN is returned if fewer than two points are in
+// the window.
+func zscore(sorted SortableSeries, i int, window time.Duration) float64 {
+	if i < 0 {
+		return math.NaN()
+	}
+	start := sorted[i].T.Add(-window)
+	baseline := make(Series)
+	for j := i - 1; j >= 0 && !sorted[j].T.Before(start); j-- {
+		baseline[sorted[j].T] = sorted[j].V
+	}
+	if len(baseline) < 2 {
+		return math.NaN()
+	}
+	mean := avg(baseline)
+	sd := dev(baseline)
+	diff := sorted[i].V - mean
+	if sd == 0 {
+		if diff == 0 {
+			return 0
+		}
+		return math.Inf(int(diff / math.Abs(diff)))
+	}
+	return diff / sd
+}
+
 func Streak(e *State, T miniprofiler.Timer, series *Results) (*Results, error) {
 	return reduce(e, T, series, streak)
 }
@@ -1118,11 +1469,15 @@ func Max(e *State, T miniprofiler.Timer, series *Results) (r *Results, err error
 // percentile returns the value at the corresponding percentile between 0 and 1.
 // Min and Max can be simulated using p <= 0 and p >= 1, respectively.
 func percentile(dps Series, args ...float64) (a float64) {
-	p := args[0]
 	var x []float64
 	for _, v := range dps {
 		x = append(x, float64(v))
 	}
+	return percentileOf(x, args[0])
+}
+
+// percentileOf is percentile for the values x, which it sorts.
+func percentileOf(x []float64, p float64) float64 {
 	sort.Float64s(x)
 	if p <= 0 {
 		return x[0]
@@ -1180,6 +1535,115 @@ func AddTags(e *State, T miniprofiler.Timer, series *Results, s string) (*Result
 	return series, nil
 }
 
+// Aggr combines the series of each group formed by the tag keys in groups (a
+// comma separated list, empty for a single group) into one series. The series
+// of a group are first aligned on the union of their timestamps, each one
+// linearly interpolated within its own range. At each timestamp the aligned
+// values are then reduced by aggregator, which is one of avg, sum, min, max,
+// median, count or pN (the Nth percentile, i.e. p95 or p99.9).
+func Aggr(e *State, T miniprofiler.Timer, series *Results, groups, aggregator string) (*Results, error) {
+	f, err := aggregatorFunc(aggregator)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, k := range strings.Split(groups, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			keys = append(keys, k)
+		}
+	}
+	type aggrGroup struct {
+		group  opentsdb.TagSet
+		series []Series
+		times  map[time.Time]bool
+	}
+	grouped := make(map[string]*aggrGroup)
+	var order []string
+	for _, res := range series.Results {
+		ts := make(opentsdb.TagSet)
+		for _, k := range keys {
+			v, ok := res.Group[k]
+			if !ok {
+				return nil, fmt.Errorf("aggr: tag key %v not found in %v", k, res.Group)
+			}
+			ts[k] = v
+		}
+		g, ok := grouped[ts.String()]
+		if !ok {
+			g = &aggrGroup{group: ts, times: make(map[time.Time]bool)}
+			grouped[ts.String()] = g
+			order = append(order, ts.String())
+		}
+		s := res.Value.Value().(Series)
+		g.series = append(g.series, s)
+		for t := range s {
+			g.times[t] = true
+		}
+	}
+	r := new(Results)
+	for _, k := range order {
+		g := grouped[k]
+		sorted := make([]SortableSeries, len(g.series))
+		for i, s := range g.series {
+			sorted[i] = NewSortedSeries(s)
+		}
+		s := make(Series, len(g.times))
+		values := make([]float64, 0, len(g.series))
+		for t := range g.times {
+			values = values[:0]
+			for i, gs := range g.series {
+				if v, ok := fillValue(gs, sorted[i], t, "linear"); ok {
+					values = append(values, v)
+				}
+			}
+			s[t] = f(values)
+		}
+		r.Results = append(r.Results, &Result{
+			Value: s,
+			Group: g.group,
+		})
+	}
+	return r, nil
+}
+
+// aggregatorFunc returns the function that reduces values for the named
+// aggregator. It may reorder values.
+func aggregatorFunc(aggregator string) (func([]float64) float64, error) {
+	switch aggregator {
+	case "avg":
+		return func(x []float64) float64 {
+			var a float64
+			for _, v := range x {
+				a += v
+			}
+			return a / float64(len(x))
+		}, nil
+	case "sum":
+		return func(x []float64) float64 {
+			var a float64
+			for _, v := range x {
+				a += v
+			}
+			return a
+		}, nil
+	case "count":
+		return func(x []float64) float64 { return float64(len(x)) }, nil
+	case "min":
+		return func(x []float64) float64 { return percentileOf(x, 0) }, nil
+	case "max":
+		return func(x []float64) float64 { return percentileOf(x, 1) }, nil
+	case "median":
+		return func(x []float64) float64 { return percentileOf(x, .5) }, nil
+	}
+	if strings.HasPrefix(aggregator, "p") {
+		p, err := strconv.ParseFloat(aggregator[1:], 64)
+		if err == nil && p >= 0 && p <= 100 {
+			return func(x []float64) float64 { return percentileOf(x, p/100) }, nil
+		}
+	}
+	return nil, fmt.Errorf("aggr: unknown aggregator %q, must be avg, sum, min, max, median, count or pN", aggregator)
+}
+
 func Ungroup(e *State, T miniprofiler.Timer, d *Results) (*Results, error) {
 	if len(d.Results) != 1 {
 		return nil, fmt.Errorf("ungroup: requires exactly one group")
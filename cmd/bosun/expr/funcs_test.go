@@ -2,6 +2,7 @@ package expr
 
 import (
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -257,3 +258,321 @@ func TestTail(t *testing.T) {
 		}
 	}
 }
+
+func TestHW(t *testing.T) {
+	// A series that repeats exactly every season is predicted exactly.
+	err := testExpression(exprInOut{
+		`hw(series("foo=bar", 0, 1, 60, 2, 120, 3, 180, 4, 240, 1, 300, 2, 360, 3, 420, 4, 480, 1, 540, 2), .5, .5, .5, "4m")`,
+		Results{
+			Results: ResultSlice{
+				&Result{
+					Value: Series{
+						time.Unix(240, 0): 1,
+						time.Unix(300, 0): 2,
+						time.Unix(360, 0): 3,
+						time.Unix(420, 0): 4,
+						time.Unix(480, 0): 1,
+						time.Unix(540, 0): 2,
+					},
+					Group: opentsdb.TagSet{"foo": "bar"},
+				},
+			},
+		},
+		false,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	// Less than two seasons of data
+	err = testExpression(exprInOut{
+		`hw(series("foo=bar", 0, 1, 60, 2, 120, 3, 180, 4, 240, 1), .5, .5, .5, "4m")`,
+		Results{},
+		false,
+	})
+	if err == nil {
+		t.Error("expected error for series shorter than two seasons")
+	}
+
+	// A short series of a new host does not stop the others from being forecast
+	err = testExpression(exprInOut{
+		`hw(merge(series("host=a", 0, 1, 60, 2, 120, 3, 180, 4, 240, 1, 300, 2, 360, 3, 420, 4), series("host=b", 0, 1, 60, 2)), .5, .5, .5, "4m")`,
+		Results{
+			Results: ResultSlice{
+				&Result{
+					Value: Series{
+						time.Unix(240, 0): 1,
+						time.Unix(300, 0): 2,
+						time.Unix(360, 0): 3,
+						time.Unix(420, 0): 4,
+					},
+					Group: opentsdb.TagSet{"host": "a"},
+				},
+			},
+		},
+		false,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZScoreAnomaly(t *testing.T) {
+	const data = `series("foo=bar", 0, 1, 60, 2, 120, 1, 180, 2, 240, 1, 300, 2, 360, 10, 420, 11)`
+	tests := []struct {
+		expr   string
+		expect float64
+	}{
+		{fmt.Sprintf(`zscore(%s, "5m")`, data), (11 - 3.2) / math.Sqrt(14.7)},
+		{fmt.Sprintf(`zscore(%s, "1m")`, data), math.NaN()},
+		{fmt.Sprintf(`anomaly(%s, "5m", 2)`, data), 2},
+		{fmt.Sprintf(`anomaly(%s, "5m", 3)`, data), 0},
+	}
+	for _, test := range tests {
+		e, err := New(test.expr, builtins)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, _, err := e.Execute(&Backends{}, &BosunProviders{}, nil, queryTime, 0, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r.Results) != 1 {
+			t.Fatalf("%v: expected one result, got %v", test.expr, len(r.Results))
+		}
+		got := float64(r.Results[0].Value.(Number))
+		if math.IsNaN(test.expect) {
+			if !math.IsNaN(got) {
+				t.Errorf("%v: expected NaN, got %v", test.expr, got)
+			}
+		} else if math.Abs(got-test.expect) > 1e-9 {
+			t.Errorf("%v: expected %v, got %v", test.expr, test.expect, got)
+		}
+	}
+}
+
+func TestAggr(t *testing.T) {
+	seriesA := `series("dc=ny,host=a", 0, 1, 60, 2, 120, 3)`
+	seriesB := `series("dc=ny,host=b", 0, 3, 60, 6)`
+	seriesC := `series("dc=la,host=c", 0, 10, 60, 20)`
+	merged := fmt.Sprintf("merge(%v, %v, %v)", seriesA, seriesB, seriesC)
+	tests := []exprInOut{
+		{
+			fmt.Sprintf(`aggr(%v, "dc", "sum")`, merged),
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Series{
+							time.Unix(0, 0):   4,
+							time.Unix(60, 0):  8,
+							time.Unix(120, 0): 3,
+						},
+						Group: opentsdb.TagSet{"dc": "ny"},
+					},
+					&Result{
+						Value: Series{
+							time.Unix(0, 0):  10,
+							time.Unix(60, 0): 20,
+						},
+						Group: opentsdb.TagSet{"dc": "la"},
+					},
+				},
+			},
+			false,
+		},
+		{
+			fmt.Sprintf(`aggr(%v, "", "p95")`, merged),
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Series{
+							time.Unix(0, 0):   10,
+							time.Unix(60, 0):  20,
+							time.Unix(120, 0): 3,
+						},
+						Group: opentsdb.TagSet{},
+					},
+				},
+			},
+			false,
+		},
+		{
+			fmt.Sprintf(`aggr(%v, "dc", "avg")`, merged),
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Series{
+							time.Unix(0, 0):   2,
+							time.Unix(60, 0):  4,
+							time.Unix(120, 0): 3,
+						},
+						Group: opentsdb.TagSet{"dc": "ny"},
+					},
+					&Result{
+						Value: Series{
+							time.Unix(0, 0):  10,
+							time.Unix(60, 0): 20,
+						},
+						Group: opentsdb.TagSet{"dc": "la"},
+					},
+				},
+			},
+			false,
+		},
+		{
+			// series with offset timestamps are interpolated within
+			// their range
+			`aggr(merge(series("host=a", 0, 1, 60, 3), series("host=b", 30, 10, 90, 20)), "", "sum")`,
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Series{
+							time.Unix(0, 0):  1,
+							time.Unix(30, 0): 12,
+							time.Unix(60, 0): 18,
+							time.Unix(90, 0): 20,
+						},
+						Group: opentsdb.TagSet{},
+					},
+				},
+			},
+			false,
+		},
+		{
+			fmt.Sprintf(`aggr(%v, "dc, host", "max")`, seriesA),
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Series{
+							time.Unix(0, 0):   1,
+							time.Unix(60, 0):  2,
+							time.Unix(120, 0): 3,
+						},
+						Group: opentsdb.TagSet{"dc": "ny", "host": "a"},
+					},
+				},
+			},
+			false,
+		},
+		{
+			fmt.Sprintf(`aggr(%v, "host", "count")`, seriesA),
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: Series{
+							time.Unix(0, 0):   1,
+							time.Unix(60, 0):  1,
+							time.Unix(120, 0): 1,
+						},
+						Group: opentsdb.TagSet{"host": "a"},
+					},
+				},
+			},
+			false,
+		},
+	}
+	for _, test := range tests {
+		if err := testExpression(test); err != nil {
+			t.Errorf("%v: %v", test.expr, err)
+		}
+	}
+	if err := testExpression(exprInOut{fmt.Sprintf(`aggr(%v, "dc", "p101")`, merged), Results{}, false}); err == nil {
+		t.Error("expected error for invalid aggregator")
+	}
+	if err := testExpression(exprInOut{fmt.Sprintf(`aggr(%v, "rack", "sum")`, merged), Results{}, false}); err == nil {
+		t.Error("expected error for missing group tag")
+	}
+}
+
+func TestResample(t *testing.T) {
+	data := `series("foo=bar", 0, 1, 30, 3, 60, 5, 240, 8)`
+	tests := []struct {
+		expr     string
+		expected Series
+	}{
+		{
+			fmt.Sprintf(`resample(%v, "1m", "avg", "none")`, data),
+			Series{time.Unix(0, 0): 2, time.Unix(60, 0): 5, time.Unix(240, 0): 8},
+		},
+		{
+			fmt.Sprintf(`resample(%v, "1m", "sum", "zero")`, data),
+			Series{time.Unix(0, 0): 4, time.Unix(60, 0): 5, time.Unix(120, 0): 0, time.Unix(180, 0): 0, time.Unix(240, 0): 8},
+		},
+		{
+			fmt.Sprintf(`resample(%v, "1m", "last", "ffill")`, data),
+			Series{time.Unix(0, 0): 3, time.Unix(60, 0): 5, time.Unix(120, 0): 5, time.Unix(180, 0): 5, time.Unix(240, 0): 8},
+		},
+		{
+			fmt.Sprintf(`resample(%v, "1m", "last", "linear")`, data),
+			Series{time.Unix(0, 0): 3, time.Unix(60, 0): 5, time.Unix(120, 0): 6, time.Unix(180, 0): 7, time.Unix(240, 0): 8},
+		},
+	}
+	for _, test := range tests {
+		err := testExpression(exprInOut{
+			test.expr,
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: test.expected,
+						Group: opentsdb.TagSet{"foo": "bar"},
+					},
+				},
+			},
+			false,
+		})
+		if err != nil {
+			t.Errorf("%v: %v", test.expr, err)
+		}
+	}
+	if err := testExpression(exprInOut{fmt.Sprintf(`resample(%v, "1m", "max", "none")`, data), Results{}, false}); err == nil {
+		t.Error("expected error for invalid reducer")
+	}
+}
+
+func TestAlignInterpolate(t *testing.T) {
+	seriesA := `series("foo=bar", 0, 0, 60, 6, 120, 12)`
+	seriesB := `series("foo=bar", 30, 1, 60, 2, 150, 3)`
+	tests := []struct {
+		expr     string
+		expected Series
+	}{
+		{
+			fmt.Sprintf(`align(%v, %v)`, seriesA, seriesB),
+			Series{time.Unix(30, 0): 3, time.Unix(60, 0): 6},
+		},
+		{
+			fmt.Sprintf(`align(%v, %v) / %v`, seriesA, seriesB, seriesB),
+			Series{time.Unix(30, 0): 3, time.Unix(60, 0): 3},
+		},
+		{
+			// without interpolation only matching timestamps are kept
+			fmt.Sprintf(`%v + %v`, seriesA, seriesB),
+			Series{time.Unix(60, 0): 8},
+		},
+		{
+			fmt.Sprintf(`interpolate(%v, "linear") + %v`, seriesA, seriesB),
+			Series{time.Unix(30, 0): 4, time.Unix(60, 0): 8, time.Unix(120, 0): 14.6666666666666667},
+		},
+		{
+			fmt.Sprintf(`%v + interpolate(%v, "ffill")`, seriesA, seriesB),
+			Series{time.Unix(30, 0): 1, time.Unix(60, 0): 8, time.Unix(120, 0): 14, time.Unix(150, 0): 15},
+		},
+	}
+	for _, test := range tests {
+		err := testExpression(exprInOut{
+			test.expr,
+			Results{
+				Results: ResultSlice{
+					&Result{
+						Value: test.expected,
+						Group: opentsdb.TagSet{"foo": "bar"},
+					},
+				},
+			},
+			false,
+		})
+		if err != nil {
+			t.Errorf("%v: %v", test.expr, err)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package expr
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -27,6 +28,12 @@ var Graphite = map[string]parse.Func{
 		Tags:   graphiteTagQuery,
 		F:      GraphiteQuery,
 	},
+	"graphiteTagged": {
+		Args:   []models.FuncType{models.TypeString, models.TypeString, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   graphiteTaggedTagQuery,
+		F:      GraphiteTaggedQuery,
+	},
 }
 
 func parseGraphiteResponse(req *graphite.Request, s *graphite.Response, formatTags []string) ([]*Result, error) {
@@ -39,10 +46,13 @@ func parseGraphiteResponse(req *graphite.Request, s *graphite.Response, formatTa
 	for _, res := range *s {
 		// build tag set
 		tags := make(opentsdb.TagSet)
+		name, seriesTags := graphiteSeriesTags(res)
 		if len(formatTags) == 1 && formatTags[0] == "" {
-			tags["key"] = res.Target
+			if len(seriesTags) == 0 {
+				tags["key"] = res.Target
+			}
 		} else {
-			nodes := strings.Split(res.Target, ".")
+			nodes := strings.Split(name, ".")
 			if len(nodes) < len(formatTags) {
 				msg := fmt.Sprintf("returned target '%s' does not match format '%s'", res.Target, strings.Join(formatTags, ","))
 				return nil, fmt.Errorf(parseErrFmt, req.URL, msg)
@@ -53,6 +63,11 @@ func parseGraphiteResponse(req *graphite.Request, s *graphite.Response, formatTa
 				}
 			}
 		}
+		for k, v := range seriesTags {
+			if _, ok := tags[k]; !ok {
+				tags[k] = v
+			}
+		}
 		if !tags.Valid() {
 			msg := fmt.Sprintf("returned target '%s' would make an invalid tag '%s'", res.Target, tags.String())
 			return nil, fmt.Errorf(parseErrFmt, req.URL, msg)
@@ -93,6 +108,34 @@ func parseGraphiteResponse(req *graphite.Request, s *graphite.Response, formatTa
 	return results, nil
 }
 
+// graphiteSeriesTags returns the name and tags of a tagged series. Graphite 1.1+
+// returns these in the tags object of each series, and also encodes them in
+// the target as "name;key=value;key2=value2". Untagged series return their
+// target as name and no tags.
+func graphiteSeriesTags(res graphite.Series) (name string, tags map[string]string) {
+	if len(res.Tags) > 0 {
+		name = res.Tags["name"]
+		if name == "" {
+			name = res.Target
+		}
+		return name, res.Tags
+	}
+	parts := strings.Split(res.Target, ";")
+	if len(parts) == 1 {
+		return res.Target, nil
+	}
+	tags = map[string]string{"name": parts[0]}
+	for _, p := range parts[1:] {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 {
+			// not a tagged series, the target just contains a semicolon
+			return res.Target, nil
+		}
+		tags[kv[0]] = kv[1]
+	}
+	return parts[0], tags
+}
+
 func GraphiteBand(e *State, T miniprofiler.Timer, query, duration, period, format string, num float64) (r *Results, err error) {
 	r = new(Results)
 	r.IgnoreOtherUnjoined = true
@@ -198,6 +241,12 @@ func GraphiteQuery(e *State, T miniprofiler.Timer, query string, sduration, edur
 	return
 }
 
+// GraphiteTaggedQuery queries Graphite for tagged series (i.e. a seriesByTag()
+// expression). The tags of each returned series become its group.
+func GraphiteTaggedQuery(e *State, T miniprofiler.Timer, query string, sduration, eduration string) (r *Results, err error) {
+	return GraphiteQuery(e, T, query, sduration, eduration, "")
+}
+
 func graphiteTagQuery(args []parse.Node) (parse.Tags, error) {
 	t := make(parse.Tags)
 	n := args[3].(*parse.StringNode)
@@ -209,17 +258,128 @@ func graphiteTagQuery(args []parse.Node) (parse.Tags, error) {
 	return t, nil
 }
 
+var graphiteTagExprRE = regexp.MustCompile(`^\s*([^!=~]+?)\s*(!=~|=~|!=|=)`)
+
+// graphiteTaggedTagQuery works out the group keys of a graphiteTagged() query.
+// Tagged series always have a name tag. The keys of groupByTags() are used if the
+// query groups by tags, otherwise the tags matched in seriesByTag().
+func graphiteTaggedTagQuery(args []parse.Node) (parse.Tags, error) {
+	n := args[0].(*parse.StringNode)
+	t := parse.Tags{"name": struct{}{}}
+	var walk func(s string) error
+	walk = func(s string) error {
+		name, fargs, err := graphiteParseCall(s)
+		if err != nil || name == "" {
+			return err
+		}
+		switch name {
+		case "seriesByTag":
+			for _, a := range fargs {
+				if m := graphiteTagExprRE.FindStringSubmatch(graphiteUnquote(a)); m != nil {
+					t[m[1]] = struct{}{}
+				}
+			}
+			return nil
+		case "groupByTags":
+			if len(fargs) < 3 {
+				return fmt.Errorf("graphiteTagged: groupByTags requires at least one tag")
+			}
+			for _, a := range fargs[2:] {
+				t[graphiteUnquote(a)] = struct{}{}
+			}
+			return nil
+		}
+		for _, a := range fargs {
+			if err := walk(a); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(n.Text); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// graphiteParseCall splits a graphite function call into its name and
+// arguments. If s is not a function call (i.e. a string or number argument)
+// the returned name is empty.
+func graphiteParseCall(s string) (name string, args []string, err error) {
+	s = strings.TrimSpace(s)
+	open := strings.IndexByte(s, '(')
+	if open <= 0 || !strings.HasSuffix(s, ")") || strings.ContainsAny(s[:open], "'\",") {
+		return "", nil, nil
+	}
+	name = strings.TrimSpace(s[:open])
+	depth := 0
+	var quote rune
+	start := open + 1
+	body := s[:len(s)-1]
+	for i, c := range body {
+		if i <= open {
+			continue
+		}
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return "", nil, fmt.Errorf("graphite: unbalanced parentheses in %s", s)
+			}
+		case c == ',' && depth == 0:
+			args = append(args, strings.TrimSpace(body[start:i]))
+			start = i + 1
+		}
+	}
+	if depth != 0 || quote != 0 {
+		return "", nil, fmt.Errorf("graphite: unbalanced parentheses or quotes in %s", s)
+	}
+	if a := strings.TrimSpace(body[start:]); a != "" || len(args) > 0 {
+		args = append(args, a)
+	}
+	return name, args, nil
+}
+
+// graphiteUnquote removes the quotes around a graphite string argument.
+func graphiteUnquote(s string) string {
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
 func timeGraphiteRequest(e *State, T miniprofiler.Timer, req *graphite.Request) (resp graphite.Response, err error) {
 	e.graphiteQueries = append(e.graphiteQueries, *req)
 	b, _ := json.MarshalIndent(req, "", "  ")
 	T.StepCustomTiming("graphite", "query", string(b), func() {
-		key := req.CacheKey()
 		getFn := func() (interface{}, error) {
 			return e.GraphiteContext.Query(req)
 		}
 		var val interface{}
-		val, err = e.Cache.Get(key, getFn)
+		val, err = e.Cache.GetRelative("graphite", graphiteCacheKey(req, e.now), e.now, getFn)
 		resp = val.(graphite.Response)
 	})
 	return
 }
+
+// graphiteCacheKey returns the cache key of req with its times relative to
+// now, so that the same query of a later check can use the cached result.
+func graphiteCacheKey(req *graphite.Request, now time.Time) string {
+	var start, end int64
+	if req.Start != nil {
+		start = req.Start.Unix() - now.Unix()
+	}
+	if req.End != nil {
+		end = req.End.Unix() - now.Unix()
+	}
+	targets, _ := json.Marshal(req.Targets)
+	return fmt.Sprintf("%d-%d-%s", start, end, targets)
+}
@@ -0,0 +1,93 @@
+package expr
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/graphite"
+)
+
+func TestGraphiteTaggedQuery(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if target := r.FormValue("target"); target != "seriesByTag('name=disk.used','server=~web.*')" {
+			t.Errorf("unexpected target: %v", target)
+		}
+		fmt.Fprint(w, `[
+			{"target": "disk.used;rack=a1;server=web01", "tags": {"name": "disk.used", "rack": "a1", "server": "web01"}, "datapoints": [[1, 1483228800], [null, 1483228860]]},
+			{"target": "disk.used;rack=a2;server=web02", "datapoints": [[2, 1483228800]]}
+		]`)
+	}))
+	defer ts.Close()
+
+	e, err := New(`graphiteTagged("seriesByTag('name=disk.used','server=~web.*')", "1h", "")`, Graphite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backends := &Backends{
+		GraphiteContext: graphite.Host(ts.URL),
+	}
+	res, _, err := e.Execute(backends, &BosunProviders{}, nil, time.Unix(1483228900, 0), 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]Series{
+		"name=disk.used,rack=a1,server=web01": {time.Unix(1483228800, 0): 1},
+		"name=disk.used,rack=a2,server=web02": {time.Unix(1483228800, 0): 2},
+	}
+	if len(res.Results) != len(expected) {
+		t.Fatalf("got %v results, expected %v", len(res.Results), len(expected))
+	}
+	for _, r := range res.Results {
+		ex, ok := expected[r.Group.Tags()]
+		if !ok {
+			t.Errorf("unexpected group %v", r.Group)
+			continue
+		}
+		if !r.Value.(Series).Equal(ex) {
+			t.Errorf("%v: got %v, expected %v", r.Group, r.Value, ex)
+		}
+	}
+}
+
+func TestParseGraphiteResponseTagged(t *testing.T) {
+	req := &graphite.Request{}
+	resp := graphite.Response{
+		{Target: "collectd.web01.cpu;dc=ny"},
+		{Target: "collectd.web02.cpu;dc=ny"},
+	}
+	results, err := parseGraphiteResponse(req, &resp, []string{"", "host", ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, expect := range []string{"dc=ny,host=web01,name=collectd.web01.cpu", "dc=ny,host=web02,name=collectd.web02.cpu"} {
+		if got := results[i].Group.Tags(); got != expect {
+			t.Errorf("got %v, expected %v", got, expect)
+		}
+	}
+}
+
+func TestGraphiteTaggedTagQuery(t *testing.T) {
+	tests := map[string]string{
+		`seriesByTag('name=disk.used','server=~web.*')`:                                   "name,server",
+		`sumSeries(seriesByTag("name=disk.used", "dc!=ny", "rack=~a(1|2)"))`:              "dc,name,rack",
+		`groupByTags(seriesByTag('name=disk.used','server=~web.*'), 'sum', 'dc', 'rack')`: "dc,name,rack",
+		`disk.used`: "name",
+	}
+	for q, expect := range tests {
+		tags, err := graphiteTaggedTagQuery([]parse.Node{&parse.StringNode{Text: q}})
+		if err != nil {
+			t.Errorf("%v: %v", q, err)
+			continue
+		}
+		if tags.String() != expect {
+			t.Errorf("%v: got %v, expected %v", q, tags, expect)
+		}
+	}
+	if _, err := graphiteTaggedTagQuery([]parse.Node{&parse.StringNode{Text: `groupByTags(seriesByTag('name=a', 'sum')`}}); err == nil {
+		t.Error("expected error for unbalanced parentheses")
+	}
+}
@@ -3,6 +3,8 @@ package expr
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -23,6 +25,12 @@ var Influx = map[string]parse.Func{
 		Tags:   influxTag,
 		F:      InfluxQuery,
 	},
+	"iq": {
+		Args:   []models.FuncType{models.TypeString, models.TypeString, models.TypeString, models.TypeString, models.TypeString, models.TypeString, models.TypeString, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   influxIQTag,
+		F:      InfluxIQ,
+	},
 }
 
 func influxTag(args []parse.Node) (parse.Tags, error) {
@@ -50,45 +58,222 @@ func InfluxQuery(e *State, T miniprofiler.Timer, db, query, startDuration, endDu
 	if err != nil {
 		return nil, err
 	}
-	r := new(Results)
 	for _, row := range qres {
-		tags := opentsdb.TagSet(row.Tags)
-		if e.Squelched(tags) {
-			continue
-		}
 		if len(row.Columns) != 2 {
 			return nil, fmt.Errorf("influx: expected exactly one result column")
 		}
-		values := make(Series, len(row.Values))
-		for _, v := range row.Values {
-			if len(v) != 2 {
-				return nil, fmt.Errorf("influx: expected exactly one result column")
+	}
+	return influxResults(e, qres, false)
+}
+
+// InfluxIQ builds a select statement for the given measurement, fields and tags
+// and queries InfluxDB with it. Tags are filtered and grouped the way q() does with
+// OpenTSDB: "host=*" groups by host, "host=ny-*" and "host=ny-web01|ny-web02" also
+// filter on host. When field lists more than one field, each field is returned as
+// its own series tagged with field=<name>.
+func InfluxIQ(e *State, T miniprofiler.Timer, db, measurement, field, aggregator, tags, startDuration, endDuration, groupByInterval string) (*Results, error) {
+	query, err := influxBuildQuery(measurement, field, aggregator, tags)
+	if err != nil {
+		return nil, err
+	}
+	qres, err := timeInfluxRequest(e, T, db, query, startDuration, endDuration, groupByInterval)
+	if err != nil {
+		return nil, err
+	}
+	return influxResults(e, qres, len(influxFields(field)) > 1)
+}
+
+// influxResults converts the rows returned by InfluxDB to results. Each value
+// column of a row becomes a series. If fieldTag is true the column name is added
+// to the group of the series as the field tag.
+func influxResults(e *State, rows []influxModels.Row, fieldTag bool) (*Results, error) {
+	r := new(Results)
+	for _, row := range rows {
+		if len(row.Columns) < 2 {
+			return nil, fmt.Errorf("influx: expected at least one result column")
+		}
+		for col := 1; col < len(row.Columns); col++ {
+			tags := opentsdb.TagSet(row.Tags).Copy()
+			if fieldTag {
+				tags["field"] = row.Columns[col]
 			}
-			ts, ok := v[0].(string)
-			if !ok {
-				return nil, fmt.Errorf("influx: expected time string column")
+			if e.Squelched(tags) {
+				continue
 			}
-			t, err := time.Parse(time.RFC3339, ts)
-			if err != nil {
-				return nil, err
+			values := make(Series, len(row.Values))
+			for _, v := range row.Values {
+				if len(v) != len(row.Columns) {
+					return nil, fmt.Errorf("influx: expected %d result columns", len(row.Columns))
+				}
+				ts, ok := v[0].(string)
+				if !ok {
+					return nil, fmt.Errorf("influx: expected time string column")
+				}
+				t, err := time.Parse(time.RFC3339, ts)
+				if err != nil {
+					return nil, err
+				}
+				if v[col] == nil {
+					// no value for this field at this time
+					continue
+				}
+				n, ok := v[col].(json.Number)
+				if !ok {
+					return nil, fmt.Errorf("influx: expected json.Number")
+				}
+				f, err := n.Float64()
+				if err != nil {
+					return nil, fmt.Errorf("influx: bad number: %v", err)
+				}
+				values[t] = f
+			}
+			r.Results = append(r.Results, &Result{
+				Value: values,
+				Group: tags,
+			})
+		}
+	}
+	return r, nil
+}
+
+func influxIQTag(args []parse.Node) (parse.Tags, error) {
+	t := make(parse.Tags)
+	ts, err := influxTagSet(args[4].(*parse.StringNode).Text)
+	if err != nil {
+		return nil, err
+	}
+	for k := range ts {
+		t[k] = struct{}{}
+	}
+	if len(influxFields(args[2].(*parse.StringNode).Text)) > 1 {
+		t["field"] = struct{}{}
+	}
+	return t, nil
+}
+
+// influxFields splits a comma separated list of fields.
+func influxFields(field string) []string {
+	var fields []string
+	for _, f := range strings.Split(field, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// influxTagSet parses tags of the form k=v,m=o. Unlike opentsdb.ParseTags,
+// values may contain "*" wildcards anywhere, not only as the whole value.
+func influxTagSet(tags string) (opentsdb.TagSet, error) {
+	if strings.TrimSpace(tags) == "" {
+		return opentsdb.TagSet{}, nil
+	}
+	ts, err := opentsdb.ParseTags(tags)
+	if ts == nil {
+		return nil, err
+	}
+	for k, v := range ts {
+		if !opentsdb.ValidTSDBString(k) {
+			return nil, fmt.Errorf("influx: invalid character in tag key %s", k)
+		}
+		for _, alt := range strings.Split(v, "|") {
+			if alt == "" || !opentsdb.ValidTSDBString(strings.Replace(alt, "*", "x", -1)) {
+				return nil, fmt.Errorf("influx: invalid character in tag value %s", v)
 			}
-			n, ok := v[1].(json.Number)
-			if !ok {
-				return nil, fmt.Errorf("influx: expected json.Number")
+		}
+	}
+	return ts, nil
+}
+
+var influxAggregatorRE = regexp.MustCompile(`^[a-zA-Z_]+$`)
+
+// influxBuildQuery returns an InfluxQL select statement for the fields of
+// measurement. Each field is reduced by aggregator (unless it is empty) and
+// aliased to its own name. The statement is filtered and grouped by tags, which
+// use the OpenTSDB tag syntax. Time conditions are added by influxQueryDuration.
+func influxBuildQuery(measurement, field, aggregator, tags string) (string, error) {
+	if measurement == "" {
+		return "", fmt.Errorf("influx: measurement must not be empty")
+	}
+	fields := influxFields(field)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("influx: at least one field is required")
+	}
+	if aggregator != "" && !influxAggregatorRE.MatchString(aggregator) {
+		return "", fmt.Errorf("influx: invalid aggregator %q", aggregator)
+	}
+	ts, err := influxTagSet(tags)
+	if err != nil {
+		return "", err
+	}
+	s := &influxql.SelectStatement{
+		Sources: influxql.Sources{&influxql.Measurement{Name: measurement}},
+		Fill:    influxql.NoFill,
+	}
+	for _, f := range fields {
+		var ex influxql.Expr = &influxql.VarRef{Val: f}
+		if aggregator != "" {
+			ex = &influxql.Call{Name: aggregator, Args: []influxql.Expr{ex}}
+		}
+		s.Fields = append(s.Fields, &influxql.Field{Expr: ex, Alias: f})
+	}
+	keys := make([]string, 0, len(ts))
+	for k := range ts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s.Dimensions = append(s.Dimensions, &influxql.Dimension{Expr: &influxql.VarRef{Val: k}})
+		cond, err := influxTagCondition(k, ts[k])
+		if err != nil {
+			return "", err
+		}
+		if cond == nil {
+			continue
+		}
+		if s.Condition == nil {
+			s.Condition = cond
+		} else {
+			s.Condition = &influxql.BinaryExpr{Op: influxql.AND, LHS: s.Condition, RHS: cond}
+		}
+	}
+	return s.String(), nil
+}
+
+// influxTagCondition returns the condition matching the OpenTSDB style tag value
+// v for tag key k. A "*" value matches everything and yields a nil condition,
+// values with "|" match any of the alternatives and "*" inside a value is a
+// wildcard.
+func influxTagCondition(k, v string) (influxql.Expr, error) {
+	if v == "*" {
+		return nil, nil
+	}
+	var cond influxql.Expr
+	for _, alt := range strings.Split(v, "|") {
+		var c influxql.Expr
+		if strings.Contains(alt, "*") {
+			parts := strings.Split(alt, "*")
+			for i := range parts {
+				parts[i] = regexp.QuoteMeta(parts[i])
 			}
-			f, err := n.Float64()
+			re, err := regexp.Compile("^" + strings.Join(parts, ".*") + "$")
 			if err != nil {
-				return nil, fmt.Errorf("influx: bad number: %v", err)
+				return nil, err
 			}
-			values[t] = f
+			c = &influxql.BinaryExpr{Op: influxql.EQREGEX, LHS: &influxql.VarRef{Val: k}, RHS: &influxql.RegexLiteral{Val: re}}
+		} else {
+			c = &influxql.BinaryExpr{Op: influxql.EQ, LHS: &influxql.VarRef{Val: k}, RHS: &influxql.StringLiteral{Val: alt}}
+		}
+		if cond == nil {
+			cond = c
+		} else {
+			cond = &influxql.BinaryExpr{Op: influxql.OR, LHS: cond, RHS: c}
 		}
-		r.Results = append(r.Results, &Result{
-			Value: values,
-			Group: tags,
-		})
 	}
-	_ = r
-	return r, nil
+	if _, ok := cond.(*influxql.BinaryExpr); ok && strings.Contains(v, "|") {
+		cond = &influxql.ParenExpr{Expr: cond}
+	}
+	return cond, nil
 }
 
 // influxQueryDuration adds time WHERE clauses to query for the given start and end durations.
@@ -187,6 +372,13 @@ func influxQueryDuration(now time.Time, query, start, end, groupByInterval strin
 	return s.String(), nil
 }
 
+// influxCacheKey returns the cache key of a query. Unlike the query that is
+// sent, it has the durations of the query rather than its times, so that the
+// same query of a later check can use the cached result.
+func influxCacheKey(db, query, startDuration, endDuration, groupByInterval string) string {
+	return fmt.Sprintf("%s\n%s\n%s\n%s\n%s", db, query, startDuration, endDuration, groupByInterval)
+}
+
 func timeInfluxRequest(e *State, T miniprofiler.Timer, db, query, startDuration, endDuration, groupByInterval string) (s []influxModels.Row, err error) {
 	q, err := influxQueryDuration(e.now, query, startDuration, endDuration, groupByInterval)
 	if err != nil {
@@ -221,7 +413,8 @@ func timeInfluxRequest(e *State, T miniprofiler.Timer, db, query, startDuration,
 		}
 		var val interface{}
 		var ok bool
-		val, err = e.Cache.Get(q, getFn)
+		key := influxCacheKey(db, query, startDuration, endDuration, groupByInterval)
+		val, err = e.Cache.GetRelative("influx", key, e.now, getFn)
 		if s, ok = val.([]influxModels.Row); !ok {
 			err = fmt.Errorf("influx: did not get a valid result from InfluxDB")
 		}
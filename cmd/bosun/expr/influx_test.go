@@ -2,6 +2,8 @@ package expr
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -67,3 +69,105 @@ func TestInfluxQuery(t *testing.T) {
 		t.Fatal("Should have received an error from InfluxQuery")
 	}
 }
+
+func TestInfluxBuildQuery(t *testing.T) {
+	tests := []struct {
+		measurement, field, aggregator, tags string
+		expect                               string // empty for error
+	}{
+		{
+			"cpu", "value", "mean", "host=*,dc=ny|la",
+			"SELECT mean(value) AS value FROM cpu WHERE (dc = 'ny' OR dc = 'la') GROUP BY dc, host fill(none)",
+		},
+		{
+			"os.cpu", "user, system", "", "host=ny-web*",
+			`SELECT "user" AS "user", system AS system FROM "os.cpu" WHERE host =~ /^ny-web.*$/ GROUP BY host fill(none)`,
+		},
+		{
+			"m", "v", "max", "",
+			"SELECT max(v) AS v FROM m fill(none)",
+		},
+		{"m", "", "max", "", ""},
+		{"m", "v", "max(v)", "", ""},
+		{"m", "v", "max", "host=a'b", ""},
+	}
+	for _, test := range tests {
+		q, err := influxBuildQuery(test.measurement, test.field, test.aggregator, test.tags)
+		if test.expect == "" {
+			if err == nil {
+				t.Errorf("%v: expected error, got %v", test, q)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", test, err)
+		} else if q != test.expect {
+			t.Errorf("%v: \n\texpected: %v\n\tgot: %v", test, test.expect, q)
+		}
+	}
+}
+
+func TestInfluxIQ(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expect := "SELECT mean(rx) AS rx, mean(tx) AS tx FROM net WHERE time >= '2015-02-24T23:00:00Z' AND time <= '2015-02-25T00:00:00Z' GROUP BY host, time(30m) fill(none)"
+		if q := r.FormValue("q"); q != expect {
+			t.Errorf("\n\texpected: %v\n\tgot: %v", expect, q)
+		}
+		fmt.Fprint(w, `{"results":[{"series":[
+			{"name":"net","tags":{"host":"ny-web01"},"columns":["time","rx","tx"],"values":[
+				["2015-02-24T23:00:00Z",1,2],
+				["2015-02-24T23:30:00Z",3,null]
+			]}
+		]}]}`)
+	}))
+	defer ts.Close()
+
+	e, err := New(`iq("db", "net", "rx,tx", "mean", "host=*", "1h", "", "30m")`, Influx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags, err := e.Root.Tags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tags.String() != "field,host" {
+		t.Errorf("unexpected tags: %v", tags)
+	}
+	backends := &Backends{
+		InfluxConfig: client.HTTPConfig{Addr: ts.URL},
+	}
+	now := time.Date(2015, time.February, 25, 0, 0, 0, 0, time.UTC)
+	res, _, err := e.Execute(backends, &BosunProviders{}, nil, now, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t1 := time.Date(2015, time.February, 24, 23, 0, 0, 0, time.UTC)
+	t2 := t1.Add(30 * time.Minute)
+	expected := map[string]Series{
+		"field=rx,host=ny-web01": {t1: 1, t2: 3},
+		"field=tx,host=ny-web01": {t1: 2},
+	}
+	if len(res.Results) != len(expected) {
+		t.Fatalf("got %v results, expected %v", len(res.Results), len(expected))
+	}
+	for _, r := range res.Results {
+		ex, ok := expected[r.Group.Tags()]
+		if !ok {
+			t.Errorf("unexpected group %v", r.Group)
+			continue
+		}
+		if !r.Value.(Series).Equal(ex) {
+			t.Errorf("%v: got %v, expected %v", r.Group, r.Value, ex)
+		}
+	}
+}
+
+func TestInfluxCacheKey(t *testing.T) {
+	a := influxCacheKey("db", "SELECT mean(value) FROM cpu GROUP BY host", "1h", "", "1m")
+	if b := influxCacheKey("db", "SELECT mean(value) FROM cpu GROUP BY host", "2h", "", "1m"); a == b {
+		t.Errorf("keys of different queries are the same: %s", a)
+	}
+	if b := influxCacheKey("other", "SELECT mean(value) FROM cpu GROUP BY host", "1h", "", "1m"); a == b {
+		t.Errorf("keys of queries to different databases are the same: %s", a)
+	}
+}
@@ -110,7 +110,7 @@ func timeLSRequest(e *State, T miniprofiler.Timer, req *LogstashRequest) (resp *
 			return e.LogstashHosts.Query(req)
 		}
 		var val interface{}
-		val, err = e.Cache.Get(string(b), getFn)
+		val, err = e.Cache.GetRelative("logstash", string(b), e.now, getFn)
 		resp = val.(*elastic.SearchResult)
 	})
 	return
@@ -0,0 +1,208 @@
+package expr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+// Loki is a map of functions to count log lines stored in Loki. They are only
+// loaded when the Loki URL is set in the config file.
+var Loki = map[string]parse.Func{
+	"lokicount": {
+		Args:   []models.FuncType{models.TypeString, models.TypeString, models.TypeString, models.TypeString, models.TypeString, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   lokiTagQuery,
+		F:      LokiCount,
+	},
+	"lokirate": {
+		Args:   []models.FuncType{models.TypeString, models.TypeString, models.TypeString, models.TypeString, models.TypeString, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   lokiTagQuery,
+		F:      LokiRate,
+	},
+}
+
+// lokiTagQuery returns the labels of the groupBy argument, a comma separated
+// list of labels, as the group keys.
+func lokiTagQuery(args []parse.Node) (parse.Tags, error) {
+	n := args[2].(*parse.StringNode)
+	t := make(parse.Tags)
+	for _, s := range strings.Split(n.Text, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			t[s] = struct{}{}
+		}
+	}
+	return t, nil
+}
+
+// LokiCount returns the number of log lines matching selector and filter in
+// each bucket, grouped by the labels in groupBy.
+func LokiCount(e *State, T miniprofiler.Timer, selector, filter, groupBy, bucket, sduration, eduration string) (*Results, error) {
+	return lokiBucketQuery(e, T, "count_over_time", selector, filter, groupBy, bucket, sduration, eduration)
+}
+
+// LokiRate returns the per second rate of log lines matching selector and
+// filter in each bucket, grouped by the labels in groupBy.
+func LokiRate(e *State, T miniprofiler.Timer, selector, filter, groupBy, bucket, sduration, eduration string) (*Results, error) {
+	return lokiBucketQuery(e, T, "rate", selector, filter, groupBy, bucket, sduration, eduration)
+}
+
+func lokiBucketQuery(e *State, T miniprofiler.Timer, fn, selector, filter, groupBy, bucket, sduration, eduration string) (r *Results, err error) {
+	selector = strings.TrimSpace(selector)
+	if !strings.HasPrefix(selector, "{") || !strings.HasSuffix(selector, "}") {
+		return nil, fmt.Errorf("loki: selector must be of the form {label=\"value\", ...}: %v", selector)
+	}
+	bd, err := opentsdb.ParseDuration(bucket)
+	if err != nil {
+		return nil, err
+	}
+	if bd <= 0 {
+		return nil, fmt.Errorf("loki: bucket must be greater than zero")
+	}
+	sd, err := opentsdb.ParseDuration(sduration)
+	if err != nil {
+		return nil, err
+	}
+	ed := opentsdb.Duration(0)
+	if eduration != "" {
+		ed, err = opentsdb.ParseDuration(eduration)
+		if err != nil {
+			return nil, err
+		}
+	}
+	step := time.Duration(bd)
+	req := &LokiRequest{
+		Query: lokiQuery(fn, selector, filter, groupBy, step),
+		Start: e.now.Add(-time.Duration(sd)),
+		End:   e.now.Add(-time.Duration(ed)),
+		Step:  step,
+	}
+	resp, err := timeLokiRequest(e, T, req)
+	if err != nil {
+		return nil, err
+	}
+	r = new(Results)
+	r.Results, err = parseMatrixResponse(e, "loki", resp)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// lokiQuery builds a LogQL metric query that applies fn over buckets of step to
+// the log lines of selector that match filter and sums the result by the labels
+// of groupBy.
+func lokiQuery(fn, selector, filter, groupBy string, step time.Duration) string {
+	var labels []string
+	for _, s := range strings.Split(groupBy, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			labels = append(labels, s)
+		}
+	}
+	logs := selector
+	if filter = strings.TrimSpace(filter); filter != "" {
+		logs += " " + filter
+	}
+	inner := fmt.Sprintf("%s(%s [%ds])", fn, logs, int64(step/time.Second))
+	if len(labels) == 0 {
+		return fmt.Sprintf("sum(%s)", inner)
+	}
+	return fmt.Sprintf("sum by (%s) (%s)", strings.Join(labels, ", "), inner)
+}
+
+func timeLokiRequest(e *State, T miniprofiler.Timer, req *LokiRequest) (resp *PrometheusResponse, err error) {
+	e.lokiQueries = append(e.lokiQueries, *req)
+	b, _ := json.MarshalIndent(req, "", "  ")
+	T.StepCustomTiming("loki", "query", string(b), func() {
+		getFn := func() (interface{}, error) {
+			return e.LokiHost.Query(req)
+		}
+		var val interface{}
+		var ok bool
+		val, err = e.Cache.GetRelative("loki", req.CacheKey(e.now), e.now, getFn)
+		if err != nil {
+			return
+		}
+		if resp, ok = val.(*PrometheusResponse); !ok {
+			err = fmt.Errorf("loki: did not get a valid result from Loki")
+		}
+	})
+	return
+}
+
+// LokiHost is the base URL of a Loki server, for example http://loki:3100.
+// It exists as a type for something to attach methods to.
+type LokiHost string
+
+// LokiRequest holds the parameters of a LogQL range query.
+type LokiRequest struct {
+	Query string
+	Start time.Time
+	End   time.Time
+	Step  time.Duration
+}
+
+// CacheKey returns the identifier of the request in the query cache, with
+// its times relative to now.
+func (r *LokiRequest) CacheKey(now time.Time) string {
+	return fmt.Sprintf("%d-%d-%d-%s", r.Start.Unix()-now.Unix(), r.End.Unix()-now.Unix(), int64(r.Step/time.Second), r.Query)
+}
+
+// LokiClient is the client used for Loki requests.
+var LokiClient = &http.Client{
+	Timeout: time.Minute,
+}
+
+// Query runs a range query against the Loki server. Loki answers metric
+// queries in the format of the Prometheus HTTP API.
+func (h LokiHost) Query(r *LokiRequest) (*PrometheusResponse, error) {
+	u, err := url.Parse(string(h))
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" {
+		u, err = url.Parse("http://" + string(h))
+		if err != nil {
+			return nil, err
+		}
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/loki/api/v1/query_range"
+	v := url.Values{
+		"query": []string{r.Query},
+		"start": []string{strconv.FormatInt(r.Start.UnixNano(), 10)},
+		"end":   []string{strconv.FormatInt(r.End.UnixNano(), 10)},
+		"step":  []string{strconv.FormatFloat(r.Step.Seconds(), 'f', -1, 64)},
+	}
+	u.RawQuery = v.Encode()
+	resp, err := LokiClient.Get(u.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		// Loki reports errors as plain text.
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("loki: %v: %s", resp.Status, bytes.TrimSpace(b))
+	}
+	var lr PrometheusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&lr); err != nil {
+		return nil, fmt.Errorf("loki: could not decode response: %v", err)
+	}
+	if lr.Status != "success" {
+		return nil, fmt.Errorf("loki: %v: %v", lr.ErrorType, lr.Error)
+	}
+	return &lr, nil
+}
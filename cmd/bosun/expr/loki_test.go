@@ -0,0 +1,113 @@
+package expr
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/expr/parse"
+)
+
+func TestLokiCount(t *testing.T) {
+	queryTime := time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/loki/api/v1/query_range" {
+			t.Errorf("unexpected path: %v", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if got, expect := q.Get("query"), `sum by (host, level) (count_over_time({app="web"} |= "timeout" [300s]))`; got != expect {
+			t.Errorf("query: got %v, expected %v", got, expect)
+		}
+		if got, expect := q.Get("start"), fmt.Sprint(queryTime.Add(-time.Hour).UnixNano()); got != expect {
+			t.Errorf("start: got %v, expected %v", got, expect)
+		}
+		if got, expect := q.Get("end"), fmt.Sprint(queryTime.UnixNano()); got != expect {
+			t.Errorf("end: got %v, expected %v", got, expect)
+		}
+		if got := q.Get("step"); got != "300" {
+			t.Errorf("step: got %v, expected 300", got)
+		}
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"matrix","result":[
+			{"metric":{"host":"ny-web01","level":"error"},"values":[[1483224900,"4"],[1483225200,"7"]]},
+			{"metric":{"host":"ny-web02","level":"warn"},"values":[[1483225200,"1"]]}
+		]}}`)
+	}))
+	defer ts.Close()
+
+	e, err := New(`lokicount('''{app="web"}''', '''|= "timeout"''', "host,level", "5m", "1h", "")`, Loki)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backends := &Backends{
+		LokiHost: LokiHost(ts.URL),
+	}
+	results, _, err := e.Execute(backends, &BosunProviders{}, nil, queryTime, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]Series{
+		"host=ny-web01,level=error": {
+			time.Unix(1483224900, 0).UTC(): 4,
+			time.Unix(1483225200, 0).UTC(): 7,
+		},
+		"host=ny-web02,level=warn": {
+			time.Unix(1483225200, 0).UTC(): 1,
+		},
+	}
+	if len(results.Results) != len(expected) {
+		t.Fatalf("got %v results, expected %v", len(results.Results), len(expected))
+	}
+	for _, r := range results.Results {
+		ex, ok := expected[r.Group.Tags()]
+		if !ok {
+			t.Errorf("unexpected group %v", r.Group)
+			continue
+		}
+		if !r.Value.(Series).Equal(ex) {
+			t.Errorf("%v: got %v, expected %v", r.Group, r.Value, ex)
+		}
+	}
+}
+
+func TestLokiQuery(t *testing.T) {
+	tests := []struct {
+		fn, selector, filter, groupBy string
+		expect                        string
+	}{
+		{"count_over_time", `{app="web"}`, "", "", `sum(count_over_time({app="web"} [60s]))`},
+		{"rate", `{app="web"}`, `|~ "5.."`, " host ", `sum by (host) (rate({app="web"} |~ "5.." [60s]))`},
+	}
+	for _, test := range tests {
+		if got := lokiQuery(test.fn, test.selector, test.filter, test.groupBy, time.Minute); got != test.expect {
+			t.Errorf("got %v, expected %v", got, test.expect)
+		}
+	}
+}
+
+func TestLokiBadSelector(t *testing.T) {
+	e, err := New(`lokirate("app=web", "", "", "1m", "1h", "")`, Loki)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = e.Execute(&Backends{}, &BosunProviders{}, nil, time.Now(), 0, false)
+	if err == nil {
+		t.Fatal("expected an error for a selector without braces")
+	}
+}
+
+func TestLokiTagQuery(t *testing.T) {
+	tags, err := lokiTagQuery([]parse.Node{nil, nil, &parse.StringNode{Text: "host, level,"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 2 {
+		t.Fatalf("got %v, expected host and level", tags)
+	}
+	for _, k := range []string{"host", "level"} {
+		if _, ok := tags[k]; !ok {
+			t.Errorf("missing tag key %v in %v", k, tags)
+		}
+	}
+}
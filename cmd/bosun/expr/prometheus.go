@@ -0,0 +1,277 @@
+package expr
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+// Prometheus is a map of functions to query a Prometheus server.
+var Prometheus = map[string]parse.Func{
+	"prom": {
+		Args:   []models.FuncType{models.TypeString, models.TypeString, models.TypeString, models.TypeString},
+		Return: models.TypeSeriesSet,
+		Tags:   promTagQuery,
+		F:      PromQuery,
+	},
+}
+
+// promDefaultStep is the resolution used for range queries when no step is given.
+const promDefaultStep = time.Minute
+
+var (
+	promByRe      = regexp.MustCompile(`\bby\s*\(([^)]*)\)`)
+	promMatcherRe = regexp.MustCompile(`\{([^}]*)\}`)
+	promLabelRe   = regexp.MustCompile(`([a-zA-Z_][a-zA-Z0-9_]*)\s*(=~|!~|!=|=)`)
+)
+
+// promTagQuery infers the group keys of a PromQL query. If the query has a
+// "by (...)" clause, its labels are the group keys. Otherwise the labels of the
+// series selector's matchers are used.
+func promTagQuery(args []parse.Node) (parse.Tags, error) {
+	q := args[0].(*parse.StringNode).Text
+	t := make(parse.Tags)
+	if m := promByRe.FindStringSubmatch(q); m != nil {
+		for _, s := range strings.Split(m[1], ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				t[s] = struct{}{}
+			}
+		}
+		return t, nil
+	}
+	for _, m := range promMatcherRe.FindAllStringSubmatch(q, -1) {
+		for _, l := range promLabelRe.FindAllStringSubmatch(m[1], -1) {
+			if l[1] == promMetricLabel {
+				continue
+			}
+			t[l[1]] = struct{}{}
+		}
+	}
+	return t, nil
+}
+
+// promMetricLabel is the label Prometheus uses for the metric name. It is
+// not included in the group of a result.
+const promMetricLabel = "__name__"
+
+// PromQuery runs a PromQL range query against Prometheus. The returned series
+// are grouped by the labels of each result.
+func PromQuery(e *State, T miniprofiler.Timer, query, sduration, eduration, step string) (r *Results, err error) {
+	sd, err := opentsdb.ParseDuration(sduration)
+	if err != nil {
+		return
+	}
+	ed := opentsdb.Duration(0)
+	if eduration != "" {
+		ed, err = opentsdb.ParseDuration(eduration)
+		if err != nil {
+			return
+		}
+	}
+	st := promDefaultStep
+	if step != "" {
+		var d opentsdb.Duration
+		d, err = opentsdb.ParseDuration(step)
+		if err != nil {
+			return
+		}
+		st = time.Duration(d)
+	}
+	if st <= 0 {
+		return nil, fmt.Errorf("prom: step must be greater than zero")
+	}
+	req := &PrometheusRequest{
+		Query: query,
+		Start: e.now.Add(-time.Duration(sd)),
+		End:   e.now.Add(-time.Duration(ed)),
+		Step:  st,
+	}
+	resp, err := timePrometheusRequest(e, T, req)
+	if err != nil {
+		return nil, err
+	}
+	r = new(Results)
+	r.Results, err = parseMatrixResponse(e, "prom", resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// parseMatrixResponse converts a matrix result of the Prometheus HTTP API,
+// which Loki uses as well, to series. name prefixes error messages.
+func parseMatrixResponse(e *State, name string, resp *PrometheusResponse) ([]*Result, error) {
+	if resp.Data.ResultType != "matrix" {
+		return nil, fmt.Errorf("%v: expected matrix result, got %v", name, resp.Data.ResultType)
+	}
+	seen := make(map[string]bool)
+	var results []*Result
+	for _, res := range resp.Data.Result {
+		tags := make(opentsdb.TagSet)
+		for k, v := range res.Metric {
+			if k == promMetricLabel {
+				continue
+			}
+			k = opentsdb.MustReplace(k, "_")
+			v = opentsdb.MustReplace(v, "_")
+			if k == "" || v == "" {
+				continue
+			}
+			tags[k] = v
+		}
+		if e.Squelched(tags) {
+			continue
+		}
+		if ts := tags.String(); !seen[ts] {
+			seen[ts] = true
+		} else {
+			return nil, fmt.Errorf("%v: more than 1 series identified by tagset '%v'", name, ts)
+		}
+		dps := make(Series, len(res.Values))
+		for _, v := range res.Values {
+			t, f, err := v.parse()
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", name, err)
+			}
+			if math.IsNaN(f) {
+				continue
+			}
+			dps[t] = f
+		}
+		results = append(results, &Result{
+			Value: dps,
+			Group: tags,
+		})
+	}
+	return results, nil
+}
+
+func timePrometheusRequest(e *State, T miniprofiler.Timer, req *PrometheusRequest) (resp *PrometheusResponse, err error) {
+	e.prometheusQueries = append(e.prometheusQueries, *req)
+	b, _ := json.MarshalIndent(req, "", "  ")
+	T.StepCustomTiming("prometheus", "query", string(b), func() {
+		getFn := func() (interface{}, error) {
+			return e.PrometheusHost.Query(req)
+		}
+		var val interface{}
+		var ok bool
+		val, err = e.Cache.GetRelative("prometheus", req.CacheKey(e.now), e.now, getFn)
+		if err != nil {
+			return
+		}
+		if resp, ok = val.(*PrometheusResponse); !ok {
+			err = fmt.Errorf("prom: did not get a valid result from Prometheus")
+		}
+	})
+	return
+}
+
+// PrometheusHost is the base URL of a Prometheus server, for example
+// http://prometheus:9090. It exists as a type for something to attach
+// methods to.
+type PrometheusHost string
+
+// PrometheusRequest holds the parameters of a PromQL range query.
+type PrometheusRequest struct {
+	Query string
+	Start time.Time
+	End   time.Time
+	Step  time.Duration
+}
+
+// CacheKey returns the identifier of the request in the query cache, with
+// its times relative to now.
+func (r *PrometheusRequest) CacheKey(now time.Time) string {
+	return fmt.Sprintf("%d-%d-%d-%s", r.Start.Unix()-now.Unix(), r.End.Unix()-now.Unix(), int64(r.Step/time.Second), r.Query)
+}
+
+// PrometheusResponse is the body returned by the Prometheus HTTP API.
+type PrometheusResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string                   `json:"resultType"`
+		Result     []PrometheusSampleStream `json:"result"`
+	} `json:"data"`
+}
+
+// PrometheusSampleStream is a single series of a matrix result.
+type PrometheusSampleStream struct {
+	Metric map[string]string `json:"metric"`
+	Values []PrometheusValue `json:"values"`
+}
+
+// PrometheusValue is a [timestamp, "value"] pair.
+type PrometheusValue []interface{}
+
+func (v PrometheusValue) parse() (time.Time, float64, error) {
+	if len(v) != 2 {
+		return time.Time{}, 0, fmt.Errorf("value has != 2 fields: %v", v)
+	}
+	ts, ok := v[0].(float64)
+	if !ok {
+		return time.Time{}, 0, fmt.Errorf("bad timestamp: %v", v[0])
+	}
+	s, ok := v[1].(string)
+	if !ok {
+		return time.Time{}, 0, fmt.Errorf("bad value: %v", v[1])
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("bad number: %v", err)
+	}
+	sec := int64(ts)
+	return time.Unix(sec, int64((ts-float64(sec))*1e9)).UTC(), f, nil
+}
+
+// PrometheusClient is the client used for Prometheus requests.
+var PrometheusClient = &http.Client{
+	Timeout: time.Minute,
+}
+
+// Query runs a range query against the Prometheus server.
+func (h PrometheusHost) Query(r *PrometheusRequest) (*PrometheusResponse, error) {
+	u, err := url.Parse(string(h))
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" {
+		u, err = url.Parse("http://" + string(h))
+		if err != nil {
+			return nil, err
+		}
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/api/v1/query_range"
+	v := url.Values{
+		"query": []string{r.Query},
+		"start": []string{strconv.FormatInt(r.Start.Unix(), 10)},
+		"end":   []string{strconv.FormatInt(r.End.Unix(), 10)},
+		"step":  []string{strconv.FormatFloat(r.Step.Seconds(), 'f', -1, 64)},
+	}
+	u.RawQuery = v.Encode()
+	resp, err := PrometheusClient.Get(u.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var pr PrometheusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&pr); err != nil {
+		return nil, fmt.Errorf("prom: could not decode response (status %v): %v", resp.StatusCode, err)
+	}
+	if pr.Status != "success" {
+		return nil, fmt.Errorf("prom: %v: %v", pr.ErrorType, pr.Error)
+	}
+	return &pr, nil
+}
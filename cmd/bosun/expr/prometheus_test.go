@@ -0,0 +1,119 @@
+package expr
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/opentsdb"
+)
+
+func TestPromQuery(t *testing.T) {
+	queryTime := time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query_range" {
+			t.Errorf("unexpected path: %v", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if got := q.Get("query"); got != `sum(rate(http_requests_total[5m])) by (host)` {
+			t.Errorf("unexpected query: %v", got)
+		}
+		if got, expect := q.Get("start"), fmt.Sprint(queryTime.Add(-time.Hour).Unix()); got != expect {
+			t.Errorf("start: got %v, expected %v", got, expect)
+		}
+		if got, expect := q.Get("end"), fmt.Sprint(queryTime.Unix()); got != expect {
+			t.Errorf("end: got %v, expected %v", got, expect)
+		}
+		if got := q.Get("step"); got != "60" {
+			t.Errorf("step: got %v, expected 60", got)
+		}
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"matrix","result":[
+			{"metric":{"host":"ny-web01"},"values":[[1483225140,"1"],[1483225200,"2.5"]]},
+			{"metric":{"host":"ny-web02:9100"},"values":[[1483225140,"3"],[1483225200,"NaN"]]}
+		]}}`)
+	}))
+	defer ts.Close()
+
+	e, err := New(`prom("sum(rate(http_requests_total[5m])) by (host)", "1h", "", "1m")`, Prometheus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backends := &Backends{
+		PrometheusHost: PrometheusHost(ts.URL),
+	}
+	results, _, err := e.Execute(backends, &BosunProviders{}, nil, queryTime, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]Series{
+		"host=ny-web01": {
+			time.Unix(1483225140, 0).UTC(): 1,
+			time.Unix(1483225200, 0).UTC(): 2.5,
+		},
+		"host=ny-web02_9100": {
+			time.Unix(1483225140, 0).UTC(): 3,
+		},
+	}
+	if len(results.Results) != len(expected) {
+		t.Fatalf("got %v results, expected %v", len(results.Results), len(expected))
+	}
+	for _, r := range results.Results {
+		ex, ok := expected[r.Group.Tags()]
+		if !ok {
+			t.Errorf("unexpected group %v", r.Group)
+			continue
+		}
+		if !r.Value.(Series).Equal(ex) {
+			t.Errorf("%v: got %v, expected %v", r.Group, r.Value, ex)
+		}
+	}
+}
+
+func TestPromQueryError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status":"error","errorType":"bad_data","error":"parse error at char 5"}`)
+	}))
+	defer ts.Close()
+
+	e, err := New(`prom("up{", "1h", "", "")`, Prometheus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backends := &Backends{
+		PrometheusHost: PrometheusHost(ts.URL),
+	}
+	_, _, err = e.Execute(backends, &BosunProviders{}, nil, time.Now(), 0, false)
+	if err == nil {
+		t.Fatal("expected an error from prom")
+	}
+}
+
+func TestPromTagQuery(t *testing.T) {
+	tests := map[string]opentsdb.TagSet{
+		`sum(rate(http_requests_total[5m])) by (host, dc)`:          {"host": "", "dc": ""},
+		`sum by (host) (rate(http_requests_total{code="500"}[5m]))`: {"host": ""},
+		`up{job="node", instance=~".+"}`:                            {"job": "", "instance": ""},
+		`{__name__="up", job!="node"}`:                              {"job": ""},
+		`scalar(up)`:                                                {},
+	}
+	for q, expect := range tests {
+		tags, err := promTagQuery([]parse.Node{&parse.StringNode{Text: q}})
+		if err != nil {
+			t.Errorf("%v: %v", q, err)
+			continue
+		}
+		if len(tags) != len(expect) {
+			t.Errorf("%v: got %v, expected %v", q, tags, expect)
+			continue
+		}
+		for k := range expect {
+			if _, ok := tags[k]; !ok {
+				t.Errorf("%v: missing tag key %v in %v", q, k, tags)
+			}
+		}
+	}
+}
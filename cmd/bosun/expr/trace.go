@@ -0,0 +1,215 @@
+package expr
+
+import (
+	"fmt"
+	"time"
+
+	"bosun.org/cmd/bosun/expr/parse"
+	"bosun.org/opentsdb"
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+// Trace is a node of the evaluation tree of an expression. It records what
+// was evaluated for a parse.Node and how long it took, so that the steps
+// leading to a result can be followed.
+type Trace struct {
+	Node     string
+	Type     string
+	Return   string
+	Start    time.Time
+	Duration float64       // milliseconds
+	Queries  []TraceQuery  `json:",omitempty"`
+	Joins    []TraceJoin   `json:",omitempty"`
+	Results  []TraceResult `json:",omitempty"`
+	Error    string        `json:",omitempty"`
+	Children []*Trace      `json:",omitempty"`
+}
+
+// TraceQuery is a backend query issued while evaluating a node.
+type TraceQuery struct {
+	Backend  string
+	Type     string
+	Query    string
+	Duration float64 // milliseconds
+}
+
+// TraceJoin is a decision made when joining the groups of the operands of a
+// binary operator. A is the group of the left operand and B the group of the
+// right one; one of them is nil if the group did not join. Group is the
+// group of the joined result.
+type TraceJoin struct {
+	A, B   opentsdb.TagSet
+	Group  opentsdb.TagSet
+	Reason string
+}
+
+// Reasons for a TraceJoin.
+const (
+	joinEqual     = "equal"
+	joinUngrouped = "ungrouped"
+	joinSubset    = "subset"
+	joinUnjoined  = "unjoined"
+)
+
+// TraceResult is the value of a node for a group.
+type TraceResult struct {
+	Group opentsdb.TagSet
+	Value Value
+}
+
+// ExecuteTrace is like Execute, but also returns the evaluation tree of the
+// expression.
+func (e *Expr) ExecuteTrace(backends *Backends, providers *BosunProviders, T miniprofiler.Timer, now time.Time, autods int, unjoinedOk bool) (r *Results, queries []opentsdb.Request, trace *Trace, err error) {
+	s := newState(e, backends, providers, now, autods, unjoinedOk)
+	root := &Trace{}
+	s.trace = root
+	r, queries, err = e.ExecuteState(s, T)
+	if len(root.Children) > 0 {
+		trace = root.Children[0]
+	}
+	return
+}
+
+// traceTimer is a timer that records the custom timings of backend queries
+// in a trace.
+type traceTimer struct {
+	miniprofiler.Timer
+	t *Trace
+}
+
+func (t traceTimer) Step(name string, f func(miniprofiler.Timer)) {
+	t.Timer.Step(name, func(T miniprofiler.Timer) {
+		f(traceTimer{T, t.t})
+	})
+}
+
+func (t traceTimer) StepCustomTiming(callType, executeType, command string, f func()) {
+	start := time.Now()
+	t.Timer.StepCustomTiming(callType, executeType, command, f)
+	t.add(callType, executeType, command, start, time.Now())
+}
+
+func (t traceTimer) AddCustomTiming(callType, executeType string, start, end time.Time, command string) {
+	t.Timer.AddCustomTiming(callType, executeType, start, end, command)
+	t.add(callType, executeType, command, start, end)
+}
+
+func (t traceTimer) add(callType, executeType, command string, start, end time.Time) {
+	t.t.Queries = append(t.t.Queries, TraceQuery{
+		Backend:  callType,
+		Type:     executeType,
+		Query:    command,
+		Duration: milliseconds(end.Sub(start)),
+	})
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// traceNode evaluates node with walk, recording it as a child of the current
+// trace node if tracing is enabled.
+func (e *State) traceNode(node parse.Node, T miniprofiler.Timer, walk func(miniprofiler.Timer) *Results) *Results {
+	if e.trace == nil {
+		return walk(T)
+	}
+	parent := e.trace
+	t := &Trace{
+		Node:   node.String(),
+		Type:   traceNodeType(node),
+		Return: node.Return().String(),
+		Start:  time.Now(),
+	}
+	parent.Children = append(parent.Children, t)
+	e.trace = t
+	if tt, ok := T.(traceTimer); ok {
+		T = tt.Timer
+	}
+	defer func() {
+		t.Duration = milliseconds(time.Since(t.Start))
+		if err := recover(); err != nil {
+			t.Error = fmt.Sprint(err)
+			e.trace = parent
+			panic(err)
+		}
+		e.trace = parent
+	}()
+	res := walk(traceTimer{T, t})
+	t.Results = traceResults(res)
+	return res
+}
+
+// traceLeaf records a literal argument of a function.
+func (e *State) traceLeaf(node parse.Node, v Value) {
+	if e.trace == nil {
+		return
+	}
+	t := &Trace{
+		Node:   node.String(),
+		Type:   traceNodeType(node),
+		Return: node.Return().String(),
+		Start:  time.Now(),
+	}
+	if v != nil {
+		t.Results = []TraceResult{{Value: v}}
+	}
+	e.trace.Children = append(e.trace.Children, t)
+}
+
+// traceJoin records a join decision of the current node.
+func (e *State) traceJoin(a, b, group opentsdb.TagSet, reason string) {
+	if e.trace == nil {
+		return
+	}
+	e.trace.Joins = append(e.trace.Joins, TraceJoin{
+		A:      a,
+		B:      b,
+		Group:  group,
+		Reason: reason,
+	})
+}
+
+func traceNodeType(node parse.Node) string {
+	switch node.(type) {
+	case *parse.NumberNode:
+		return "number"
+	case *parse.StringNode:
+		return "string"
+	case *parse.BinaryNode:
+		return "binary"
+	case *parse.UnaryNode:
+		return "unary"
+	case *parse.FuncNode:
+		return "func"
+	case *parse.ExprNode:
+		return "expr"
+	}
+	return "unknown"
+}
+
+// traceResults copies the values of res, as later nodes may modify series in
+// place.
+func traceResults(res *Results) []TraceResult {
+	if res == nil {
+		return nil
+	}
+	rs := make([]TraceResult, 0, len(res.Results))
+	for _, r := range res.Results {
+		v := r.Value
+		switch t := v.(type) {
+		case Series:
+			c := make(Series, len(t))
+			for k, f := range t {
+				c[k] = f
+			}
+			v = c
+		case NumberExpr:
+			v = String(t.String())
+		}
+		rs = append(rs, TraceResult{
+			Group: r.Group,
+			Value: v,
+		})
+	}
+	return rs
+}
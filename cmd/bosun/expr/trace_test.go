@@ -0,0 +1,87 @@
+package expr
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestExecuteTrace(t *testing.T) {
+	e, err := New(`avg(merge(series("host=a", 0, 1, 60, 3), series("host=b", 0, 5))) + avg(series("", 0, 1))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, _, trace, err := e.ExecuteTrace(nil, &BosunProviders{}, nil, time.Now(), 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Results) != 2 {
+		t.Fatalf("got %v results, expected 2", len(res.Results))
+	}
+	if trace == nil {
+		t.Fatal("expected a trace")
+	}
+	if trace.Type != "binary" || len(trace.Children) != 2 {
+		t.Fatalf("unexpected root %v with %v children", trace.Type, len(trace.Children))
+	}
+	if len(trace.Joins) != 2 {
+		t.Fatalf("got %v joins, expected 2", len(trace.Joins))
+	}
+	for _, j := range trace.Joins {
+		if j.Reason != joinUngrouped {
+			t.Errorf("%v: got join reason %v, expected %v", j.Group, j.Reason, joinUngrouped)
+		}
+	}
+	if len(trace.Results) != 2 {
+		t.Errorf("got %v root results, expected 2", len(trace.Results))
+	}
+	left := trace.Children[0]
+	if left.Node != `avg(merge(series("host=a", 0, 1, 60, 3), series("host=b", 0, 5)))` {
+		t.Errorf("unexpected left node %v", left.Node)
+	}
+	// avg takes merge, which takes two series
+	if len(left.Children) != 1 || len(left.Children[0].Children) != 2 {
+		t.Fatalf("unexpected tree below %v", left.Node)
+	}
+	series := left.Children[0].Children[0]
+	// the group and the points of series are literal arguments
+	if len(series.Children) != 5 {
+		t.Errorf("got %v arguments of %v, expected 5", len(series.Children), series.Node)
+	}
+	if len(series.Results) != 1 || series.Results[0].Group["host"] != "a" {
+		t.Errorf("unexpected results of %v: %v", series.Node, series.Results)
+	}
+}
+
+func TestExecuteTraceQueries(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"matrix","result":[
+			{"metric":{"host":"ny-web01"},"values":[[1483225200,"2"]]}
+		]}}`)
+	}))
+	defer ts.Close()
+
+	e, err := New(`sum(lokicount('''{app="web"}''', "", "host", "5m", "1h", "")) * 2`, Loki)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backends := &Backends{
+		LokiHost: LokiHost(ts.URL),
+	}
+	_, _, trace, err := e.ExecuteTrace(backends, &BosunProviders{}, nil, time.Now(), 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := trace.Children[0].Children[0]
+	if count.Type != "func" || len(count.Queries) != 1 {
+		t.Fatalf("expected one query of %v, got %v", count.Node, count.Queries)
+	}
+	if q := count.Queries[0]; q.Backend != "loki" || q.Duration <= 0 {
+		t.Errorf("unexpected query %+v", q)
+	}
+	if len(trace.Queries) != 0 {
+		t.Errorf("unexpected queries of %v: %v", trace.Node, trace.Queries)
+	}
+}
@@ -82,7 +82,7 @@ func timeTSDBRequest(e *State, T miniprofiler.Timer, req *opentsdb.Request) (s o
 				return e.TSDBContext.Query(req)
 			}
 			var val interface{}
-			val, err = e.Cache.Get(string(b), getFn)
+			val, err = e.Cache.GetRelative("opentsdb", tsdbCacheKey(req, e.now), e.now, getFn)
 			s = val.(opentsdb.ResponseSet).Copy()
 
 		})
@@ -95,6 +95,20 @@ func timeTSDBRequest(e *State, T miniprofiler.Timer, req *opentsdb.Request) (s o
 	return
 }
 
+// tsdbCacheKey returns the cache key of req with its times relative to now, so
+// that the same query of a later check can use the cached result.
+func tsdbCacheKey(req *opentsdb.Request, now time.Time) string {
+	r := *req
+	if start, ok := r.Start.(int64); ok {
+		r.Start = start - now.Unix()
+	}
+	if end, ok := r.End.(int64); ok {
+		r.End = end - now.Unix()
+	}
+	b, _ := json.Marshal(&r)
+	return string(b)
+}
+
 func bandTSDB(e *State, T miniprofiler.Timer, query, duration, period string, num float64, rfunc func(*Results, *opentsdb.Response, time.Duration) error) (r *Results, err error) {
 	r = new(Results)
 	r.IgnoreOtherUnjoined = true
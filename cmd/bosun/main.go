@@ -133,7 +133,7 @@ func main() {
 		slog.Fatal(err)
 	}
 
-	if err := sched.Load(sysProvider, ruleProvider, da, *flagSkipLast, *flagQuiet); err != nil {
+	if err := sched.Load(sysProvider, ruleProvider, da, *flagSkipLast, *flagQuiet, *flagReadonly); err != nil {
 		slog.Fatal(err)
 	}
 	if err := metadata.InitF(false, func(k metadata.Metakey, v interface{}) error { return sched.DefaultSched.PutMetadata(k, v) }); err != nil {
@@ -207,10 +207,11 @@ func main() {
 		slog.Infoln("schedule shutdown, loading new schedule")
 
 		// Load does not set the DataAccess or Search if it is already set
-		if err := sched.Load(sysProvider, newConf, da, *flagSkipLast, *flagQuiet); err != nil {
+		if err := sched.Load(sysProvider, newConf, da, *flagSkipLast, *flagQuiet, *flagReadonly); err != nil {
 			slog.Fatal(err)
 		}
 		web.ResetSchedule() // Signal web to point to the new DefaultSchedule
+		newSched.ConfigReloaded()
 		go func() {
 			slog.Infoln("running new schedule")
 			if !*flagNoChecks {
@@ -269,7 +270,13 @@ func quit() {
 
 func initDataAccess(systemConf conf.SystemConfProvider) (database.DataAccess, error) {
 	var da database.DataAccess
-	if systemConf.GetRedisHost() != "" {
+	if systemConf.GetSQLDataSource() != "" {
+		var err error
+		da, err = database.NewSQLDataAccess(systemConf.GetSQLDriver(), systemConf.GetSQLDataSource())
+		if err != nil {
+			return nil, err
+		}
+	} else if systemConf.GetRedisHost() != "" {
 		da = database.NewDataAccess(systemConf.GetRedisHost(), true, systemConf.GetRedisDb(), systemConf.GetRedisPassword())
 	} else {
 		_, err := database.StartLedis(systemConf.GetLedisDir(), systemConf.GetLedisBindAddr())
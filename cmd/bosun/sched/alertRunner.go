@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"time"
 
-	"bosun.org/cmd/bosun/cache"
 	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
 	"bosun.org/slog"
 )
 
@@ -16,11 +16,16 @@ func (s *Schedule) Run() error {
 	}
 	s.nc = make(chan interface{}, 1)
 	go s.dispatchNotifications()
+	s.oc = make(chan interface{}, 1)
+	go s.dispatchDeliveries()
+	go s.retainIncidents()
 	type alertCh struct {
 		ch     chan<- *checkContext
 		modulo int
+		alert  *conf.Alert // nil for records
+		next   time.Time   // next run of an alert with a schedule
 	}
-	chs := []alertCh{}
+	chs := []*alertCh{}
 	for _, a := range s.RuleConf.GetAlerts() {
 		ch := make(chan *checkContext, 1)
 		re := a.RunEvery
@@ -28,7 +33,16 @@ func (s *Schedule) Run() error {
 			re = s.SystemConf.GetDefaultRunEvery()
 		}
 		go s.runAlert(a, ch)
-		chs = append(chs, alertCh{ch: ch, modulo: re})
+		chs = append(chs, &alertCh{ch: ch, modulo: re, alert: a, next: a.NextRun(utcNow())})
+	}
+	for _, r := range s.RuleConf.GetRecords() {
+		ch := make(chan *checkContext, 1)
+		re := r.RunEvery
+		if re == 0 {
+			re = s.SystemConf.GetDefaultRunEvery()
+		}
+		go s.runRecord(r, ch)
+		chs = append(chs, &alertCh{ch: ch, modulo: re})
 	}
 	i := 0
 	for {
@@ -37,17 +51,28 @@ func (s *Schedule) Run() error {
 			return nil
 		default:
 		}
-		ctx := &checkContext{utcNow(), cache.New(0)}
+		ctx := &checkContext{utcNow(), s.checkCache(), false}
 		s.LastCheck = utcNow()
 		for _, a := range chs {
-			if i%a.modulo != 0 {
+			if a.alert != nil && a.alert.Schedule != nil {
+				// Alerts with a schedule run at the first check at or
+				// after their next run.
+				if a.next.IsZero() || ctx.runTime.Before(a.next) {
+					continue
+				}
+				a.next = a.alert.NextRun(ctx.runTime)
+			} else if i%a.modulo != 0 {
 				continue
 			}
+			c := ctx
+			if a.alert != nil && !a.alert.Active(ctx.runTime) {
+				c = &checkContext{ctx.runTime, ctx.checkCache, true}
+			}
 			// Put on channel. If that fails, the alert is backed up pretty bad.
 			// Because channel is buffered size 1, it will continue as soon as it finishes.
 			// Master scheduler will never block here.
 			select {
-			case a.ch <- ctx:
+			case a.ch <- c:
 			default:
 			}
 		}
@@ -78,6 +103,10 @@ func (s *Schedule) runAlert(a *conf.Alert, ch <-chan *checkContext) {
 
 func (s *Schedule) checkAlert(a *conf.Alert, ctx *checkContext) {
 	rh := s.NewRunHistory(ctx.runTime, ctx.checkCache)
+	if ctx.inactive {
+		s.checkInactiveAlert(rh, a)
+		return
+	}
 	// s.CheckAlert will return early if the schedule has been closed
 	cancelled := s.CheckAlert(nil, rh, a)
 	if cancelled {
@@ -88,3 +117,19 @@ func (s *Schedule) checkAlert(a *conf.Alert, ctx *checkContext) {
 	s.RunHistory(rh)
 	slog.Infof("runHistory on %s took %v\n", a.Name, time.Since(start))
 }
+
+// checkInactiveAlert marks the alert keys of a as unevaluated instead of
+// evaluating a, as it is outside its active windows. This keeps them from
+// becoming unknown.
+func (s *Schedule) checkInactiveAlert(rh *RunHistory, a *conf.Alert) {
+	keys, err := s.DataAccess.State().GetUntouchedSince(a.Name, rh.Start.Unix())
+	if err != nil {
+		slog.Errorf("Error getting alert keys of inactive alert %s: %s", a.Name, err)
+		return
+	}
+	for _, ak := range keys {
+		rh.Events[ak] = &models.Event{Unevaluated: true}
+	}
+	s.RunHistory(rh)
+	slog.Infof("alert %s is outside its active windows, marked %d keys unevaluated\n", a.Name, len(keys))
+}
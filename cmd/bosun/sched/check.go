@@ -74,6 +74,8 @@ func (s *Schedule) NewRunHistory(start time.Time, cache *cache.Cache) *RunHistor
 			InfluxConfig:    s.SystemConf.GetInfluxContext(),
 			LogstashHosts:   s.SystemConf.GetLogstashContext(),
 			ElasticHosts:    s.SystemConf.GetElasticContext(),
+			PrometheusHost:  s.SystemConf.GetPrometheusContext(),
+			LokiHost:        s.SystemConf.GetLokiContext(),
 			AnnotateContext: s.SystemConf.GetAnnotateContext(),
 		},
 	}
@@ -129,7 +131,16 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 			return
 		}
 	}
+	// discard is set when a pending incident recovers before it is abnormal.
+	discard := false
 	defer func() {
+		if discard {
+			// an incident that never was abnormal is not kept
+			if incident.Id != 0 {
+				err = data.DeleteIncident(incident.Id)
+			}
+			return
+		}
 		// save unless incident is new and closed (log alert)
 		if incident != nil && (incident.Id != 0 || incident.Open) {
 			_, err = data.UpdateIncidentState(incident)
@@ -161,6 +172,20 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 		newIncident = true
 		shouldNotify = true
 	}
+	if holdStatus(a, incident, event) {
+		// A new incident is opened as normal while its status is pending,
+		// so that the pending state is saved and shown on the dashboard.
+		if newIncident {
+			incident.CurrentStatus = models.StNormal
+			incident.Open = true
+		}
+		return
+	}
+	if incident.WorstStatus <= models.StNormal && event.Status <= models.StNormal {
+		// The alert key recovered before its pending status took effect.
+		discard = true
+		return
+	}
 	// set state.Result according to event result
 	if event.Status == models.StCritical {
 		incident.Result = event.Crit
@@ -172,14 +197,24 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 		incident.LastAbnormalStatus = event.Status
 		incident.LastAbnormalTime = event.Time.UTC().Unix()
 	}
+	// The incident opens on its first abnormal status, which for a pending
+	// incident is not when it is created, and escalates on a worse one.
+	opened := incident.WorstStatus <= models.StNormal && event.Status > models.StNormal
+	escalated := false
 	if event.Status > incident.WorstStatus {
 		incident.WorstStatus = event.Status
 		shouldNotify = true
+		escalated = !opened
 	}
+	previousStatus := incident.CurrentStatus
 	if event.Status != incident.CurrentStatus {
 		incident.Events = append(incident.Events, *event)
+		if max := s.SystemConf.GetRetentionConf().MaxEvents; max > 0 && len(incident.Events) > max {
+			incident.Events = incident.Events[len(incident.Events)-max:]
+		}
 	}
 	incident.CurrentStatus = event.Status
+	flapChanged, flapChanges := updateFlapping(a, incident, event.Time)
 
 	//run a preliminary save on new incidents to get an id
 	if newIncident {
@@ -192,6 +227,15 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 			}
 		}
 	}
+	if event.Status != previousStatus {
+		s.publish(&StreamEvent{
+			Type:           StreamStatus,
+			AlertKey:       ak,
+			IncidentId:     incident.Id,
+			Status:         event.Status,
+			PreviousStatus: previousStatus,
+		})
+	}
 
 	//render templates and open alert key if abnormal
 	if event.Status > models.StNormal {
@@ -220,6 +264,24 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 		}
 	}
 
+	// Start the escalation from its first level, which is notified by the
+	// next CheckNotifications.
+	escalate := func(e *conf.Escalation) {
+		if e == nil {
+			return
+		}
+		es := &models.EscalationState{
+			AlertKey:   ak,
+			Escalation: e.Name,
+			Due:        utcNow(),
+		}
+		if err := s.DataAccess.Notifications().SetEscalation(es); err != nil {
+			slog.Errorln(err)
+			return
+		}
+		checkNotify = true
+	}
+
 	notifyCurrent := func() {
 		//Auto close ignoreUnknowns for new incident.
 		if silencedOrIgnored(a, event, si) {
@@ -230,20 +292,25 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 		switch event.Status {
 		case models.StCritical, models.StUnknown:
 			notify(a.CritNotification)
+			escalate(a.CritEscalation)
 		case models.StWarning:
 			notify(a.WarnNotification)
+			escalate(a.WarnEscalation)
 		}
 	}
 
 	// lock while we change notifications.
 	s.Lock("RunHistory")
-	if shouldNotify {
+	if shouldNotify && !incident.Flapping {
 		incident.NeedAck = false
 		if err = s.DataAccess.Notifications().ClearNotifications(ak); err != nil {
 			return
 		}
 		notifyCurrent()
 	}
+	if flapChanged && !silencedOrIgnored(a, event, si) {
+		s.flapNotify(a, incident, flapChanges)
+	}
 
 	// finally close an open alert with silence once it goes back to normal.
 	if si := silenced(ak); si != nil && event.Status == models.StNormal {
@@ -256,9 +323,76 @@ func (s *Schedule) runHistory(r *RunHistory, ak models.AlertKey, event *models.E
 		}(ak)
 	}
 	s.Unlock()
+	if opened && incident.Open && incident.Id != 0 {
+		s.notifyWebhooks(conf.WebhookOpen, incident)
+	} else if escalated {
+		s.notifyWebhooks(conf.WebhookEscalate, incident)
+	}
 	return checkNotify, nil
 }
 
+// holdStatus holds back a change of the status of incident to the status of
+// event until it has lasted for the critFor, warnFor or recoverFor of the
+// alert. It returns true if the change is pending. The pending state is kept
+// in the incident.
+func holdStatus(a *conf.Alert, incident *models.IncidentState, event *models.Event) bool {
+	current := incident.CurrentStatus
+	if current == models.StNone {
+		current = models.StNormal
+	}
+	var f conf.For
+	switch event.Status {
+	case models.StCritical:
+		f = a.CritFor
+	case models.StWarning:
+		f = a.WarnFor
+	case models.StNormal:
+		f = a.RecoverFor
+	}
+	if event.Status != current && !f.IsZero() {
+		if incident.PendingStatus != event.Status {
+			incident.PendingStatus = event.Status
+			incident.PendingSince = event.Time.UTC().Unix()
+			incident.PendingCount = 0
+		}
+		incident.PendingCount++
+		if f.Held(time.Unix(incident.PendingSince, 0), event.Time, incident.PendingCount) {
+			return true
+		}
+	}
+	incident.PendingStatus = models.StNone
+	incident.PendingSince = 0
+	incident.PendingCount = 0
+	return false
+}
+
+// updateFlapping marks incident as flapping when its number of status changes
+// in the flapWindow of the alert reaches flapStart, and as no longer flapping
+// when it falls to flapStop. It returns whether the flapping state changed,
+// and the number of status changes.
+func updateFlapping(a *conf.Alert, incident *models.IncidentState, now time.Time) (changed bool, changes int) {
+	if a.FlapWindow == 0 {
+		changed = incident.Flapping
+		incident.Flapping = false
+		return
+	}
+	since := now.Add(-a.FlapWindow)
+	for _, e := range incident.Events {
+		if e.Time.After(since) {
+			changes++
+		}
+	}
+	switch {
+	case !incident.Flapping && changes >= a.FlapStart:
+		incident.Flapping = true
+		changed = true
+	case incident.Flapping && changes <= a.FlapStop:
+		incident.Flapping = false
+		changed = true
+	}
+	return
+}
+
 func silencedOrIgnored(a *conf.Alert, event *models.Event, si *models.Silence) bool {
 	if a.IgnoreUnknown && event.Status == models.StUnknown {
 		return true
@@ -95,6 +95,215 @@ func TestCheckFlapping(t *testing.T) {
 	}
 }
 
+func TestCheckFor(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, `
+		template t {
+			subject = 1
+		}
+		notification n {
+			print = true
+		}
+		alert a {
+			critNotification = n
+			crit = 1
+			critFor = 3
+			recoverFor = 10m
+			template = t
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{}, c)
+	ak := models.NewAlertKey("a", nil)
+	start := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	type check struct {
+		S         models.Status
+		Current   models.Status
+		Pending   models.Status
+		Open      bool
+		ExpectNot bool
+	}
+	checks := []check{
+		{models.StCritical, models.StNormal, models.StCritical, true, false},
+		{models.StCritical, models.StNormal, models.StCritical, true, false},
+		{models.StCritical, models.StCritical, models.StNone, true, true},
+		{models.StNormal, models.StCritical, models.StNormal, true, false},
+		{models.StCritical, models.StCritical, models.StNone, true, false},
+		{models.StNormal, models.StCritical, models.StNormal, true, false},
+		{models.StNormal, models.StCritical, models.StNormal, true, false},
+		{models.StNormal, models.StNormal, models.StNone, true, false},
+	}
+	for i, c := range checks {
+		r := &RunHistory{
+			Start: start.Add(time.Duration(i) * 5 * time.Minute),
+			Events: map[models.AlertKey]*models.Event{
+				ak: {Status: c.S},
+			},
+		}
+		s.RunHistory(r)
+		hasNot := len(s.pendingNotifications) > 0
+		s.pendingNotifications = nil
+		if hasNot != c.ExpectNot {
+			t.Errorf("check %d: got notification %v, expected %v", i, hasNot, c.ExpectNot)
+		}
+		st, err := s.DataAccess.State().GetLatestIncident(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if st == nil {
+			t.Fatalf("check %d: no incident", i)
+		}
+		if st.CurrentStatus != c.Current || st.PendingStatus != c.Pending || st.Open != c.Open {
+			t.Errorf("check %d: got status %v pending %v open %v, expected %v pending %v open %v", i, st.CurrentStatus, st.PendingStatus, st.Open, c.Current, c.Pending, c.Open)
+		}
+	}
+
+	// A key that recovers while pending is not kept as an incident, and does
+	// not notify.
+	ak = models.NewAlertKey("a", opentsdb.TagSet{"host": "a"})
+	for i, status := range []models.Status{models.StCritical, models.StNormal} {
+		r := &RunHistory{
+			Start: start,
+			Events: map[models.AlertKey]*models.Event{
+				ak: {Status: status},
+			},
+		}
+		s.RunHistory(r)
+		if len(s.pendingNotifications) > 0 {
+			t.Errorf("check %d: unexpected notification", i)
+		}
+		st, err := s.DataAccess.State().GetLatestIncident(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pending := status == models.StCritical; (st != nil) != pending {
+			t.Errorf("check %d: expected pending incident %v, got %+v", i, pending, st)
+		}
+	}
+}
+
+func TestCheckFlapDetection(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, `
+		template t {
+			subject = 1
+		}
+		notification n {
+			print = true
+		}
+		alert a {
+			warnNotification = n
+			warn = 1
+			critNotification = n
+			crit = 1
+			template = t
+			flapWindow = 1h
+			flapStart = 4
+			flapStop = 1
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{}, c)
+	ak := models.NewAlertKey("a", nil)
+	start := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	type check struct {
+		S         models.Status
+		After     time.Duration
+		Flapping  bool
+		ExpectNot bool
+	}
+	checks := []check{
+		{models.StWarning, 0, false, true},
+		{models.StNormal, 5 * time.Minute, false, false},
+		{models.StWarning, 5 * time.Minute, false, false},
+		{models.StNormal, 5 * time.Minute, true, false},
+		{models.StCritical, 5 * time.Minute, true, false},
+		{models.StCritical, 50 * time.Minute, true, false},
+		{models.StCritical, 10 * time.Minute, false, false},
+	}
+	now := start
+	for i, c := range checks {
+		now = now.Add(c.After)
+		r := &RunHistory{
+			Start: now,
+			Events: map[models.AlertKey]*models.Event{
+				ak: {Status: c.S},
+			},
+		}
+		s.RunHistory(r)
+		hasNot := len(s.pendingNotifications) > 0
+		s.pendingNotifications = nil
+		if hasNot != c.ExpectNot {
+			t.Errorf("check %d: got notification %v, expected %v", i, hasNot, c.ExpectNot)
+		}
+		st, err := s.DataAccess.State().GetLatestIncident(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if st.Flapping != c.Flapping {
+			t.Errorf("check %d: got flapping %v, expected %v", i, st.Flapping, c.Flapping)
+		}
+		is, err := MakeIncidentSummary(s.RuleConf, s.Silenced(), st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := is.Ask("isFlapping:true"); err != nil || ok != c.Flapping {
+			t.Errorf("check %d: isFlapping:true got %v %v", i, ok, err)
+		}
+	}
+}
+
+func TestCheckInactive(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, `
+		template t {
+			subject = 1
+		}
+		alert a {
+			crit = 1
+			activeWindow = Mon-Fri 08:00-18:00
+			template = t
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{}, c)
+	a := c.Alerts["a"]
+	ak := models.NewAlertKey("a", nil)
+	// 2016-01-08 is a Friday. The inactive run is on the next Saturday, as
+	// it only marks keys touched before it, and keys are touched at the
+	// current time.
+	active := time.Date(2016, 1, 8, 17, 0, 0, 0, time.UTC)
+	inactive := time.Date(2016, 1, 9, 12, 0, 0, 0, time.UTC)
+	defer func(now func() time.Time) { utcNow = now }(utcNow)
+	utcNow = func() time.Time { return active }
+	if !a.Active(active) || a.Active(inactive) {
+		t.Fatal("unexpected active window")
+	}
+	s.RunHistory(&RunHistory{
+		Start: active,
+		Events: map[models.AlertKey]*models.Event{
+			ak: {Status: models.StCritical},
+		},
+	})
+	s.checkAlert(a, &checkContext{inactive, s.checkCache(), true})
+	st, err := s.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st == nil {
+		t.Fatal("no incident")
+	}
+	if !st.Unevaluated || st.CurrentStatus != models.StCritical {
+		t.Errorf("expected unevaluated critical incident, got unevaluated %v status %v", st.Unevaluated, st.CurrentStatus)
+	}
+}
+
 func TestCheckSilence(t *testing.T) {
 	defer setup()()
 	done := make(chan bool, 1)
@@ -127,7 +336,7 @@ func TestCheckSilence(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = s.AddSilence(utcNow().Add(-time.Hour), utcNow().Add(time.Hour), "a", "", false, true, "", "user", "message")
+	_, err = s.AddSilence(utcNow().Add(-time.Hour), utcNow().Add(time.Hour), nil, "a", "", false, true, "", "user", "message")
 	if err != nil {
 		t.Fatal(err)
 	}
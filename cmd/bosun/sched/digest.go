@@ -0,0 +1,150 @@
+package sched
+
+import (
+	"bytes"
+	htemplate "html/template"
+	ttemplate "text/template"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+)
+
+func init() {
+	metadata.AddMetricMeta(
+		"bosun.notifications.suppressed", metadata.Counter, metadata.Alert,
+		"The number of incidents held back by the maxPerHour of a notification, to be sent in a digest.")
+	metadata.AddMetricMeta(
+		"bosun.notifications.digested", metadata.Counter, metadata.Alert,
+		"The number of incidents sent in notification digests.")
+}
+
+// pendingDigest holds the incidents collected for the digest of a
+// notification.
+type pendingDigest struct {
+	due    time.Time
+	states []*models.IncidentState
+}
+
+// collectDigest adds st to the digest of n instead of notifying it, if n only
+// sends digests or already sent maxPerHour notifications in the last hour. It
+// reports whether st was added. Only the email, post and get actions of n are
+// digested: the incidents at its providers are triggered regardless.
+func (s *Schedule) collectDigest(n *conf.Notification, st *models.IncidentState) bool {
+	now := utcNow()
+	if n.MaxPerHour > 0 {
+		times := s.notificationTimes[n.Name]
+		hourAgo := now.Add(-time.Hour)
+		for len(times) > 0 && !times[0].After(hourAgo) {
+			times = times[1:]
+		}
+		if len(times) < n.MaxPerHour {
+			s.notificationTimes[n.Name] = append(times, now)
+			return false
+		}
+		s.notificationTimes[n.Name] = times
+		collect.Add("notifications.suppressed", opentsdb.TagSet{"notification": n.Name}, 1)
+	} else if n.DigestInterval == 0 {
+		return false
+	}
+	d := s.pendingDigests[n.Name]
+	if d == nil {
+		d = &pendingDigest{due: now.Add(n.DigestInterval)}
+		s.pendingDigests[n.Name] = d
+	}
+	d.states = append(d.states, st)
+	return true
+}
+
+// sendDigests sends the digests that are due at t.
+func (s *Schedule) sendDigests(t time.Time) {
+	s.Lock("SendDigests")
+	defer s.Unlock()
+	for name, d := range s.pendingDigests {
+		if t.Before(d.due) {
+			continue
+		}
+		delete(s.pendingDigests, name)
+		n := s.RuleConf.GetNotification(name)
+		if n == nil {
+			slog.Warningf("dropping digest of %d incidents to removed notification %s", len(d.states), name)
+			continue
+		}
+		if s.quiet {
+			slog.Infoln("quiet mode prevented digest of", len(d.states), "incidents to", n.Name)
+			continue
+		}
+		s.dnotify(n, s.digestStates(d.states))
+	}
+}
+
+// digestStates returns the states that still need a notification: those that
+// were not acknowledged or closed since they were collected.
+func (s *Schedule) digestStates(states []*models.IncidentState) []*models.IncidentState {
+	var open []*models.IncidentState
+	for _, st := range states {
+		if st.Id != 0 {
+			latest, err := s.DataAccess.State().GetIncidentState(st.Id)
+			if err != nil {
+				slog.Errorln(err)
+			} else if latest != nil {
+				if !latest.Open || !latest.NeedAck {
+					continue
+				}
+				st = latest
+			}
+		}
+		open = append(open, st)
+	}
+	return open
+}
+
+var defaultDigestTemplate = &conf.Template{
+	Body: htemplate.Must(htemplate.New("").Parse(`
+		<p>{{len .States}} alerts were held back by notification {{.Name}}:
+		<ul>
+		{{range .States}}
+			<li>
+				{{if .Id}}<a href="{{$.IncidentLink .Id}}">#{{.Id}}</a>:{{end}}
+				{{.Subject}} ({{.CurrentStatus}})
+			</li>
+		{{end}}
+		</ul>
+	`)),
+	Subject: ttemplate.Must(ttemplate.New("").Parse(`{{.Name}}: digest of {{len .States}} alerts`)),
+}
+
+// dnotify sends the digest of states to n.
+func (s *Schedule) dnotify(n *conf.Notification, states []*models.IncidentState) {
+	if len(states) == 0 {
+		return
+	}
+	subject := new(bytes.Buffer)
+	body := new(bytes.Buffer)
+	t := s.RuleConf.GetDigestTemplate()
+	if t == nil {
+		t = defaultDigestTemplate
+	}
+	data := &digestContext{
+		Time:     utcNow(),
+		Name:     n.Name,
+		States:   states,
+		schedule: s,
+	}
+	if t.Body != nil {
+		if err := t.Body.Execute(body, data); err != nil {
+			slog.Infoln("digest template error:", err)
+		}
+	}
+	if t.Subject != nil {
+		if err := t.Subject.Execute(subject, data); err != nil {
+			slog.Infoln("digest template error:", err)
+		}
+	}
+	collect.Add("notifications.digested", opentsdb.TagSet{"notification": n.Name}, int64(len(states)))
+	s.deliver(n.Notify(subject.String(), body.String(), subject.Bytes(), body.Bytes(), "digest"), 0)
+}
@@ -2,8 +2,14 @@ package sched
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"bosun.org/cmd/bosun/conf"
 	"bosun.org/cmd/bosun/conf/rule"
@@ -145,3 +151,514 @@ func TestActionNotificationGrouping(t *testing.T) {
 	expect("n2", acrit, bwarn, cA)
 	expect("n3", bcrit, cB)
 }
+
+func TestProviderNotifications(t *testing.T) {
+	defer setup()()
+	type request struct {
+		path, auth string
+		body       map[string]interface{}
+	}
+	rc := make(chan request, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		rc <- request{r.URL.Path, r.Header.Get("Authorization"), body}
+	}))
+	defer ts.Close()
+	defer func(pd, og string) {
+		conf.PagerDutyURL, conf.OpsGenieURL = pd, og
+	}(conf.PagerDutyURL, conf.OpsGenieURL)
+	conf.PagerDutyURL = ts.URL + "/pagerduty"
+	conf.OpsGenieURL = ts.URL + "/opsgenie"
+	c, err := rule.NewConf("", conf.EnabledBackends{}, fmt.Sprintf(`
+		template t {
+			subject = %s
+		}
+		notification n {
+			slack = %s/slack
+			pagerduty = pdkey
+			opsgenie = ogkey
+			runOnActions = false
+		}
+		alert a {
+			template = t
+			critNotification = n
+			crit = 1
+		}
+	`, strings.Repeat("é", 140), ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{Hostname: "bosun"}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive := func(action string) map[string]request {
+		reqs := make(map[string]request)
+		for i := 0; i < 3; i++ {
+			select {
+			case r := <-rc:
+				reqs[strings.SplitN(r.path[1:], "/", 2)[0]] = r
+			case <-time.After(time.Second):
+				t.Fatalf("%s: failed to receive provider requests before timeout", action)
+			}
+		}
+		return reqs
+	}
+	ak := models.AlertKey("a{}")
+
+	check(s, utcNow())
+	s.CheckNotifications()
+	st, err := s.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := fmt.Sprintf("bosun-%d", st.Id)
+	reqs := receive("trigger")
+	if r := reqs["pagerduty"]; r.body["event_action"] != "trigger" || r.body["dedup_key"] != key || r.body["routing_key"] != "pdkey" {
+		t.Errorf("unexpected pagerduty trigger: %v", r.body)
+	}
+	if r := reqs["opsgenie"]; r.path != "/opsgenie" || r.body["alias"] != key || r.body["priority"] != "P1" || r.auth != "GenieKey ogkey" || r.body["message"] != strings.Repeat("é", 130) {
+		t.Errorf("unexpected opsgenie trigger: %s %v", r.path, r.body)
+	}
+	if r := reqs["slack"]; r.body["text"] != "a{} is critical" {
+		t.Errorf("unexpected slack trigger: %v", r.body)
+	}
+
+	if err := s.ActionByAlertKey("user", "on it", models.ActionAcknowledge, ak); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ActionNotify(models.ActionAcknowledge, "user", "on it", []models.AlertKey{ak}); err != nil {
+		t.Fatal(err)
+	}
+	reqs = receive("acknowledge")
+	if r := reqs["pagerduty"]; r.body["event_action"] != "acknowledge" || r.body["dedup_key"] != key {
+		t.Errorf("unexpected pagerduty acknowledge: %v", r.body)
+	}
+	if r := reqs["opsgenie"]; r.path != "/opsgenie/"+key+"/acknowledge" || r.body["note"] != "on it" {
+		t.Errorf("unexpected opsgenie acknowledge: %s %v", r.path, r.body)
+	}
+
+	if err := s.ActionByAlertKey("user", "done", models.ActionForceClose, ak); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ActionNotify(models.ActionForceClose, "user", "done", []models.AlertKey{ak}); err != nil {
+		t.Fatal(err)
+	}
+	reqs = receive("resolve")
+	if r := reqs["pagerduty"]; r.body["event_action"] != "resolve" || r.body["dedup_key"] != key {
+		t.Errorf("unexpected pagerduty resolve: %v", r.body)
+	}
+	if r := reqs["opsgenie"]; r.path != "/opsgenie/"+key+"/close" {
+		t.Errorf("unexpected opsgenie close: %s %v", r.path, r.body)
+	}
+	if r := reqs["slack"]; r.body["text"] != "user closed a{}" {
+		t.Errorf("unexpected slack resolve: %v", r.body)
+	}
+
+	// a purged incident is resolved by the action itself, as it is gone
+	// once ActionNotify runs
+	check(s, utcNow())
+	s.CheckNotifications()
+	receive("second trigger")
+	st, err = s.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key = fmt.Sprintf("bosun-%d", st.Id)
+	if err := s.ActionByAlertKey("user", "gone", models.ActionPurge, ak); err != nil {
+		t.Fatal(err)
+	}
+	reqs = receive("purge")
+	if r := reqs["pagerduty"]; r.body["event_action"] != "resolve" || r.body["dedup_key"] != key {
+		t.Errorf("unexpected pagerduty resolve of purged incident: %v", r.body)
+	}
+	if r := reqs["opsgenie"]; r.path != "/opsgenie/"+key+"/close" {
+		t.Errorf("unexpected opsgenie close of purged incident: %s %v", r.path, r.body)
+	}
+}
+
+func TestOutboxRetries(t *testing.T) {
+	defer setup()()
+	fail := true
+	rc := make(chan bool, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+		rc <- true
+	}))
+	defer ts.Close()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, fmt.Sprintf(`
+		template t {
+			subject = crit
+		}
+		notification n {
+			post = %s/post
+			retries = 1
+			retryBackoff = 1h
+		}
+		alert a {
+			template = t
+			critNotification = n
+			crit = 1
+		}
+	`, ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive := func(attempt string) {
+		select {
+		case <-rc:
+		case <-time.After(time.Second):
+			t.Fatalf("%s: failed to receive post before timeout", attempt)
+		}
+	}
+	// delivery waits for the first attempt of the delivery of the incident to
+	// be saved.
+	delivery := func(id int64) *models.Delivery {
+		for i := 0; i < 100; i++ {
+			ds, err := s.DataAccess.Outbox().GetIncidentDeliveries(id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(ds) == 1 && ds[0].Attempts > 0 {
+				return ds[0]
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("no attempted delivery of incident %d", id)
+		return nil
+	}
+
+	check(s, utcNow())
+	s.CheckNotifications()
+	receive("first")
+	st, err := s.DataAccess.State().GetLatestIncident("a{}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := delivery(st.Id)
+	if d.Status != models.DeliveryRetrying || d.Attempts != 1 || !strings.Contains(d.LastError, "503") {
+		t.Fatalf("expected retrying delivery after first attempt, got %s %d %q", d.Status, d.Attempts, d.LastError)
+	}
+	if next := d.NextAttempt.Sub(*d.LastAttempt); next != time.Hour {
+		t.Errorf("expected retry in an hour, got %s", next)
+	}
+
+	// make the retry due
+	d.NextAttempt = utcNow().Add(-time.Second)
+	if err := s.DataAccess.Outbox().UpdateDelivery(d); err != nil {
+		t.Fatal(err)
+	}
+	s.sendDeliveries()
+	receive("retry")
+	s.deliveryAttempts.Wait()
+	d = delivery(st.Id)
+	if d.Status != models.DeliveryFailed || d.Attempts != 2 {
+		t.Fatalf("expected failed delivery after retry, got %s %d", d.Status, d.Attempts)
+	}
+	if due, _ := s.DataAccess.Outbox().GetDueDeliveries(utcNow().Add(24 * time.Hour)); len(due) != 0 {
+		t.Errorf("expected no due deliveries, got %d", len(due))
+	}
+
+	fail = false
+	d, err = s.ReplayDelivery(d.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive("replay")
+	if d.Status != models.DeliverySent || d.LastError != "" {
+		t.Fatalf("expected sent delivery after replay, got %s %q", d.Status, d.LastError)
+	}
+}
+
+func TestOutboxRunningAttempt(t *testing.T) {
+	defer setup()()
+	posts := make(chan bool, 10)
+	release := make(chan bool)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posts <- true
+		<-release
+	}))
+	defer ts.Close()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, fmt.Sprintf(`
+		template t {
+			subject = crit
+		}
+		notification n {
+			post = %s/post
+		}
+		alert a {
+			template = t
+			critNotification = n
+			crit = 1
+		}
+	`, ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(s, utcNow())
+	s.CheckNotifications()
+	select {
+	case <-posts:
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive post before timeout")
+	}
+	st, err := s.DataAccess.State().GetLatestIncident("a{}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := s.DataAccess.Outbox().GetIncidentDeliveries(st.Id)
+	if err != nil || len(ds) != 1 {
+		t.Fatalf("expected one delivery, got %d: %v", len(ds), err)
+	}
+
+	// the first attempt outlives its lease: the dispatcher must neither wait
+	// for it nor make another one.
+	d := ds[0]
+	d.NextAttempt = utcNow().Add(-time.Second)
+	if err := s.DataAccess.Outbox().UpdateDelivery(d); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan time.Time)
+	go func() { done <- s.sendDeliveries() }()
+	select {
+	case next := <-done:
+		if !next.After(utcNow()) {
+			t.Errorf("expected the next dispatch to be later, got %s", next)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("sendDeliveries waited for the running attempt")
+	}
+	select {
+	case <-posts:
+		t.Fatal("unexpected second attempt of a running delivery")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+	s.deliveryAttempts.Wait()
+	d, err = s.DataAccess.Outbox().GetDelivery(d.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Status != models.DeliverySent || d.Attempts != 1 {
+		t.Fatalf("expected delivery sent in one attempt, got %s %d", d.Status, d.Attempts)
+	}
+}
+
+func TestEscalation(t *testing.T) {
+	defer setup()()
+	rc := make(chan string, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rc <- r.URL.Path
+	}))
+	defer ts.Close()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, fmt.Sprintf(`
+		template t {
+			subject = crit
+		}
+		notification first {
+			post = %[1]s/first
+		}
+		notification second {
+			post = %[1]s/second
+		}
+		escalation e {
+			level 1 {
+				notification = first
+				delay = 1h
+				repeat = 2
+			}
+			level 2 {
+				notification = second
+			}
+		}
+		alert a {
+			template = t
+			critEscalation = e
+			crit = 1
+		}
+		alert b {
+			template = t
+			critEscalation = e
+			crit = 1
+		}
+	`, ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nd := s.DataAccess.Notifications()
+	checkAlert := func(name string) {
+		s.ctx.runTime = utcNow()
+		s.checkAlert(c.GetAlert(name), s.ctx)
+	}
+	// next makes the escalation of ak due, sends its notification and checks
+	// it was sent to path.
+	next := func(ak models.AlertKey, path string) *models.EscalationState {
+		es, err := nd.GetEscalation(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if es == nil {
+			t.Fatalf("%s: no escalation", path)
+		}
+		es.Due = utcNow().Add(-time.Second)
+		if err := nd.SetEscalation(es); err != nil {
+			t.Fatal(err)
+		}
+		s.CheckNotifications()
+		select {
+		case p := <-rc:
+			if p != path {
+				t.Fatalf("expected notification %s, got %s", path, p)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%s: failed to receive notification before timeout", path)
+		}
+		es, err = nd.GetEscalation(ak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return es
+	}
+
+	checkAlert("a")
+	es := next("a{}", "/first")
+	if es == nil || es.Level != 0 || es.Notified != 1 || es.Due.Sub(utcNow()) < 59*time.Minute {
+		t.Fatalf("unexpected escalation after first notification: %+v", es)
+	}
+	es = next("a{}", "/first")
+	if es == nil || es.Level != 1 || es.Notified != 0 {
+		t.Fatalf("unexpected escalation after repeat: %+v", es)
+	}
+	if es = next("a{}", "/second"); es != nil {
+		t.Fatalf("expected escalation to end after its last level, got %+v", es)
+	}
+
+	// acknowledging the incident ends the escalation
+	checkAlert("b")
+	next("b{}", "/first")
+	if err := s.ActionByAlertKey("user", "on it", models.ActionAcknowledge, "b{}"); err != nil {
+		t.Fatal(err)
+	}
+	if es, err := nd.GetEscalation("b{}"); err != nil || es != nil {
+		t.Fatalf("expected acknowledging to end the escalation, got %+v, %v", es, err)
+	}
+}
+
+func TestNotificationDigest(t *testing.T) {
+	defer setup()()
+	rc := make(chan string, 10)
+	pc := make(chan string, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		if r.URL.Path == "/pagerduty" {
+			pc <- string(b)
+			return
+		}
+		rc <- string(b)
+	}))
+	defer ts.Close()
+	defer func(pd string) {
+		conf.PagerDutyURL = pd
+	}(conf.PagerDutyURL)
+	conf.PagerDutyURL = ts.URL + "/pagerduty"
+	text := fmt.Sprintf(`
+		template t {
+			subject = crit {{.Alert.Name}}
+		}
+		notification n {
+			post = %s
+			pagerduty = pdkey
+			maxPerHour = 1
+			digestInterval = 5m
+		}
+		alert a {
+			template = t
+			critNotification = n
+			crit = 1
+		}
+		alert b {
+			template = t
+			critNotification = n
+			crit = 1
+		}
+		alert c {
+			template = t
+			critNotification = n
+			crit = 1
+		}
+	`, ts.URL)
+	c, err := rule.NewConf("", conf.EnabledBackends{}, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive := func(name string, ch chan string) string {
+		select {
+		case b := <-ch:
+			return b
+		case <-time.After(time.Second):
+			t.Fatalf("%s: failed to receive post before timeout", name)
+		}
+		return ""
+	}
+
+	check(s, utcNow())
+	s.CheckNotifications()
+	if b := receive("notification", rc); !strings.HasPrefix(b, "crit ") {
+		t.Fatalf("unexpected notification %q", b)
+	}
+	// the incidents are triggered at pagerduty even when digested
+	for i := 0; i < 3; i++ {
+		if b := receive("pagerduty", pc); !strings.Contains(b, `"trigger"`) {
+			t.Fatalf("unexpected pagerduty event %q", b)
+		}
+	}
+	s.sendDigests(utcNow())
+	select {
+	case b := <-rc:
+		t.Fatalf("unexpected post before digest interval: %q", b)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// the digest and the rate limit outlive a reload of the rule config
+	c, err = rule.NewConf("", conf.EnabledBackends{}, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Reset()
+	s = DefaultSched
+	if err := s.Init(&conf.SystemConf{}, c, db, false, false, false); err != nil {
+		t.Fatal(err)
+	}
+	if !s.collectDigest(c.Notifications["n"], &models.IncidentState{Subject: "crit d"}) {
+		t.Error("expected the rate limit to hold after reload")
+	}
+	s.sendDigests(utcNow().Add(5 * time.Minute))
+	if b := receive("digest", rc); b != "n: digest of 3 alerts" {
+		t.Fatalf("unexpected digest %q", b)
+	}
+	if len(s.pendingDigests) != 0 {
+		t.Errorf("expected no pending digests, got %d", len(s.pendingDigests))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	htemplate "html/template"
+	"net/url"
 	"strings"
 	ttemplate "text/template"
 	"time"
@@ -15,6 +16,7 @@ import (
 
 func (s *Schedule) dispatchNotifications() {
 	ticker := time.NewTicker(s.SystemConf.GetCheckFrequency() * 2)
+	digests := time.NewTicker(time.Minute)
 	var next <-chan time.Time
 	nextAt := func(t time.Time) {
 		diff := t.Sub(utcNow())
@@ -32,6 +34,8 @@ func (s *Schedule) dispatchNotifications() {
 			nextAt(s.CheckNotifications())
 		case <-ticker.C:
 			s.sendUnknownNotifications()
+		case <-digests.C:
+			s.sendDigests(utcNow())
 		}
 	}
 
@@ -104,6 +108,13 @@ func (s *Schedule) CheckNotifications() time.Time {
 			s.Notify(st, rt, n)
 		}
 	}
+	escalations, err := s.DataAccess.Notifications().GetDueEscalations()
+	if err != nil {
+		slog.Error("Error getting escalations", err)
+	}
+	for _, es := range escalations {
+		s.checkEscalation(es, silenced)
+	}
 	s.sendNotifications(silenced)
 	s.pendingNotifications = nil
 	err = s.DataAccess.Notifications().ClearNotificationsBefore(latestTime)
@@ -119,6 +130,63 @@ func (s *Schedule) CheckNotifications() time.Time {
 	return timeout
 }
 
+// checkEscalation notifies the current level of the due escalation es, and
+// schedules its next notification. The escalation ends with its last level, or
+// when its incident is acknowledged or closed.
+func (s *Schedule) checkEscalation(es *models.EscalationState, silenced SilenceTester) {
+	ak := es.AlertKey
+	end := func() {
+		if err := s.DataAccess.Notifications().ClearEscalation(ak); err != nil {
+			slog.Error(err)
+		}
+	}
+	save := func() {
+		if err := s.DataAccess.Notifications().SetEscalation(es); err != nil {
+			slog.Error(err)
+		}
+	}
+	e := s.RuleConf.GetEscalation(es.Escalation)
+	if e == nil || es.Level >= len(e.Levels) {
+		end()
+		return
+	}
+	// Hold the escalation while the alert key is silenced. It ends when the
+	// silence closes the incident.
+	if si := silenced(ak); si != nil {
+		slog.Infoln("silencing escalation of", ak)
+		es.Due = utcNow().Add(time.Minute)
+		save()
+		return
+	}
+	st, err := s.DataAccess.State().GetLatestIncident(ak)
+	if err != nil {
+		slog.Error(err)
+		return
+	}
+	if st == nil || !st.Open || !st.NeedAck {
+		end()
+		return
+	}
+	rt, err := s.DataAccess.State().GetRenderedTemplates(st.Id)
+	if err != nil {
+		slog.Error(err)
+		return
+	}
+	l := e.Levels[es.Level]
+	s.Notify(st, rt, l.Notification)
+	es.Notified++
+	if l.Repeat != 0 && es.Notified >= l.Repeat {
+		if es.Level == len(e.Levels)-1 {
+			end()
+			return
+		}
+		es.Level++
+		es.Notified = 0
+	}
+	es.Due = utcNow().Add(l.Delay)
+	save()
+}
+
 func (s *Schedule) sendNotifications(silenced SilenceTester) {
 	if s.quiet {
 		slog.Infoln("quiet mode prevented", len(s.pendingNotifications), "notifications")
@@ -138,6 +206,9 @@ func (s *Schedule) sendNotifications(silenced SilenceTester) {
 					continue
 				}
 				s.pendingUnknowns[n] = append(s.pendingUnknowns[n], st.IncidentState)
+				// Unknowns are sent grouped, but the incidents at the
+				// providers are separate.
+				s.deliver(n.NotifyIncident(s.incidentEvent(conf.IncidentTrigger, st.IncidentState, "", "")), st.Id)
 			} else if silenced {
 				slog.Infof("silencing %s", ak)
 				continue
@@ -147,8 +218,13 @@ func (s *Schedule) sendNotifications(silenced SilenceTester) {
 					slog.Error(err)
 				}
 				continue
-			} else {
+			} else if !s.collectDigest(n, st.IncidentState) {
 				s.notify(st.IncidentState, st.RenderedTemplates, n)
+			} else {
+				// The incidents at the providers are triggered even
+				// when digested, so that they can be acknowledged and
+				// resolved later.
+				s.deliver(n.NotifyIncident(s.incidentEvent(conf.IncidentTrigger, st.IncidentState, "", "")), st.Id)
 			}
 			if n.Next != nil {
 				s.QueueNotification(ak, n.Next, utcNow())
@@ -214,7 +290,25 @@ func (s *Schedule) notify(st *models.IncidentState, rt *models.RenderedTemplates
 	if len(rt.EmailBody) == 0 {
 		rt.EmailBody = []byte(rt.Body)
 	}
-	n.Notify(st.Subject, rt.Body, rt.EmailSubject, rt.EmailBody, s.SystemConf, string(st.AlertKey), rt.Attachments...)
+	s.deliver(n.Notify(st.Subject, rt.Body, rt.EmailSubject, rt.EmailBody, string(st.AlertKey), rt.Attachments...), st.Id)
+	s.deliver(n.NotifyIncident(s.incidentEvent(conf.IncidentTrigger, st, "", "")), st.Id)
+}
+
+// incidentEvent returns an event of type t of st for the providers of
+// notifications.
+func (s *Schedule) incidentEvent(t conf.IncidentEventType, st *models.IncidentState, user, message string) *conf.IncidentEvent {
+	return &conf.IncidentEvent{
+		Type:     t,
+		Id:       st.Id,
+		AlertKey: st.AlertKey,
+		Status:   st.CurrentStatus,
+		Subject:  st.Subject,
+		Link: s.SystemConf.MakeLink("/incident", &url.Values{
+			"id": []string{fmt.Sprint(st.Id)},
+		}),
+		User:    user,
+		Message: message,
+	}
 }
 
 // utnotify is single notification for N unknown groups into a single notification
@@ -237,7 +331,7 @@ func (s *Schedule) utnotify(groups map[string]models.AlertKeys, n *conf.Notifica
 	}); err != nil {
 		slog.Errorln(err)
 	}
-	n.Notify(subject, body.String(), []byte(subject), body.Bytes(), s.SystemConf, "unknown_treshold")
+	s.deliver(n.Notify(subject, body.String(), []byte(subject), body.Bytes(), "unknown_treshold"), 0)
 }
 
 var defaultUnknownTemplate = &conf.Template{
@@ -272,7 +366,7 @@ func (s *Schedule) unotify(name string, group models.AlertKeys, n *conf.Notifica
 			slog.Infoln("unknown template error:", err)
 		}
 	}
-	n.Notify(subject.String(), body.String(), subject.Bytes(), body.Bytes(), s.SystemConf, name)
+	s.deliver(n.Notify(subject.String(), body.String(), subject.Bytes(), body.Bytes(), name), 0)
 }
 
 func (s *Schedule) QueueNotification(ak models.AlertKey, n *conf.Notification, started time.Time) error {
@@ -303,12 +397,57 @@ func init() {
 	actionNotificationBodyTemplate = htemplate.Must(htemplate.New("").Parse(body))
 }
 
+var flapNotificationSubjectTemplate *ttemplate.Template
+var flapNotificationBodyTemplate *htemplate.Template
+
+func init() {
+	subject := `Incident #{{.State.Id}} ({{.State.Subject}}) {{if .State.Flapping}}started{{else}}stopped{{end}} flapping`
+	body := `<a href="{{.IncidentLink .State.Id}}">Incident #{{.State.Id}}</a> ({{.State.Subject}})
+{{if .State.Flapping}}started{{else}}stopped{{end}} flapping: it changed status {{.Changes}} times in the last {{.Window}}.<br/>
+{{if .State.Flapping}}No notifications are sent for it until it stops flapping.{{else}}Its current status is {{.State.CurrentStatus}}.{{end}}`
+	flapNotificationSubjectTemplate = ttemplate.Must(ttemplate.New("").Parse(subject))
+	flapNotificationBodyTemplate = htemplate.Must(htemplate.New("").Parse(body))
+}
+
+// flapNotify sends a notification that st started or stopped flapping to the
+// notifications of its worst status.
+func (s *Schedule) flapNotify(a *conf.Alert, st *models.IncidentState, changes int) {
+	if s.quiet {
+		slog.Infoln("quiet mode prevented flapping notification for", st.AlertKey)
+		return
+	}
+	n := a.CritNotification
+	if st.WorstStatus == models.StWarning {
+		n = a.WarnNotification
+	}
+	if n == nil {
+		return
+	}
+	data := flapNotificationContext{st, changes, a.FlapWindow, s}
+	buf := &bytes.Buffer{}
+	if err := flapNotificationSubjectTemplate.Execute(buf, data); err != nil {
+		slog.Error("Error rendering flapping notification subject", err)
+	}
+	subject := buf.String()
+	buf = &bytes.Buffer{}
+	if err := flapNotificationBodyTemplate.Execute(buf, data); err != nil {
+		slog.Error("Error rendering flapping notification body", err)
+	}
+	for _, not := range n.Get(s.RuleConf, st.AlertKey.Group()) {
+		s.deliver(not.Notify(subject, buf.String(), []byte(subject), buf.Bytes(), string(st.AlertKey)), st.Id)
+	}
+}
+
 func (s *Schedule) ActionNotify(at models.ActionType, user, message string, aks []models.AlertKey) error {
 	groupings, err := s.groupActionNotifications(aks)
 	if err != nil {
 		return err
 	}
 	for notification, states := range groupings {
+		s.actionNotifyProviders(notification, at, user, message, states)
+		if !notification.RunOnActions {
+			continue
+		}
 		incidents := []*models.IncidentState{}
 		for _, state := range states {
 			incidents = append(incidents, state)
@@ -328,7 +467,7 @@ func (s *Schedule) ActionNotify(at models.ActionType, user, message string, aks
 			slog.Error("Error rendering action notification body", err)
 		}
 
-		notification.Notify(subject, buf.String(), []byte(subject), buf.Bytes(), s.SystemConf, "actionNotification")
+		s.deliver(notification.Notify(subject, buf.String(), []byte(subject), buf.Bytes(), "actionNotification"), 0)
 	}
 	return nil
 }
@@ -336,26 +475,15 @@ func (s *Schedule) ActionNotify(at models.ActionType, user, message string, aks
 func (s *Schedule) groupActionNotifications(aks []models.AlertKey) (map[*conf.Notification][]*models.IncidentState, error) {
 	groupings := make(map[*conf.Notification][]*models.IncidentState)
 	for _, ak := range aks {
-		alert := s.RuleConf.GetAlert(ak.Name())
 		status, err := s.DataAccess.State().GetLatestIncident(ak)
 		if err != nil {
 			return nil, err
 		}
-		if alert == nil || status == nil {
-			continue
-		}
-		var n *conf.Notifications
-		if status.WorstStatus == models.StWarning || alert.CritNotification == nil {
-			n = alert.WarnNotification
-		} else {
-			n = alert.CritNotification
-		}
-		if n == nil {
+		if status == nil {
 			continue
 		}
-		nots := n.Get(s.RuleConf, ak.Group())
-		for _, not := range nots {
-			if !not.RunOnActions {
+		for _, not := range s.incidentNotifications(status) {
+			if !not.RunOnActions && !not.HasProvider() {
 				continue
 			}
 			groupings[not] = append(groupings[not], status)
@@ -363,3 +491,54 @@ func (s *Schedule) groupActionNotifications(aks []models.AlertKey) (map[*conf.No
 	}
 	return groupings, nil
 }
+
+// incidentNotifications returns the notifications of the worst status of st.
+func (s *Schedule) incidentNotifications(st *models.IncidentState) map[string]*conf.Notification {
+	alert := s.RuleConf.GetAlert(st.AlertKey.Name())
+	if alert == nil {
+		return nil
+	}
+	var n *conf.Notifications
+	if st.WorstStatus == models.StWarning || alert.CritNotification == nil {
+		n = alert.WarnNotification
+	} else {
+		n = alert.CritNotification
+	}
+	if n == nil {
+		return nil
+	}
+	return n.Get(s.RuleConf, st.AlertKey.Group())
+}
+
+// resolveForgotten resolves st at the providers of its notifications once it
+// is forgotten or purged. This cannot wait for ActionNotify, which only finds
+// incidents that still exist.
+func (s *Schedule) resolveForgotten(at models.ActionType, user, message string, st *models.IncidentState) {
+	for _, n := range s.incidentNotifications(st) {
+		s.actionNotifyProviders(n, at, user, message, []*models.IncidentState{st})
+	}
+}
+
+// actionNotifyProviders acknowledges or resolves states at the providers of
+// n, if the action at acknowledged, closed, forgot or purged them.
+func (s *Schedule) actionNotifyProviders(n *conf.Notification, at models.ActionType, user, message string, states []*models.IncidentState) {
+	if !n.HasProvider() {
+		return
+	}
+	var t conf.IncidentEventType
+	switch at {
+	case models.ActionAcknowledge:
+		t = conf.IncidentAcknowledge
+	case models.ActionClose, models.ActionForceClose, models.ActionForget, models.ActionPurge:
+		t = conf.IncidentResolve
+	default:
+		return
+	}
+	if s.quiet {
+		slog.Infoln("quiet mode prevented", len(states), "incident", t, "events")
+		return
+	}
+	for _, st := range states {
+		s.deliver(n.NotifyIncident(s.incidentEvent(t, st, user, message)), st.Id)
+	}
+}
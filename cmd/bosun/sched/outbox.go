@@ -0,0 +1,241 @@
+package sched
+
+import (
+	"fmt"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+)
+
+func init() {
+	metadata.AddMetricMeta(
+		"bosun.outbox.attempts", metadata.Counter, metadata.Count,
+		"The number of attempts to send a notification delivery, by action and result.")
+}
+
+const (
+	// deliveryLease is how long an attempt of a delivery may take before the
+	// dispatcher considers it lost and makes another one.
+	deliveryLease = time.Minute
+	// deliveryRetention is how long sent and failed deliveries are kept.
+	deliveryRetention = 7 * 24 * time.Hour
+)
+
+// deliver saves ds to the outbox and makes a first attempt to send each of
+// them. Deliveries that fail are retried by dispatchDeliveries.
+func (s *Schedule) deliver(ds []*models.Delivery, incidentId int64) {
+	for _, d := range ds {
+		if incidentId != 0 {
+			d.IncidentId = incidentId
+		}
+		d.NextAttempt = utcNow().Add(deliveryLease)
+		if err := s.DataAccess.Outbox().AddDelivery(d); err != nil {
+			slog.Errorf("error saving %s delivery of %s: %v", d.Action, d.AlertKey, err)
+			continue
+		}
+		s.claimDelivery(d.Id)
+		s.startDelivery(d)
+	}
+}
+
+// claimDelivery marks an attempt of the delivery with the given id as running,
+// so that no other one is made until releaseDelivery. It returns false if one
+// is already running.
+func (s *Schedule) claimDelivery(id int64) bool {
+	s.deliveryLock.Lock()
+	defer s.deliveryLock.Unlock()
+	if s.deliveriesRunning[id] {
+		return false
+	}
+	if s.deliveriesRunning == nil {
+		s.deliveriesRunning = make(map[int64]bool)
+	}
+	s.deliveriesRunning[id] = true
+	return true
+}
+
+func (s *Schedule) releaseDelivery(id int64) {
+	s.deliveryLock.Lock()
+	defer s.deliveryLock.Unlock()
+	delete(s.deliveriesRunning, id)
+}
+
+// startDelivery attempts the claimed delivery d in the background.
+func (s *Schedule) startDelivery(d *models.Delivery) {
+	s.deliveryAttempts.Add(1)
+	go func() {
+		defer s.deliveryAttempts.Done()
+		defer s.releaseDelivery(d.Id)
+		s.attemptDelivery(d)
+	}()
+}
+
+// attemptDelivery makes one attempt to send d and saves the result.
+func (s *Schedule) attemptDelivery(d *models.Delivery) {
+	var dl conf.Deliverer
+	if d.Action == conf.ActionWebhook {
+		if w := s.SystemConf.GetWebhook(d.Notification); w != nil {
+			dl = w
+		}
+	} else if n := s.RuleConf.GetNotification(d.Notification); n != nil {
+		dl = n
+	}
+	now := utcNow()
+	if dl == nil {
+		d.Status = models.DeliveryFailed
+		d.LastError = fmt.Sprintf("unknown %s %s", deliveryKind(d), d.Notification)
+	} else {
+		d.Attempts++
+		d.LastAttempt = &now
+		err := dl.Deliver(d, s.SystemConf)
+		delay, retry := dl.RetryDelay(d.Attempts)
+		switch {
+		case err == nil:
+			d.Status = models.DeliverySent
+			d.LastError = ""
+		case retry:
+			d.Status = models.DeliveryRetrying
+			d.LastError = err.Error()
+			d.NextAttempt = now.Add(delay)
+		default:
+			d.Status = models.DeliveryFailed
+			d.LastError = err.Error()
+		}
+	}
+	collect.Add("outbox.attempts", opentsdb.TagSet{"action": d.Action, "result": d.Status.String()}, 1)
+	switch d.Status {
+	case models.DeliveryRetrying:
+		slog.Warningf("%s %s %s of %s failed, attempt %d, retrying at %s: %s", d.Action, deliveryKind(d), d.Notification, d.AlertKey, d.Attempts, d.NextAttempt.Format(time.RFC3339), d.LastError)
+	case models.DeliveryFailed:
+		slog.Errorf("%s %s %s of %s failed, giving up after %d attempts: %s", d.Action, deliveryKind(d), d.Notification, d.AlertKey, d.Attempts, d.LastError)
+	}
+	if err := s.DataAccess.Outbox().UpdateDelivery(d); err != nil {
+		slog.Errorf("error saving %s delivery of %s: %v", d.Action, d.AlertKey, err)
+		return
+	}
+	if d.Status == models.DeliveryRetrying && s.oc != nil {
+		select {
+		case s.oc <- true:
+		default:
+		}
+	}
+}
+
+// deliveryKind returns what sends d: a notification or a webhook.
+func deliveryKind(d *models.Delivery) string {
+	if d.Action == conf.ActionWebhook {
+		return "webhook"
+	}
+	return "notification"
+}
+
+// dispatchDeliveries retries deliveries of the outbox when they are due, and
+// purges old ones.
+func (s *Schedule) dispatchDeliveries() {
+	purge := time.NewTicker(time.Hour)
+	defer purge.Stop()
+	var next <-chan time.Time
+	nextAt := func(t time.Time) {
+		diff := time.Minute
+		if !t.IsZero() {
+			diff = t.Sub(utcNow())
+		}
+		if diff <= 0 {
+			diff = time.Millisecond
+		} else if diff > time.Minute {
+			diff = time.Minute
+		}
+		next = time.After(diff)
+	}
+	nextAt(utcNow())
+	for {
+		select {
+		case <-s.runnerContext.Done():
+			return
+		case <-next:
+			nextAt(s.sendDeliveries())
+		case <-s.oc:
+			t, err := s.DataAccess.Outbox().GetNextDeliveryTime()
+			if err != nil {
+				slog.Errorln("error getting next delivery time:", err)
+			}
+			nextAt(t)
+		case <-purge.C:
+			if err := s.DataAccess.Outbox().PurgeDeliveries(utcNow().Add(-deliveryRetention)); err != nil {
+				slog.Errorln("error purging deliveries:", err)
+			}
+		}
+	}
+}
+
+// sendDeliveries starts attempts of the deliveries that are due, without
+// waiting for them. Deliveries with an attempt still running are left to it.
+// It returns the time the next one is due.
+func (s *Schedule) sendDeliveries() time.Time {
+	ds, err := s.DataAccess.Outbox().GetDueDeliveries(utcNow())
+	if err != nil {
+		slog.Errorln("error getting due deliveries:", err)
+		return utcNow().Add(time.Minute)
+	}
+	running := false
+	for _, due := range ds {
+		if !s.claimDelivery(due.Id) {
+			running = true
+			continue
+		}
+		// an attempt that was running when ds was read may have ended since.
+		d, err := s.DataAccess.Outbox().GetDelivery(due.Id)
+		if err != nil {
+			slog.Errorln("error getting delivery:", err)
+		}
+		if err != nil || d == nil || d.IsDone() || d.NextAttempt.After(utcNow()) {
+			s.releaseDelivery(due.Id)
+			continue
+		}
+		d.NextAttempt = utcNow().Add(deliveryLease)
+		if err := s.DataAccess.Outbox().UpdateDelivery(d); err != nil {
+			slog.Errorln("error saving delivery:", err)
+			s.releaseDelivery(d.Id)
+			continue
+		}
+		s.startDelivery(d)
+	}
+	t, err := s.DataAccess.Outbox().GetNextDeliveryTime()
+	if err != nil {
+		slog.Errorln("error getting next delivery time:", err)
+		return utcNow().Add(time.Minute)
+	}
+	if running && t.Before(utcNow().Add(time.Second)) {
+		// an attempt overran its lease: look again once it may have ended.
+		t = utcNow().Add(time.Second)
+	}
+	return t
+}
+
+// ReplayDelivery sends the delivery with the given id again, regardless of
+// its status.
+func (s *Schedule) ReplayDelivery(id int64) (*models.Delivery, error) {
+	d, err := s.DataAccess.Outbox().GetDelivery(id)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, fmt.Errorf("delivery %d not found", id)
+	}
+	if !s.claimDelivery(id) {
+		return nil, fmt.Errorf("delivery %d is being sent", id)
+	}
+	defer s.releaseDelivery(id)
+	d.Status = models.DeliveryPending
+	d.NextAttempt = utcNow().Add(deliveryLease)
+	if err := s.DataAccess.Outbox().UpdateDelivery(d); err != nil {
+		return nil, err
+	}
+	s.attemptDelivery(d)
+	return d, nil
+}
@@ -0,0 +1,134 @@
+package sched
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+
+	"bosun.org/cmd/bosun/cache"
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/expr"
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+func init() {
+	metadata.AddMetricMeta(
+		"bosun.record.datapoints", metadata.Counter, metadata.Count,
+		"Number of datapoints written by a recording rule.")
+	metadata.AddMetricMeta(
+		"bosun.record.errs", metadata.Counter, metadata.Count,
+		"Number of failed evaluations or writes of a recording rule.")
+}
+
+func (s *Schedule) runRecord(r *conf.Record, ch <-chan *checkContext) {
+	s.checksRunning.Add(1)
+	defer s.checksRunning.Done()
+	for {
+		select {
+		case <-s.runnerContext.Done():
+			slog.Infof("Stopping record routine for %v\n", r.Name)
+			return
+		case ctx := <-ch:
+			s.checkRecord(r, ctx)
+		}
+	}
+}
+
+func (s *Schedule) checkRecord(r *conf.Record, ctx *checkContext) {
+	start := utcNow()
+	dps, err := s.EvalRecord(nil, r, ctx.runTime, ctx.checkCache)
+	if err == nil {
+		err = s.putRecord(r, dps)
+	}
+	if err != nil {
+		collect.Add("record.errs", opentsdb.TagSet{"record": r.Name}, 1)
+		slog.Errorf("record %s: %v", r.Name, err)
+		return
+	}
+	slog.Infof("record %s wrote %d datapoints in %v\n", r.Name, len(dps), time.Since(start))
+}
+
+// EvalRecord evaluates the expression of r at now and returns a datapoint of
+// the record's metric for each result group. A result without tags is
+// tagged with the name of the record, as OpenTSDB requires at least one tag.
+// Results that are NaN or infinite are skipped.
+func (s *Schedule) EvalRecord(T miniprofiler.Timer, r *conf.Record, now time.Time, c *cache.Cache) (opentsdb.MultiDataPoint, error) {
+	rh := s.NewRunHistory(now, c)
+	providers := &expr.BosunProviders{
+		Cache:  rh.Cache,
+		Search: s.Search,
+	}
+	results, _, err := r.Expr.Execute(rh.Backends, providers, T, now, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	var dps opentsdb.MultiDataPoint
+	for _, res := range results.Results {
+		var v float64
+		switch rv := res.Value.(type) {
+		case expr.Number:
+			v = float64(rv)
+		case expr.Scalar:
+			v = float64(rv)
+		default:
+			return nil, fmt.Errorf("expected a number, got %v", res.Type())
+		}
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		tags := res.Group.Copy().Merge(r.Tags)
+		if len(tags) == 0 {
+			tags["record"] = r.Name
+		}
+		dp := &opentsdb.DataPoint{
+			Metric:    r.Metric,
+			Timestamp: now.Unix(),
+			Value:     v,
+			Tags:      tags,
+		}
+		if err := dp.Clean(); err != nil {
+			return nil, fmt.Errorf("%v: %v", res.Group, err)
+		}
+		dps = append(dps, dp)
+	}
+	return dps, nil
+}
+
+// putRecord writes the datapoints of r to the TSDB, unless bosun runs in
+// readonly mode.
+func (s *Schedule) putRecord(r *conf.Record, dps opentsdb.MultiDataPoint) error {
+	if len(dps) == 0 {
+		return nil
+	}
+	if s.readonly {
+		slog.Infof("readonly: not writing %d datapoints of record %s\n", len(dps), r.Name)
+		return nil
+	}
+	host := s.SystemConf.GetTSDBHost()
+	if host == "" {
+		return fmt.Errorf("no OpenTSDB host to write to")
+	}
+	u := &url.URL{
+		Scheme: "http",
+		Host:   host,
+		Path:   "/api/put",
+	}
+	resp, err := collect.SendDataPoints(dps, u.String())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("put: %v: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+	collect.Add("record.datapoints", opentsdb.TagSet{"record": r.Name}, int64(len(dps)))
+	return nil
+}
@@ -0,0 +1,92 @@
+package sched
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/opentsdb"
+)
+
+func TestCheckRecord(t *testing.T) {
+	defer setup()()
+	puts := make(chan opentsdb.MultiDataPoint, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/put" {
+			t.Errorf("unexpected path %v", r.URL.Path)
+		}
+		g, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		var dps opentsdb.MultiDataPoint
+		if err := json.NewDecoder(g).Decode(&dps); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+		puts <- dps
+	}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := rule.NewConf("", conf.EnabledBackends{OpenTSDB: true}, `
+		record cpu {
+			expr = avg(merge(series("host=a", 0, 1, 60, 3), series("host=b", 0, 5), series("host=c", 0, 0/0)))
+			metric = os.cpu.avg
+			tags = src=bosun
+			runEvery = 5
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := c.Records["cpu"]
+	if r.RunEvery != 5 {
+		t.Errorf("got runEvery %v, expected 5", r.RunEvery)
+	}
+	sc := &conf.SystemConf{OpenTSDBConf: conf.OpenTSDBConf{Host: u.Host}}
+	s, err := initSched(sc, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
+	s.checkRecord(r, &checkContext{now, s.checkCache(), false})
+	var dps opentsdb.MultiDataPoint
+	select {
+	case dps = <-puts:
+	case <-time.After(time.Second):
+		t.Fatal("no datapoints written")
+	}
+	expect := map[string]float64{
+		"{host=a,src=bosun}": 2,
+		"{host=b,src=bosun}": 5,
+	}
+	if len(dps) != len(expect) {
+		t.Fatalf("got %v datapoints, expected %v", len(dps), len(expect))
+	}
+	for _, dp := range dps {
+		if dp.Metric != "os.cpu.avg" || dp.Timestamp != now.Unix() {
+			t.Errorf("unexpected datapoint %v", dp)
+		}
+		if v, ok := expect[dp.Tags.String()]; !ok || dp.Value != v {
+			t.Errorf("%v: got %v, expected %v", dp.Tags, dp.Value, v)
+		}
+	}
+
+	s.readonly = true
+	s.checkRecord(r, &checkContext{now, s.checkCache(), false})
+	select {
+	case <-puts:
+		t.Error("datapoints written in readonly mode")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
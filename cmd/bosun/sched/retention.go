@@ -0,0 +1,176 @@
+package sched
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+func init() {
+	metadata.AddMetricMeta(
+		"bosun.retention.scanned", metadata.Counter, metadata.Incident,
+		"The number of incidents scanned by the retention job.")
+	metadata.AddMetricMeta(
+		"bosun.retention.deleted", metadata.Counter, metadata.Incident,
+		"The number of closed incidents deleted by the retention job.")
+	metadata.AddMetricMeta(
+		"bosun.retention.archived", metadata.Counter, metadata.Incident,
+		"The number of incidents exported to the archive directory before they were deleted.")
+	metadata.AddMetricMeta(
+		"bosun.retention.events_dropped", metadata.Counter, metadata.Event,
+		"The number of incident events dropped by the retention job.")
+	metadata.AddMetricMeta(
+		"bosun.retention.templates_dropped", metadata.Counter, metadata.Incident,
+		"The number of incidents whose rendered templates were dropped by the retention job.")
+	metadata.AddMetricMeta(
+		"bosun.retention.progress", metadata.Gauge, metadata.Pct,
+		"The percentage of incidents the current run of the retention job has scanned.")
+	metadata.AddMetricMeta(
+		"bosun.retention.duration", metadata.Gauge, metadata.Second,
+		"The time the last run of the retention job took.")
+}
+
+// retainIncidents applies the incident retention every interval until the
+// schedule stops.
+func (s *Schedule) retainIncidents() {
+	rc := s.SystemConf.GetRetentionConf()
+	if !rc.Enabled() {
+		return
+	}
+	ticker := time.NewTicker(rc.Interval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.runnerContext.Done():
+			return
+		case <-ticker.C:
+			start := time.Now()
+			if err := s.applyRetention(rc, utcNow()); err != nil {
+				slog.Errorln("error applying incident retention:", err)
+			}
+			collect.Put("retention.duration", nil, time.Since(start).Seconds())
+		}
+	}
+}
+
+// retentionBatch is the number of incidents the retention job reads at once.
+var retentionBatch = 1000
+
+// applyRetention drops the old events and rendered templates of closed
+// incidents, and archives and deletes those that ended before the retention
+// of rc at now. Events of open incidents are capped by runHistory. Incidents
+// are scanned in batches of summaries, and only loaded in full to be trimmed
+// or archived.
+func (s *Schedule) applyRetention(rc conf.RetentionConf, now time.Time) error {
+	data := s.DataAccess.State()
+	deleteBefore := now.AddDate(0, 0, -rc.ClosedIncidentDays)
+	templatesBefore := now.Add(-rc.RenderedTemplates.Duration)
+	var expired []int64
+	seen := make(map[int64]bool)
+	for start, total := 0, 1; start < total; start += retentionBatch {
+		var summaries []*models.IncidentSummary
+		var err error
+		summaries, total, err = data.GetIncidentSummaries(start, retentionBatch)
+		if err != nil {
+			return err
+		}
+		if total > 0 {
+			collect.Put("retention.progress", nil, 100*start/total)
+		}
+		for _, st := range summaries {
+			if seen[st.Id] {
+				continue
+			}
+			seen[st.Id] = true
+			collect.Add("retention.scanned", nil, 1)
+			if st.Open || st.End == nil {
+				continue
+			}
+			if rc.ClosedIncidentDays > 0 && st.End.Before(deleteBefore) {
+				expired = append(expired, st.Id)
+				continue
+			}
+			if rc.RenderedTemplates.Duration > 0 && st.End.Before(templatesBefore) {
+				dropped, err := data.DeleteRenderedTemplates(st.Id)
+				if err != nil {
+					return err
+				}
+				if dropped {
+					collect.Add("retention.templates_dropped", nil, 1)
+				}
+			}
+			if rc.MaxEvents > 0 && st.NumEvents > rc.MaxEvents {
+				n, err := data.TrimIncidentEvents(st.Id, rc.MaxEvents)
+				if err != nil {
+					return err
+				}
+				collect.Add("retention.events_dropped", nil, int64(n))
+			}
+		}
+	}
+	collect.Put("retention.progress", nil, 100)
+	if len(expired) == 0 {
+		return nil
+	}
+	if rc.ArchiveDir != "" {
+		name, err := archiveIncidents(rc.ArchiveDir, expired, data.GetIncidentState, now)
+		if err != nil {
+			return fmt.Errorf("not deleting incidents, failed to archive them: %v", err)
+		}
+		collect.Add("retention.archived", nil, int64(len(expired)))
+		slog.Infof("archived %d incidents to %s", len(expired), name)
+	}
+	for _, id := range expired {
+		if err := data.DeleteIncident(id); err != nil {
+			return err
+		}
+		collect.Add("retention.deleted", nil, 1)
+	}
+	slog.Infof("deleted %d incidents closed before %s", len(expired), deleteBefore.Format(time.RFC3339))
+	return nil
+}
+
+// archiveIncidents writes the incidents of ids, as read by get, to a gzipped
+// file in dir, one JSON incident per line, and returns its name. The file only
+// gets its name once it is complete.
+func archiveIncidents(dir string, ids []int64, get func(int64) (*models.IncidentState, error), now time.Time) (string, error) {
+	name := filepath.Join(dir, fmt.Sprintf("incidents-%s.json.gz", now.UTC().Format("20060102T150405Z")))
+	f, err := os.Create(name + ".tmp")
+	if err != nil {
+		return "", err
+	}
+	zw := gzip.NewWriter(f)
+	enc := json.NewEncoder(zw)
+	for _, id := range ids {
+		var st *models.IncidentState
+		if st, err = get(id); err != nil {
+			break
+		}
+		if err = enc.Encode(st); err != nil {
+			break
+		}
+	}
+	if cerr := zw.Close(); err == nil {
+		err = cerr
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(name+".tmp", name)
+	}
+	if err != nil {
+		os.Remove(name + ".tmp")
+		return "", err
+	}
+	return name, nil
+}
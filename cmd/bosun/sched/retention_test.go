@@ -0,0 +1,114 @@
+package sched
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+func TestRetention(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, `alert a {
+		crit = 1
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{}, c)
+	dir, err := ioutil.TempDir("", "bosun-archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	rc := conf.RetentionConf{
+		ClosedIncidentDays: 30,
+		MaxEvents:          2,
+		RenderedTemplates:  conf.Duration{Duration: 7 * 24 * time.Hour},
+		ArchiveDir:         dir,
+	}
+
+	now := time.Date(2017, 3, 10, 12, 0, 0, 0, time.UTC)
+	events := []models.Event{{Status: models.StWarning}, {Status: models.StCritical}, {Status: models.StNormal}}
+	incident := func(host string, ended time.Duration) int64 {
+		ak := models.NewAlertKey("a", opentsdb.TagSet{"host": host})
+		st := &models.IncidentState{AlertKey: ak, Alert: "a", Start: now.Add(-ended - time.Hour), Events: events, Open: ended == 0}
+		if ended != 0 {
+			end := now.Add(-ended)
+			st.End = &end
+		}
+		id, err := s.DataAccess.State().UpdateIncidentState(st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.DataAccess.State().SetRenderedTemplates(id, &models.RenderedTemplates{Body: "body"}); err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	open := incident("open", 0)
+	recent := incident("recent", time.Hour)
+	week := incident("week", 10*24*time.Hour)
+	expired := incident("expired", 40*24*time.Hour)
+
+	defer func(b int) { retentionBatch = b }(retentionBatch)
+	retentionBatch = 3
+	if err := s.applyRetention(rc, now); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		id        int64
+		events    int
+		templates bool
+	}{
+		{open, 3, true},
+		{recent, 2, true},
+		{week, 2, false},
+	} {
+		st, err := s.DataAccess.State().GetIncidentState(c.id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(st.Events) != c.events {
+			t.Errorf("incident %d: expected %d events, got %d", c.id, c.events, len(st.Events))
+		}
+		_, err = s.DataAccess.State().GetRenderedTemplates(c.id)
+		if (err == nil) != c.templates {
+			t.Errorf("incident %d: expected rendered templates %v, got error %v", c.id, c.templates, err)
+		}
+	}
+	if _, err := s.DataAccess.State().GetIncidentState(expired); err == nil {
+		t.Errorf("expected incident %d to be deleted", expired)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json.gz"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("expected one archive, got %v (%v)", files, err)
+	}
+	f, err := os.Open(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var archived models.IncidentState
+	dec := json.NewDecoder(zr)
+	if err := dec.Decode(&archived); err != nil {
+		t.Fatal(err)
+	}
+	if archived.Id != expired || dec.More() {
+		t.Errorf("expected only incident %d in the archive, got %d", expired, archived.Id)
+	}
+}
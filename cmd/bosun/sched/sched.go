@@ -22,7 +22,9 @@ import (
 	"github.com/kylebrandt/boolq"
 )
 
-func utcNow() time.Time {
+// utcNow returns the current time in UTC. Tests may replace it to run checks
+// at a fixed time.
+var utcNow = func() time.Time {
 	return time.Now().UTC()
 }
 
@@ -40,20 +42,43 @@ type Schedule struct {
 
 	skipLast bool
 	quiet    bool
+	readonly bool
 
 	//channel signals an alert has added notifications, and notifications should be processed.
 	nc chan interface{}
 	//notifications to be sent immediately
 	pendingNotifications map[*conf.Notification][]*IncidentWithTemplates
+	//channel signals a delivery of the outbox is to be retried.
+	oc chan interface{}
+	//ids of the deliveries being attempted, so that the dispatcher does not
+	//attempt them again while they run.
+	deliveriesRunning map[int64]bool
+	deliveryLock      sync.Mutex
+	//waits for the running attempts of deliveries.
+	deliveryAttempts sync.WaitGroup
 
 	//unknown states that need to be notified about. Collected and sent in batches.
 	pendingUnknowns map[*conf.Notification][]*models.IncidentState
 
+	//states held back by maxPerHour or digestInterval of notifications, by
+	//notification name. Sent in digests. Kept across config reloads.
+	pendingDigests map[string]*pendingDigest
+	//times notifications with a maxPerHour notified in the last hour, by
+	//notification name. Kept across config reloads.
+	notificationTimes map[string][]time.Time
+
 	lastLogTimes map[models.AlertKey]time.Time
 	LastCheck    time.Time
 
+	//stream of status changes, actions, silences and reloads. Kept by Reset.
+	events *eventStream
+
 	ctx *checkContext
 
+	// QueryCache is the query cache shared by all checks, if one is configured.
+	// Init only creates it if it is not set already.
+	QueryCache *cache.Cache
+
 	DataAccess database.DataAccess
 
 	// runnerContext is a context to track running alert routines
@@ -66,7 +91,7 @@ type Schedule struct {
 	checksRunning sync.WaitGroup
 }
 
-func (s *Schedule) Init(systemConf conf.SystemConfProvider, ruleConf conf.RuleConfProvider, dataAccess database.DataAccess, skipLast, quiet bool) error {
+func (s *Schedule) Init(systemConf conf.SystemConfProvider, ruleConf conf.RuleConfProvider, dataAccess database.DataAccess, skipLast, quiet, readonly bool) error {
 	//initialize all variables and collections so they are ready to use.
 	//this will be called once at app start, and also every time the rule
 	//page runs, so be careful not to spawn long running processes that can't
@@ -74,13 +99,23 @@ func (s *Schedule) Init(systemConf conf.SystemConfProvider, ruleConf conf.RuleCo
 	//var err error
 	s.skipLast = skipLast
 	s.quiet = quiet
+	s.readonly = readonly
 	s.SystemConf = systemConf
 	s.RuleConf = ruleConf
 	s.Group = make(map[time.Time]models.AlertKeys)
 	s.pendingUnknowns = make(map[*conf.Notification][]*models.IncidentState)
+	if s.pendingDigests == nil {
+		s.pendingDigests = make(map[string]*pendingDigest)
+	}
+	if s.notificationTimes == nil {
+		s.notificationTimes = make(map[string][]time.Time)
+	}
 	s.lastLogTimes = make(map[models.AlertKey]time.Time)
 	s.LastCheck = utcNow()
-	s.ctx = &checkContext{utcNow(), cache.New(0)}
+	if qc := systemConf.GetQueryCacheConf(); qc.MaxEntries > 0 && s.QueryCache == nil {
+		s.QueryCache = cache.NewShared("checks", qc.MaxEntries, qc.TTL.Duration, qc.BackendTTL.Map())
+	}
+	s.ctx = &checkContext{utcNow(), s.checkCache(), false}
 	s.DataAccess = dataAccess
 	// Initialize the context and waitgroup used to gracefully shutdown bosun as well as reload
 	s.runnerContext, s.cancelChecks = context.WithCancel(context.Background())
@@ -89,12 +124,25 @@ func (s *Schedule) Init(systemConf conf.SystemConfProvider, ruleConf conf.RuleCo
 	if s.Search == nil {
 		s.Search = search.NewSearch(s.DataAccess, skipLast)
 	}
+	if s.events == nil {
+		s.events = newEventStream()
+	}
 	return nil
 }
 
 type checkContext struct {
 	runTime    time.Time
 	checkCache *cache.Cache
+	inactive   bool // the alert is outside its active windows
+}
+
+// checkCache returns the query cache for a check cycle: the shared query
+// cache if there is one, otherwise a cache for just that cycle.
+func (s *Schedule) checkCache() *cache.Cache {
+	if s.QueryCache != nil {
+		return s.QueryCache
+	}
+	return cache.New(0)
 }
 
 func init() {
@@ -215,10 +263,11 @@ type StateTuple struct {
 	Active        bool
 	Status        models.Status
 	CurrentStatus models.Status
+	PendingStatus models.Status
 	Silenced      bool
 }
 
-// GroupStates groups by NeedAck, Active, Status, and Silenced.
+// GroupStates groups by NeedAck, Active, Status, PendingStatus and Silenced.
 func (states States) GroupStates(silenced SilenceTester) map[StateTuple]States {
 	r := make(map[StateTuple]States)
 	for ak, st := range states {
@@ -228,6 +277,7 @@ func (states States) GroupStates(silenced SilenceTester) map[StateTuple]States {
 			Active:        st.IsActive(),
 			Status:        st.LastAbnormalStatus,
 			CurrentStatus: st.CurrentStatus,
+			PendingStatus: st.PendingStatus,
 			Silenced:      sil,
 		}
 		if _, present := r[t]; !present {
@@ -328,6 +378,7 @@ type StateGroup struct {
 	Active        bool `json:",omitempty"`
 	Status        models.Status
 	CurrentStatus models.Status
+	PendingStatus models.Status `json:",omitempty"`
 	Silenced      bool
 	IsError       bool                  `json:",omitempty"`
 	Subject       string                `json:",omitempty"`
@@ -342,6 +393,7 @@ type StateGroups struct {
 	Groups struct {
 		NeedAck      []*StateGroup `json:",omitempty"`
 		Acknowledged []*StateGroup `json:",omitempty"`
+		Pending      []*StateGroup `json:",omitempty"`
 	}
 	TimeAndDate                   []int
 	FailingAlerts, UnclosedErrors int
@@ -405,6 +457,12 @@ func (s *Schedule) MarshalGroups(T miniprofiler.Timer, filter string) (*StateGro
 	T.Step("groups", func(T miniprofiler.Timer) {
 		for tuple, states := range groups {
 			var grouped []*StateGroup
+			// Alert keys that have not been abnormal yet are grouped by
+			// the status they are pending.
+			pending := tuple.Status <= models.StNormal && tuple.PendingStatus > models.StNormal
+			if pending {
+				tuple.Status = tuple.PendingStatus
+			}
 			switch tuple.Status {
 			case models.StWarning, models.StCritical, models.StUnknown:
 				var sets map[string]models.AlertKeys
@@ -416,21 +474,23 @@ func (s *Schedule) MarshalGroups(T miniprofiler.Timer, filter string) (*StateGro
 						Active:        tuple.Active,
 						Status:        tuple.Status,
 						CurrentStatus: tuple.CurrentStatus,
+						PendingStatus: tuple.PendingStatus,
 						Silenced:      tuple.Silenced,
 						Subject:       fmt.Sprintf("%s - %s", tuple.Status, name),
 					}
 					for _, ak := range group {
 						st := status[ak]
 						g.Children = append(g.Children, &StateGroup{
-							Active:   tuple.Active,
-							Status:   tuple.Status,
-							Silenced: tuple.Silenced,
-							AlertKey: ak,
-							Alert:    ak.Name(),
-							Subject:  string(st.Subject),
-							Ago:      marshalTime(st.Last().Time),
-							State:    st,
-							IsError:  !s.AlertSuccessful(ak.Name()),
+							Active:        tuple.Active,
+							Status:        tuple.Status,
+							PendingStatus: tuple.PendingStatus,
+							Silenced:      tuple.Silenced,
+							AlertKey:      ak,
+							Alert:         ak.Name(),
+							Subject:       string(st.Subject),
+							Ago:           marshalTime(st.Last().Time),
+							State:         st,
+							IsError:       !s.AlertSuccessful(ak.Name()),
 						})
 					}
 					if len(g.Children) == 1 && g.Children[0].Subject != "" {
@@ -441,7 +501,9 @@ func (s *Schedule) MarshalGroups(T miniprofiler.Timer, filter string) (*StateGro
 			default:
 				continue
 			}
-			if tuple.NeedAck {
+			if pending {
+				t.Groups.Pending = append(t.Groups.Pending, grouped...)
+			} else if tuple.NeedAck {
 				t.Groups.NeedAck = append(t.Groups.NeedAck, grouped...)
 			} else {
 				t.Groups.Acknowledged = append(t.Groups.Acknowledged, grouped...)
@@ -469,6 +531,7 @@ func (s *Schedule) MarshalGroups(T miniprofiler.Timer, filter string) (*StateGro
 		}
 		slice.Sort(t.Groups.NeedAck, gsort(t.Groups.NeedAck))
 		slice.Sort(t.Groups.Acknowledged, gsort(t.Groups.Acknowledged))
+		slice.Sort(t.Groups.Pending, gsort(t.Groups.Pending))
 	})
 	return &t, nil
 }
@@ -484,8 +547,8 @@ func marshalTime(t time.Time) string {
 var DefaultSched = &Schedule{}
 
 // Load loads a configuration into the default schedule.
-func Load(systemConf conf.SystemConfProvider, ruleConf conf.RuleConfProvider, dataAccess database.DataAccess, skipLast, quiet bool) error {
-	return DefaultSched.Init(systemConf, ruleConf, dataAccess, skipLast, quiet)
+func Load(systemConf conf.SystemConfProvider, ruleConf conf.RuleConfProvider, dataAccess database.DataAccess, skipLast, quiet, readonly bool) error {
+	return DefaultSched.Init(systemConf, ruleConf, dataAccess, skipLast, quiet, readonly)
 }
 
 // Run runs the default schedule.
@@ -510,7 +573,11 @@ func (s *Schedule) Close(reload bool) {
 }
 
 func (s *Schedule) Reset() {
-	DefaultSched = &Schedule{}
+	DefaultSched = &Schedule{
+		events:            s.events,
+		pendingDigests:    s.pendingDigests,
+		notificationTimes: s.notificationTimes,
+	}
 }
 
 func Reset() {
@@ -564,6 +631,18 @@ func (s *Schedule) action(user, message string, t models.ActionType, st *models.
 			if err := s.DataAccess.Notifications().ClearNotifications(st.AlertKey); err != nil {
 				e = err
 			}
+			s.publish(&StreamEvent{
+				Type:       StreamAction,
+				AlertKey:   st.AlertKey,
+				IncidentId: st.Id,
+				Action:     &models.Action{User: user, Message: message, Time: utcNow(), Type: t},
+			})
+			if t == models.ActionForget || t == models.ActionPurge {
+				s.resolveForgotten(t, user, message, st)
+			}
+			if event, ok := actionWebhookEvents[t]; ok {
+				s.notifyWebhooks(event, st)
+			}
 		}
 	}()
 	isUnknown := st.LastAbnormalStatus == models.StUnknown
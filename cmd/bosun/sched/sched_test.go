@@ -57,14 +57,14 @@ func check(s *Schedule, t time.Time) {
 var db database.DataAccess
 
 func setup() func() {
-	testDb, closer := dbtest.StartTestRedis(9992)
+	testDb, closer := dbtest.StartTestDataAccess(9992)
 	db = testDb
 	return closer
 }
 
 func initSched(sc conf.SystemConfProvider, c conf.RuleConfProvider) (*Schedule, error) {
 	s := new(Schedule)
-	err := s.Init(sc, c, db, false, false)
+	err := s.Init(sc, c, db, false, false, false)
 	return s, err
 }
 
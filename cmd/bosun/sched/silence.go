@@ -36,7 +36,10 @@ func (s *Schedule) Silenced() SilenceTester {
 	}
 }
 
-func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forget, confirm bool, edit, user, message string) (map[models.AlertKey]bool, error) {
+// AddSilence adds a silence from start to end, which recurs if rec is not nil.
+// Unless confirm is set, it only returns the alert keys of open incidents the
+// silence would match.
+func (s *Schedule) AddSilence(start, end time.Time, rec *models.Recurrence, alert, tagList string, forget, confirm bool, edit, user, message string) (map[models.AlertKey]bool, error) {
 	if start.IsZero() || end.IsZero() {
 		return nil, fmt.Errorf("both start and end must be specified")
 	}
@@ -50,13 +53,14 @@ func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forge
 		return nil, fmt.Errorf("must specify either alert or tags")
 	}
 	si := &models.Silence{
-		Start:   start,
-		End:     end,
-		Alert:   alert,
-		Tags:    make(opentsdb.TagSet),
-		Forget:  forget,
-		User:    user,
-		Message: message,
+		Start:      start,
+		End:        end,
+		Alert:      alert,
+		Tags:       make(opentsdb.TagSet),
+		Forget:     forget,
+		User:       user,
+		Message:    message,
+		Recurrence: rec,
 	}
 	if tagList != "" {
 		tags, err := opentsdb.ParseTags(tagList)
@@ -78,6 +82,10 @@ func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forge
 		if err := s.DataAccess.Silence().AddSilence(si); err != nil {
 			return nil, err
 		}
+		if edit != "" && edit != si.ID() {
+			s.publish(&StreamEvent{Type: StreamSilenceClear, SilenceId: edit})
+		}
+		s.publish(&StreamEvent{Type: StreamSilenceAdd, Silence: si})
 		return nil, nil
 	}
 	aks := make(map[models.AlertKey]bool)
@@ -94,5 +102,9 @@ func (s *Schedule) AddSilence(start, end time.Time, alert, tagList string, forge
 }
 
 func (s *Schedule) ClearSilence(id string) error {
-	return s.DataAccess.Silence().DeleteSilence(id)
+	if err := s.DataAccess.Silence().DeleteSilence(id); err != nil {
+		return err
+	}
+	s.publish(&StreamEvent{Type: StreamSilenceClear, SilenceId: id})
+	return nil
 }
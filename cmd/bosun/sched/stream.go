@@ -0,0 +1,127 @@
+package sched
+
+import (
+	"sync"
+	"time"
+
+	"bosun.org/collect"
+	"bosun.org/metadata"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"github.com/ryanuber/go-glob"
+)
+
+func init() {
+	metadata.AddMetricMeta(
+		"bosun.stream.dropped", metadata.Counter, metadata.Event,
+		"The number of events of the event stream dropped for subscribers that fell behind.")
+}
+
+// The types of StreamEvents.
+const (
+	StreamStatus       = "status"
+	StreamAction       = "action"
+	StreamSilenceAdd   = "silenceAdd"
+	StreamSilenceClear = "silenceClear"
+	StreamReload       = "reload"
+)
+
+// A StreamEvent is a change published on the event stream of the schedule.
+type StreamEvent struct {
+	Type       string
+	Time       time.Time
+	AlertKey   models.AlertKey `json:",omitempty"`
+	IncidentId int64           `json:",omitempty"`
+
+	// Status and PreviousStatus of a status change.
+	Status         models.Status `json:",omitempty"`
+	PreviousStatus models.Status `json:",omitempty"`
+
+	// Action taken on the incident.
+	Action *models.Action `json:",omitempty"`
+
+	// Silence that was added, or the id of the one that was cleared.
+	Silence   *models.Silence `json:",omitempty"`
+	SilenceId string          `json:",omitempty"`
+
+	// Hash of the rule configuration that was loaded.
+	Hash string `json:",omitempty"`
+}
+
+// StreamFilter selects events of the stream by their alert key. Events of no
+// alert key, such as silences and reloads, always match.
+type StreamFilter struct {
+	Alert string          // glob of the alert name
+	Tags  opentsdb.TagSet // globs of tag values
+}
+
+// Match reports whether e passes f.
+func (f *StreamFilter) Match(e *StreamEvent) bool {
+	if e.AlertKey == "" {
+		return true
+	}
+	if f.Alert != "" && !glob.Glob(f.Alert, e.AlertKey.Name()) {
+		return false
+	}
+	group := e.AlertKey.Group()
+	for k, v := range f.Tags {
+		if tv, ok := group[k]; !ok || !glob.Glob(v, tv) {
+			return false
+		}
+	}
+	return true
+}
+
+// streamBuffer is the number of events buffered for each subscriber.
+const streamBuffer = 100
+
+// eventStream sends published events to its subscribers. It outlives config
+// reloads, which replace the schedule.
+type eventStream struct {
+	sync.Mutex
+	subscribers map[chan *StreamEvent]bool
+}
+
+func newEventStream() *eventStream {
+	return &eventStream{subscribers: make(map[chan *StreamEvent]bool)}
+}
+
+// SubscribeEvents returns a channel of the events published from now on, and
+// a function that ends the subscription. Events are dropped while the channel
+// is full.
+func (s *Schedule) SubscribeEvents() (<-chan *StreamEvent, func()) {
+	es := s.events
+	ch := make(chan *StreamEvent, streamBuffer)
+	es.Lock()
+	es.subscribers[ch] = true
+	es.Unlock()
+	return ch, func() {
+		es.Lock()
+		delete(es.subscribers, ch)
+		es.Unlock()
+	}
+}
+
+// publish sends e to the subscribers of the event stream, if the schedule
+// has one.
+func (s *Schedule) publish(e *StreamEvent) {
+	es := s.events
+	if es == nil {
+		return
+	}
+	e.Time = utcNow()
+	es.Lock()
+	defer es.Unlock()
+	for ch := range es.subscribers {
+		select {
+		case ch <- e:
+		default:
+			collect.Add("stream.dropped", nil, 1)
+		}
+	}
+}
+
+// ConfigReloaded publishes the reload of the rule configuration.
+func (s *Schedule) ConfigReloaded() {
+	s.publish(&StreamEvent{Type: StreamReload, Hash: s.RuleConf.GetHash()})
+}
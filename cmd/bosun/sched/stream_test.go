@@ -0,0 +1,103 @@
+package sched
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+)
+
+func TestEventStream(t *testing.T) {
+	defer setup()()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, `
+		alert a {
+			crit = 1
+		}
+		alert b {
+			crit = 1
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := initSched(&conf.SystemConf{}, c)
+	events, unsubscribe := s.SubscribeEvents()
+	defer unsubscribe()
+	f := &StreamFilter{Alert: "a"}
+	next := func() *StreamEvent {
+		for {
+			select {
+			case e := <-events:
+				if f.Match(e) {
+					return e
+				}
+			case <-time.After(time.Second):
+				t.Fatal("no event before timeout")
+			}
+		}
+	}
+
+	check(s, utcNow())
+	if e := next(); e.Type != StreamStatus || e.AlertKey != "a{}" || e.IncidentId == 0 || e.Status != models.StCritical || e.PreviousStatus != models.StNone {
+		t.Errorf("unexpected status event %+v", e)
+	}
+
+	if err := s.ActionByAlertKey("user", "on it", models.ActionAcknowledge, "a{}"); err != nil {
+		t.Fatal(err)
+	}
+	if e := next(); e.Type != StreamAction || e.AlertKey != "a{}" || e.Action == nil || e.Action.Type != models.ActionAcknowledge || e.Action.User != "user" {
+		t.Errorf("unexpected action event %+v", e)
+	}
+
+	start := utcNow()
+	if _, err := s.AddSilence(start, start.Add(time.Hour), nil, "b", "", false, true, "", "user", "maintenance"); err != nil {
+		t.Fatal(err)
+	}
+	e := next()
+	if e.Type != StreamSilenceAdd || e.Silence == nil || e.Silence.Alert != "b" {
+		t.Fatalf("unexpected silence event %+v", e)
+	}
+	if err := s.ClearSilence(e.Silence.ID()); err != nil {
+		t.Fatal(err)
+	}
+	if e := next(); e.Type != StreamSilenceClear || e.SilenceId == "" {
+		t.Errorf("unexpected silence clear event %+v", e)
+	}
+
+	// the stream is kept on reload
+	s.Reset()
+	if DefaultSched.events != s.events {
+		t.Fatal("expected Reset to keep the event stream")
+	}
+	DefaultSched.RuleConf = c
+	DefaultSched.ConfigReloaded()
+	if e := next(); e.Type != StreamReload || e.Hash != c.GetHash() {
+		t.Errorf("unexpected reload event %+v", e)
+	}
+}
+
+func TestStreamFilter(t *testing.T) {
+	e := &StreamEvent{Type: StreamStatus, AlertKey: models.NewAlertKey("os.cpu", opentsdb.TagSet{"host": "ny-web01", "env": "prod"})}
+	tests := []struct {
+		filter StreamFilter
+		match  bool
+	}{
+		{StreamFilter{}, true},
+		{StreamFilter{Alert: "os.*"}, true},
+		{StreamFilter{Alert: "os.mem"}, false},
+		{StreamFilter{Tags: opentsdb.TagSet{"host": "ny-*"}}, true},
+		{StreamFilter{Alert: "os.*", Tags: opentsdb.TagSet{"host": "ny-*", "env": "dev"}}, false},
+		{StreamFilter{Tags: opentsdb.TagSet{"dc": "*"}}, false},
+	}
+	for _, test := range tests {
+		if got := test.filter.Match(e); got != test.match {
+			t.Errorf("%+v: got %v, expected %v", test.filter, got, test.match)
+		}
+	}
+	if !(&StreamFilter{Alert: "os.mem"}).Match(&StreamEvent{Type: StreamReload}) {
+		t.Error("expected events of no alert key to match any filter")
+	}
+}
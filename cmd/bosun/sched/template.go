@@ -614,3 +614,31 @@ func (a actionNotificationContext) IncidentLink(i int64) string {
 		"id": []string{fmt.Sprint(i)},
 	})
 }
+
+type flapNotificationContext struct {
+	State   *models.IncidentState
+	Changes int
+	Window  time.Duration
+
+	schedule *Schedule
+}
+
+func (f flapNotificationContext) IncidentLink(i int64) string {
+	return f.schedule.SystemConf.MakeLink("/incident", &url.Values{
+		"id": []string{fmt.Sprint(i)},
+	})
+}
+
+type digestContext struct {
+	Time   time.Time
+	Name   string
+	States []*models.IncidentState
+
+	schedule *Schedule
+}
+
+func (d *digestContext) IncidentLink(i int64) string {
+	return d.schedule.SystemConf.MakeLink("/incident", &url.Values{
+		"id": []string{fmt.Sprint(i)},
+	})
+}
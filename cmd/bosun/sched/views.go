@@ -8,6 +8,7 @@ import (
 	"bosun.org/cmd/bosun/conf"
 	"bosun.org/models"
 	"bosun.org/opentsdb"
+	"github.com/kylebrandt/boolq"
 	"github.com/ryanuber/go-glob"
 )
 
@@ -56,6 +57,7 @@ type IncidentSummaryView struct {
 	Unevaluated            bool
 	NeedAck                bool
 	Silenced               bool
+	Flapping               bool
 	Actions                []EpochAction
 	Events                 []EventSummary
 	WarnNotificationChains [][]string
@@ -103,6 +105,7 @@ func MakeIncidentSummary(c conf.RuleConfProvider, s SilenceTester, is *models.In
 		Unevaluated:            is.Unevaluated,
 		NeedAck:                is.NeedAck,
 		Silenced:               s(is.AlertKey) != nil,
+		Flapping:               is.Flapping,
 		Actions:                actions,
 		Events:                 eventSummaries,
 		WarnNotificationChains: conf.GetNotificationChains(c, warnNotifications),
@@ -142,38 +145,7 @@ func (is IncidentSummaryView) Ask(filter string) (bool, error) {
 		// if we do better to show the ack'd incident than hide it.
 		return true, nil
 	case "hasTag":
-		if strings.Contains(value, "=") {
-			if strings.HasPrefix(value, "=") {
-				q := strings.TrimPrefix(value, "=")
-				for _, v := range is.Tags {
-					if glob.Glob(q, v) {
-						return true, nil
-					}
-				}
-				return false, nil
-			}
-			if strings.HasSuffix(value, "=") {
-				q := strings.TrimSuffix(value, "=")
-				_, ok := is.Tags[q]
-				return ok, nil
-			}
-			sp := strings.Split(value, "=")
-			if len(sp) != 2 {
-				return false, fmt.Errorf("unexpected tag specification: %v", value)
-			}
-			tagValues := strings.Split(sp[1], "|")
-			for k, v := range is.Tags {
-				for _, tagValue := range tagValues {
-					if k == sp[0] && glob.Glob(tagValue, v) {
-						return true, nil
-					}
-				}
-			}
-			return false, nil
-		}
-		q := strings.TrimRight(value, "=")
-		_, ok := is.Tags[q]
-		return ok, nil
+		return askTags(is.Tags, value)
 	case "hidden":
 		hide := is.Silenced || is.Unevaluated
 		switch value {
@@ -184,6 +156,15 @@ func (is IncidentSummaryView) Ask(filter string) (bool, error) {
 		default:
 			return false, fmt.Errorf("unknown %s value: %s", key, value)
 		}
+	case "isFlapping":
+		switch value {
+		case "true":
+			return is.Flapping == true, nil
+		case "false":
+			return is.Flapping == false, nil
+		default:
+			return false, fmt.Errorf("unknown %s value: %s", key, value)
+		}
 	case "name":
 		return glob.Glob(value, is.AlertName), nil
 	case "user":
@@ -241,6 +222,43 @@ func (is IncidentSummaryView) Ask(filter string) (bool, error) {
 	return false, nil
 }
 
+// askTags reports whether tags match the value of a hasTag filter: a tag key,
+// key=value with value globs separated by |, key= or =value.
+func askTags(tags opentsdb.TagSet, value string) (bool, error) {
+	if strings.Contains(value, "=") {
+		if strings.HasPrefix(value, "=") {
+			q := strings.TrimPrefix(value, "=")
+			for _, v := range tags {
+				if glob.Glob(q, v) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		if strings.HasSuffix(value, "=") {
+			q := strings.TrimSuffix(value, "=")
+			_, ok := tags[q]
+			return ok, nil
+		}
+		sp := strings.Split(value, "=")
+		if len(sp) != 2 {
+			return false, fmt.Errorf("unexpected tag specification: %v", value)
+		}
+		tagValues := strings.Split(sp[1], "|")
+		for k, v := range tags {
+			for _, tagValue := range tagValues {
+				if k == sp[0] && glob.Glob(tagValue, v) {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	}
+	q := strings.TrimRight(value, "=")
+	_, ok := tags[q]
+	return ok, nil
+}
+
 func checkTimeArg(ts int64, arg string) (bool, error) {
 	var op string
 	val := arg
@@ -271,3 +289,175 @@ func checkTimeArg(ts int64, arg string) (bool, error) {
 		return false, fmt.Errorf("unexpected op: %v", op)
 	}
 }
+
+// IncidentSearchView is an incident as returned by the incident search. Unlike
+// IncidentSummaryView it does not need the alert of the incident to still be
+// in the configuration.
+type IncidentSearchView struct {
+	Id            int64
+	Subject       string
+	AlertName     string
+	Tags          opentsdb.TagSet
+	TagsString    string
+	Start         int64
+	End           int64 `json:",omitempty"`
+	Duration      int64
+	Open          bool
+	NeedAck       bool
+	CurrentStatus models.Status
+	WorstStatus   models.Status
+	Actions       []EpochAction
+
+	now time.Time
+}
+
+// MakeIncidentSearchView returns the view of is at now. The duration of open
+// incidents runs until now.
+func MakeIncidentSearchView(is *models.IncidentState, now time.Time) *IncidentSearchView {
+	end := now
+	v := &IncidentSearchView{
+		Id:            is.Id,
+		Subject:       is.Subject,
+		AlertName:     is.AlertKey.Name(),
+		Tags:          is.AlertKey.Group(),
+		TagsString:    is.AlertKey.Group().String(),
+		Start:         is.Start.Unix(),
+		Open:          is.Open,
+		NeedAck:       is.NeedAck,
+		CurrentStatus: is.CurrentStatus,
+		WorstStatus:   is.WorstStatus,
+		Actions:       make([]EpochAction, len(is.Actions)),
+		now:           now,
+	}
+	if is.End != nil {
+		end = *is.End
+		v.End = end.Unix()
+	}
+	v.Duration = int64(end.Sub(is.Start) / time.Second)
+	for i, action := range is.Actions {
+		v.Actions[i] = MakeEpochAction(action)
+	}
+	return v
+}
+
+// ParseIncidentQuery parses a query of the incident search. It is a boolq
+// expression that also accepts NOT in place of !.
+func ParseIncidentQuery(q string) (*boolq.Tree, error) {
+	fields := strings.Fields(q)
+	for i, f := range fields {
+		// NOT may be between parentheses without spaces, as in (NOT(a OR b)).
+		var replaced string
+		for {
+			word := strings.TrimLeft(f, "(")
+			replaced += f[:len(f)-len(word)]
+			rest := strings.TrimPrefix(word, "NOT")
+			if rest == word || (rest != "" && rest[0] != '(') {
+				replaced += word
+				break
+			}
+			replaced += "!"
+			f = rest
+		}
+		fields[i] = replaced
+	}
+	return boolq.Parse(strings.Join(fields, " "))
+}
+
+// Ask reports whether v matches a term of an incident search query. Terms are
+// key:value filters, or comparisons of the start, end or duration of v such as
+// start>2017-03-01 or duration>1h. Times are dates, or durations before now.
+// Open incidents match no comparison of their end.
+func (v *IncidentSearchView) Ask(filter string) (bool, error) {
+	i := strings.IndexAny(filter, ":<>")
+	if i < 0 {
+		return false, fmt.Errorf("bad filter, filter must be in k:v, k<v or k>v format, got %v", filter)
+	}
+	key, op, value := filter[:i], filter[i], filter[i+1:]
+	if op != ':' {
+		switch key {
+		case "start":
+			return compareSearchTime(v.Start, op, value, v.now)
+		case "end":
+			if v.End == 0 {
+				return false, nil
+			}
+			return compareSearchTime(v.End, op, value, v.now)
+		case "duration":
+			d, err := opentsdb.ParseDuration(value)
+			if err != nil {
+				return false, err
+			}
+			if op == '<' {
+				return v.Duration < int64(d.Seconds()), nil
+			}
+			return v.Duration > int64(d.Seconds()), nil
+		}
+		return false, fmt.Errorf("%s can not be compared with %c", key, op)
+	}
+	switch key {
+	case "id":
+		return fmt.Sprint(v.Id) == value, nil
+	case "alert":
+		return glob.Glob(value, v.AlertName), nil
+	case "hasTag":
+		return askTags(v.Tags, value)
+	case "status":
+		return v.CurrentStatus.String() == value, nil
+	case "worstStatus":
+		return v.WorstStatus.String() == value, nil
+	case "user":
+		for _, action := range v.Actions {
+			if action.User == value {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "subject":
+		return glob.Glob(value, v.Subject), nil
+	case "open", "ack":
+		var b bool
+		switch value {
+		case "true":
+			b = true
+		case "false":
+		default:
+			return false, fmt.Errorf("unknown %s value: %s", key, value)
+		}
+		if key == "ack" {
+			return !v.NeedAck == b, nil
+		}
+		return v.Open == b, nil
+	}
+	return false, fmt.Errorf("unknown filter %s", key)
+}
+
+// searchTimeLayouts are the layouts of dates in incident search queries. They
+// are in UTC unless they have an offset.
+var searchTimeLayouts = []string{
+	"2006-01-02",
+	"2006-01-02T15:04",
+	time.RFC3339,
+}
+
+// compareSearchTime compares the unix time ts with value, a date or a
+// duration before now.
+func compareSearchTime(ts int64, op byte, value string, now time.Time) (bool, error) {
+	var t time.Time
+	for _, layout := range searchTimeLayouts {
+		if p, err := time.Parse(layout, value); err == nil {
+			t = p
+			break
+		}
+	}
+	if t.IsZero() {
+		d, err := opentsdb.ParseDuration(value)
+		if err != nil {
+			return false, fmt.Errorf("bad time %s: must be a date such as 2006-01-02 or a duration", value)
+		}
+		t = now.Add(-time.Duration(d))
+	}
+	if op == '<' {
+		return ts < t.Unix(), nil
+	}
+	return ts > t.Unix(), nil
+}
@@ -0,0 +1,87 @@
+package sched
+
+import (
+	"testing"
+	"time"
+
+	"bosun.org/models"
+	"bosun.org/opentsdb"
+	"github.com/kylebrandt/boolq"
+)
+
+func TestIncidentSearchQuery(t *testing.T) {
+	now := time.Date(2017, 3, 10, 12, 0, 0, 0, time.UTC)
+	end := now.Add(-24 * time.Hour)
+	closed := MakeIncidentSearchView(&models.IncidentState{
+		Id:            1,
+		AlertKey:      models.NewAlertKey("cpu.high", opentsdb.TagSet{"host": "ny-web01"}),
+		Start:         end.Add(-2 * time.Hour),
+		End:           &end,
+		Subject:       "cpu high on ny-web01",
+		CurrentStatus: models.StNormal,
+		WorstStatus:   models.StCritical,
+		Actions:       []models.Action{{User: "alice", Type: models.ActionAcknowledge}},
+	}, now)
+	open := MakeIncidentSearchView(&models.IncidentState{
+		Id:            2,
+		AlertKey:      models.NewAlertKey("disk.full", opentsdb.TagSet{"host": "ny-db01"}),
+		Start:         now.Add(-10 * time.Minute),
+		Open:          true,
+		NeedAck:       true,
+		CurrentStatus: models.StWarning,
+		WorstStatus:   models.StWarning,
+	}, now)
+	if closed.Duration != 7200 || open.Duration != 600 {
+		t.Fatalf("bad durations %d and %d", closed.Duration, open.Duration)
+	}
+
+	tests := []struct {
+		query        string
+		closed, open bool
+	}{
+		{"", true, true},
+		{"alert:cpu.*", true, false},
+		{"hasTag:host=ny-*", true, true},
+		{"hasTag:host=ny-web*|ny-db01 AND status:warning", false, true},
+		{"worstStatus:critical OR open:true", true, true},
+		{"NOT user:alice", false, true},
+		{"(NOT ack:true)", false, true},
+		{"NOT(user:alice OR ack:true)", false, true},
+		{"(NOT(NOT user:alice))", true, false},
+		{"duration>1h", true, false},
+		{"duration<1h AND NOT alert:cpu.*", false, true},
+		{"start>2017-03-09 AND start<2017-03-09T11:00", true, false},
+		{"start>1h", false, true},
+		{"end<2017-03-10", true, false},
+		{"end>1d", false, false},
+		{"id:2", false, true},
+	}
+	for _, test := range tests {
+		q, err := ParseIncidentQuery(test.query)
+		if err != nil {
+			t.Fatalf("%s: %v", test.query, err)
+		}
+		for _, c := range []struct {
+			v    *IncidentSearchView
+			want bool
+		}{{closed, test.closed}, {open, test.open}} {
+			got, err := boolq.AskParsedExpr(q, c.v)
+			if err != nil {
+				t.Fatalf("%s: %v", test.query, err)
+			}
+			if got != c.want {
+				t.Errorf("%s: incident %d: got %v, expected %v", test.query, c.v.Id, got, c.want)
+			}
+		}
+	}
+
+	for _, query := range []string{"nope:1", "alert>1", "start>yesterday", "ack:maybe"} {
+		q, err := ParseIncidentQuery(query)
+		if err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+		if _, err := boolq.AskParsedExpr(q, open); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package sched
+
+import (
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/models"
+	"bosun.org/slog"
+)
+
+// actionWebhookEvents are the webhook events of the actions on incidents.
+var actionWebhookEvents = map[models.ActionType]string{
+	models.ActionAcknowledge: conf.WebhookAck,
+	models.ActionClose:       conf.WebhookClose,
+	models.ActionForceClose:  conf.WebhookClose,
+	models.ActionForget:      conf.WebhookForget,
+	models.ActionPurge:       conf.WebhookForget,
+}
+
+// notifyWebhooks posts event of st to the webhooks that want it, through the
+// outbox.
+func (s *Schedule) notifyWebhooks(event string, st *models.IncidentState) {
+	var ds []*models.Delivery
+	for _, w := range s.SystemConf.GetWebhooks() {
+		if w.Wants(event) {
+			ds = append(ds, w.NewDelivery(event, st))
+		}
+	}
+	if len(ds) == 0 {
+		return
+	}
+	if s.quiet {
+		slog.Infoln("quiet mode prevented", len(ds), "webhook", event, "events of", st.AlertKey)
+		return
+	}
+	s.deliver(ds, st.Id)
+}
@@ -0,0 +1,181 @@
+package sched
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+	"bosun.org/models"
+)
+
+func TestWebhooks(t *testing.T) {
+	defer setup()()
+	retries := 1
+	wh := &conf.Webhook{
+		Name:         "tickets",
+		Events:       []string{conf.WebhookOpen, conf.WebhookAck, conf.WebhookClose},
+		Secret:       "s3cret",
+		Retries:      &retries,
+		RetryBackoff: conf.Duration{Duration: time.Hour},
+	}
+	fail := true
+	type post struct {
+		payload   conf.WebhookPayload
+		signature string
+	}
+	pc := make(chan post, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		var p post
+		if err := json.Unmarshal(body, &p.payload); err != nil {
+			t.Error(err)
+		}
+		if r.Header.Get("X-Bosun-Signature") == "sha256="+wh.Signature(body) {
+			p.signature = "ok"
+		}
+		if fail {
+			fail = false
+			http.Error(w, "unavailable", http.StatusInternalServerError)
+		}
+		pc <- p
+	}))
+	defer ts.Close()
+	wh.URL = ts.URL
+	c, err := rule.NewConf("", conf.EnabledBackends{}, `
+		template t {
+			subject = crit
+		}
+		alert a {
+			template = t
+			crit = 1
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{Webhooks: []*conf.Webhook{wh}}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive := func(event string) {
+		select {
+		case p := <-pc:
+			if p.payload.Event != event || p.payload.Incident == nil || p.payload.Incident.AlertKey != "a{}" || p.signature != "ok" {
+				t.Errorf("expected signed %s post of a{}, got %+v", event, p)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("failed to receive %s post before timeout", event)
+		}
+	}
+
+	check(s, utcNow())
+	receive(conf.WebhookOpen)
+	st, err := s.DataAccess.State().GetLatestIncident("a{}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var d *models.Delivery
+	for i := 0; i < 100 && d == nil; i++ {
+		ds, err := s.DataAccess.Outbox().GetIncidentDeliveries(st.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ds) == 1 && ds[0].Attempts > 0 {
+			d = ds[0]
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if d == nil || d.Action != conf.ActionWebhook || d.Status != models.DeliveryRetrying {
+		t.Fatalf("expected retrying webhook delivery after failed post, got %+v", d)
+	}
+	d.NextAttempt = utcNow().Add(-time.Second)
+	if err := s.DataAccess.Outbox().UpdateDelivery(d); err != nil {
+		t.Fatal(err)
+	}
+	s.sendDeliveries()
+	receive(conf.WebhookOpen)
+	s.deliveryAttempts.Wait()
+
+	if err := s.ActionByAlertKey("user", "on it", models.ActionAcknowledge, "a{}"); err != nil {
+		t.Fatal(err)
+	}
+	receive(conf.WebhookAck)
+	if err := s.ActionByAlertKey("user", "done", models.ActionForceClose, "a{}"); err != nil {
+		t.Fatal(err)
+	}
+	receive(conf.WebhookClose)
+	if err := s.ActionByAlertKey("user", "gone", models.ActionPurge, "a{}"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case p := <-pc:
+		t.Errorf("unexpected post of unwanted event %s", p.payload.Event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestWebhookPendingOpen(t *testing.T) {
+	defer setup()()
+	events := make(chan string, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p conf.WebhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			t.Error(err)
+		}
+		events <- p.Event
+	}))
+	defer ts.Close()
+	c, err := rule.NewConf("", conf.EnabledBackends{}, `
+		template t {
+			subject = a
+		}
+		alert a {
+			template = t
+			warn = 1
+			warnFor = 2
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := initSched(&conf.SystemConf{Webhooks: []*conf.Webhook{{Name: "tickets", URL: ts.URL}}}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ak := models.NewAlertKey("a", nil)
+	start := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	// The pending warning opens the incident on the second run, and the
+	// critical status escalates it.
+	for i, status := range []models.Status{models.StWarning, models.StWarning, models.StCritical} {
+		s.RunHistory(&RunHistory{
+			Start:  start.Add(time.Duration(i) * time.Minute),
+			Events: map[models.AlertKey]*models.Event{ak: {Status: status}},
+		})
+	}
+	// posts are sent concurrently, so may arrive in any order
+	got := make(map[string]bool)
+	for i := 0; i < 2; i++ {
+		select {
+		case e := <-events:
+			got[e] = true
+		case <-time.After(time.Second):
+			t.Fatalf("failed to receive post before timeout, got %v", got)
+		}
+	}
+	if !got[conf.WebhookOpen] || !got[conf.WebhookEscalate] {
+		t.Errorf("expected open and escalate events, got %v", got)
+	}
+	select {
+	case e := <-events:
+		t.Errorf("unexpected %s event", e)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -13,7 +13,7 @@ import (
 var testSearch *Search
 
 func TestMain(m *testing.M) {
-	testData, closeF := dbtest.StartTestRedis(9990)
+	testData, closeF := dbtest.StartTestDataAccess(9990)
 	testSearch = NewSearch(testData, false)
 	status := m.Run()
 	closeF()
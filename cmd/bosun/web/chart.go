@@ -245,6 +245,8 @@ func ExprGraph(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (in
 		InfluxConfig:    schedule.SystemConf.GetInfluxContext(),
 		LogstashHosts:   schedule.SystemConf.GetLogstashContext(),
 		ElasticHosts:    schedule.SystemConf.GetElasticContext(),
+		PrometheusHost:  schedule.SystemConf.GetPrometheusContext(),
+		LokiHost:        schedule.SystemConf.GetLokiContext(),
 		AnnotateContext: schedule.SystemConf.GetAnnotateContext(),
 	}
 	providers := &expr.BosunProviders{
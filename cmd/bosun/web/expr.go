@@ -15,7 +15,6 @@ import (
 
 	"bosun.org/cmd/bosun/cache"
 	"bosun.org/cmd/bosun/conf"
-	"bosun.org/cmd/bosun/conf/rule"
 	"bosun.org/cmd/bosun/expr"
 	"bosun.org/cmd/bosun/sched"
 	"bosun.org/models"
@@ -28,8 +27,34 @@ import (
 // Matt and I decided not to expire the cache at given points (such as reloading rule page), but I forgot why. ?
 // the only risk is that if you query your store for data -5m to now and your store doesn't have the latest points up to date,
 // and then 5m from now you query -10min to -5m you'll get the same cached data, including the incomplete last points
+// It is never the query cache shared by the checks, even if one is configured: the expression, graph and rule
+// pages evaluate at any time a user picks, and must neither fill that cache nor be served from it.
 var cacheObj = cache.New(100)
 
+// queryCache returns the query cache shared by the checks if one is
+// configured, otherwise the cache of the web UI.
+func queryCache() *cache.Cache {
+	if schedule.QueryCache != nil {
+		return schedule.QueryCache
+	}
+	return cacheObj
+}
+
+// QueryCache returns the statistics of the query cache, including the cached
+// results if results=true. A DELETE request flushes the results of the backend
+// given by backend, or all results, from it and from the cache of the web UI.
+func QueryCache(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	c := queryCache()
+	if r.Method == http.MethodDelete {
+		n := c.Flush(r.FormValue("backend"))
+		if c != cacheObj {
+			n += cacheObj.Flush(r.FormValue("backend"))
+		}
+		return struct{ Flushed int }{n}, nil
+	}
+	return c.Stats(r.FormValue("results") == "true"), nil
+}
+
 func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v interface{}, err error) {
 	defer func() {
 		if pan := recover(); pan != nil {
@@ -79,6 +104,8 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 		InfluxConfig:    schedule.SystemConf.GetInfluxContext(),
 		LogstashHosts:   schedule.SystemConf.GetLogstashContext(),
 		ElasticHosts:    schedule.SystemConf.GetElasticContext(),
+		PrometheusHost:  schedule.SystemConf.GetPrometheusContext(),
+		LokiHost:        schedule.SystemConf.GetLokiContext(),
 		AnnotateContext: schedule.SystemConf.GetAnnotateContext(),
 	}
 	providers := &expr.BosunProviders{
@@ -87,7 +114,14 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 		Squelched: nil,
 		History:   nil,
 	}
-	res, queries, err := e.Execute(backends, providers, t, now, 0, false)
+	var res *expr.Results
+	var queries []opentsdb.Request
+	var trace *expr.Trace
+	if r.FormValue("trace") == "true" {
+		res, queries, trace, err = e.ExecuteTrace(backends, providers, t, now, 0, false)
+	} else {
+		res, queries, err = e.Execute(backends, providers, t, now, 0, false)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -100,10 +134,12 @@ func Expr(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (v inter
 		Type    string
 		Results []*expr.Result
 		Queries map[string]opentsdb.Request
+		Trace   *expr.Trace `json:",omitempty"`
 	}{
 		e.Tree.Root.Return().String(),
 		res.Results,
 		make(map[string]opentsdb.Request),
+		trace,
 	}
 	for _, q := range queries {
 		if e, err := url.QueryUnescape(q.String()); err == nil {
@@ -135,9 +171,11 @@ type Res struct {
 }
 
 func procRule(t miniprofiler.Timer, ruleConf conf.RuleConfProvider, a *conf.Alert, now time.Time, summary bool, email string, template_group string) (*ruleResult, error) {
-	s := &sched.Schedule{}
+	// Tested rules use the cache of the web UI rather than a shared one: they
+	// may be evaluated at any time and must not fill the cache of the checks.
+	s := &sched.Schedule{QueryCache: cacheObj}
 	s.Search = schedule.Search
-	if err := s.Init(schedule.SystemConf, ruleConf, schedule.DataAccess, false, false); err != nil {
+	if err := s.Init(schedule.SystemConf, ruleConf, schedule.DataAccess, false, false, false); err != nil {
 		return nil, err
 	}
 	rh := s.NewRunHistory(now, cacheObj)
@@ -244,7 +282,9 @@ func procRule(t miniprofiler.Timer, ruleConf conf.RuleConfProvider, a *conf.Aler
 			} else if s_err != nil {
 				warning = append(warning, s_err.Error())
 			} else {
-				n.DoEmail(email_subject, email, schedule.SystemConf, string(primaryIncident.AlertKey), attachments...)
+				if err := n.DoEmail(email_subject, email, schedule.SystemConf, string(primaryIncident.AlertKey), attachments...); err != nil {
+					warning = append(warning, err.Error())
+				}
 			}
 		}
 		data = s.Data(rh, primaryIncident, a, false)
@@ -448,7 +488,7 @@ func buildConfig(r *http.Request) (c conf.RuleConfProvider, a *conf.Alert, hash
 	if err != nil {
 		return nil, nil, "", err
 	}
-	c, err = rule.NewConf("Test Config", schedule.SystemConf.EnabledBackends(), string(config))
+	c, err = parseTestConfig("Test Config", r.FormValue("file"), string(config))
 	if err != nil {
 		return nil, nil, "", err
 	}
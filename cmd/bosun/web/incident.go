@@ -1,10 +1,19 @@
 package web
 
 import (
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"bosun.org/cmd/bosun/sched"
+	"bosun.org/models"
 
 	"github.com/MiniProfiler/go/miniprofiler"
 	"github.com/kylebrandt/boolq"
@@ -42,3 +51,225 @@ func ListOpenIncidents(t miniprofiler.Timer, w http.ResponseWriter, r *http.Requ
 	}
 	return summaries, nil
 }
+
+// SearchIncidents returns the incidents that match the query in the q
+// parameter, sorted by the sort parameter. Pages of the results are limit
+// incidents long; the cursor parameter continues a search after the page with
+// that NextCursor. With format=csv the page is written as CSV instead, with no
+// limit unless one is given and the next cursor in the X-Next-Cursor header.
+func SearchIncidents(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	q, err := sched.ParseIncidentQuery(r.FormValue("q"))
+	if err != nil {
+		return nil, fmt.Errorf("bad query: %v", err)
+	}
+	csvFormat := false
+	limit := 100
+	switch r.FormValue("format") {
+	case "", "json":
+	case "csv":
+		csvFormat = true
+		limit = 0
+	default:
+		return nil, fmt.Errorf("unknown format %s", r.FormValue("format"))
+	}
+	if v := r.FormValue("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit < 1 {
+			return nil, fmt.Errorf("bad limit %s", v)
+		}
+	}
+	sortBy := r.FormValue("sort")
+	if sortBy == "" {
+		sortBy = "-start"
+	}
+	var matches []*sched.IncidentSearchView
+	t.Step("search incidents", func(miniprofiler.Timer) {
+		matches, err = searchIncidents(q, time.Now().UTC())
+	})
+	if err != nil {
+		return nil, err
+	}
+	page, next, err := pageIncidents(matches, sortBy, r.FormValue("cursor"), limit)
+	if err != nil {
+		return nil, err
+	}
+	if csvFormat {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="incidents.csv"`)
+		if next != "" {
+			w.Header().Set("X-Next-Cursor", next)
+		}
+		return nil, writeIncidentsCSV(w, page)
+	}
+	return struct {
+		Incidents  []*sched.IncidentSearchView
+		NextCursor string `json:",omitempty"`
+	}{page, next}, nil
+}
+
+// searchBatch is the number of incidents searchIncidents reads at once.
+const searchBatch = 1000
+
+// searchIncidents returns the views of the incidents that match q at now. It
+// reads the incidents in batches of summaries, without their events, and only
+// keeps the matches.
+func searchIncidents(q *boolq.Tree, now time.Time) ([]*sched.IncidentSearchView, error) {
+	matches := []*sched.IncidentSearchView{}
+	seen := make(map[int64]bool)
+	for start, total := 0, 1; start < total; start += searchBatch {
+		var summaries []*models.IncidentSummary
+		var err error
+		summaries, total, err = schedule.DataAccess.State().GetIncidentSummaries(start, searchBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, is := range summaries {
+			if seen[is.Id] {
+				continue
+			}
+			seen[is.Id] = true
+			v := sched.MakeIncidentSearchView(is.IncidentState, now)
+			match, err := boolq.AskParsedExpr(q, v)
+			if err != nil {
+				return nil, err
+			}
+			if match {
+				matches = append(matches, v)
+			}
+		}
+	}
+	return matches, nil
+}
+
+// incidentCursor is the position of the last incident of a page of search
+// results: its sort key and id.
+type incidentCursor struct {
+	Key string
+	Id  int64
+}
+
+// incidentSortKey returns the key of v to sort by field. Keys compare as
+// strings, so numbers are zero padded.
+func incidentSortKey(v *sched.IncidentSearchView, field string) (string, error) {
+	switch field {
+	case "id":
+		return fmt.Sprintf("%020d", v.Id), nil
+	case "start":
+		return fmt.Sprintf("%020d", v.Start), nil
+	case "end":
+		return fmt.Sprintf("%020d", v.End), nil
+	case "duration":
+		return fmt.Sprintf("%020d", v.Duration), nil
+	case "alert":
+		return v.AlertName, nil
+	case "status":
+		return fmt.Sprint(int(v.CurrentStatus)), nil
+	case "worstStatus":
+		return fmt.Sprint(int(v.WorstStatus)), nil
+	}
+	return "", fmt.Errorf("unknown sort field %s", field)
+}
+
+type incidentsByKey struct {
+	views []*sched.IncidentSearchView
+	keys  []string
+	desc  bool
+}
+
+func (s incidentsByKey) Len() int { return len(s.views) }
+func (s incidentsByKey) Swap(i, j int) {
+	s.views[i], s.views[j] = s.views[j], s.views[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+func (s incidentsByKey) Less(i, j int) bool {
+	return s.before(incidentCursor{s.keys[i], s.views[i].Id}, incidentCursor{s.keys[j], s.views[j].Id})
+}
+
+// before reports whether the incident at a comes before the one at b.
+func (s incidentsByKey) before(a, b incidentCursor) bool {
+	if s.desc {
+		a, b = b, a
+	}
+	if a.Key != b.Key {
+		return a.Key < b.Key
+	}
+	return a.Id < b.Id
+}
+
+// pageIncidents sorts views by sortBy, a field prefixed with - to sort in
+// descending order, and returns up to limit of them after cursor. A limit of
+// zero returns all of them. next is the cursor of the following page, if any.
+func pageIncidents(views []*sched.IncidentSearchView, sortBy, cursor string, limit int) (page []*sched.IncidentSearchView, next string, err error) {
+	s := incidentsByKey{
+		views: views,
+		keys:  make([]string, len(views)),
+		desc:  strings.HasPrefix(sortBy, "-"),
+	}
+	field := strings.TrimPrefix(sortBy, "-")
+	for i, v := range views {
+		if s.keys[i], err = incidentSortKey(v, field); err != nil {
+			return nil, "", err
+		}
+	}
+	sort.Sort(s)
+	start := 0
+	if cursor != "" {
+		var c incidentCursor
+		b, err := base64.URLEncoding.DecodeString(cursor)
+		if err == nil {
+			err = json.Unmarshal(b, &c)
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("bad cursor: %v", err)
+		}
+		for start < len(views) && !s.before(c, incidentCursor{s.keys[start], views[start].Id}) {
+			start++
+		}
+	}
+	page = views[start:]
+	if limit > 0 && len(page) > limit {
+		page = page[:limit]
+		last := len(page) - 1
+		b, err := json.Marshal(incidentCursor{s.keys[start+last], page[last].Id})
+		if err != nil {
+			return nil, "", err
+		}
+		next = base64.URLEncoding.EncodeToString(b)
+	}
+	return page, next, nil
+}
+
+// writeIncidentsCSV writes views to w as CSV, with a header row.
+func writeIncidentsCSV(w io.Writer, views []*sched.IncidentSearchView) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"Id", "Alert", "Tags", "Subject", "Status", "WorstStatus", "Open", "NeedAck", "Start", "End", "Duration", "Users"})
+	for _, v := range views {
+		end := ""
+		if v.End != 0 {
+			end = time.Unix(v.End, 0).UTC().Format(time.RFC3339)
+		}
+		var users []string
+		seen := make(map[string]bool)
+		for _, a := range v.Actions {
+			if !seen[a.User] {
+				seen[a.User] = true
+				users = append(users, a.User)
+			}
+		}
+		cw.Write([]string{
+			fmt.Sprint(v.Id),
+			v.AlertName,
+			v.TagsString,
+			v.Subject,
+			v.CurrentStatus.String(),
+			v.WorstStatus.String(),
+			fmt.Sprint(v.Open),
+			fmt.Sprint(v.NeedAck),
+			time.Unix(v.Start, 0).UTC().Format(time.RFC3339),
+			end,
+			fmt.Sprint(v.Duration),
+			strings.Join(users, " "),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,49 @@
+package web
+
+import (
+	"testing"
+
+	"bosun.org/cmd/bosun/sched"
+)
+
+func TestPageIncidents(t *testing.T) {
+	var views []*sched.IncidentSearchView
+	for i, d := range []int64{30, 10, 20, 10, 40} {
+		views = append(views, &sched.IncidentSearchView{Id: int64(i + 1), Duration: d})
+	}
+	var ids []int64
+	cursor := ""
+	for pages := 0; ; pages++ {
+		page, next, err := pageIncidents(views, "-duration", cursor, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range page {
+			ids = append(ids, v.Id)
+		}
+		if next == "" {
+			if pages != 2 {
+				t.Fatalf("expected 3 pages, got %d", pages+1)
+			}
+			break
+		}
+		cursor = next
+	}
+	// ties are broken by id, in the same order
+	expected := []int64{5, 1, 3, 4, 2}
+	if len(ids) != len(expected) {
+		t.Fatalf("got incidents %v, expected %v", ids, expected)
+	}
+	for i := range ids {
+		if ids[i] != expected[i] {
+			t.Fatalf("got incidents %v, expected %v", ids, expected)
+		}
+	}
+
+	if _, _, err := pageIncidents(views, "color", "", 0); err == nil {
+		t.Error("expected an error sorting by an unknown field")
+	}
+	if _, _, err := pageIncidents(views, "id", "garbage", 0); err == nil {
+		t.Error("expected an error for a bad cursor")
+	}
+}
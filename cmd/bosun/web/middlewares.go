@@ -7,7 +7,6 @@ import (
 	"github.com/captncraig/easyauth"
 	"github.com/captncraig/easyauth/providers/ldap"
 	"github.com/captncraig/easyauth/providers/token"
-	"github.com/captncraig/easyauth/providers/token/redisStore"
 	"github.com/gorilla/mux"
 
 	"bosun.org/cmd/bosun/conf"
@@ -91,7 +90,7 @@ func buildAuth(cfg *conf.AuthConf) (easyauth.AuthManager, *token.TokenProvider,
 	var authTokens *token.TokenProvider
 	if cfg.TokenSecret != "" {
 		tokensEnabled = true
-		authTokens = token.NewToken(cfg.TokenSecret, redisStore.New(schedule.DataAccess))
+		authTokens = token.NewToken(cfg.TokenSecret, schedule.DataAccess.Tokens())
 		auth.AddProvider("tok", authTokens)
 	}
 	return auth, authTokens, nil
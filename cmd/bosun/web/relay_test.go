@@ -21,14 +21,14 @@ var testData database.DataAccess
 
 func TestMain(m *testing.M) {
 	var closeF func()
-	testData, closeF = dbtest.StartTestRedis(9991)
+	testData, closeF = dbtest.StartTestDataAccess(9991)
 	status := m.Run()
 	closeF()
 	os.Exit(status)
 }
 
 func TestRelay(t *testing.T) {
-	schedule.Init(&conf.SystemConf{}, new(rule.Conf), testData, false, false)
+	schedule.Init(&conf.SystemConf{}, new(rule.Conf), testData, false, false, false)
 	rs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(204)
 	}))
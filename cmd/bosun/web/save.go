@@ -11,6 +11,7 @@ import (
 
 func SaveConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	data := struct {
+		File    string
 		Config  string
 		Diff    string
 		User    string
@@ -27,7 +28,12 @@ func SaveConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	} else if data.User == "" {
 		data.User = getUsername(r)
 	}
-	err := schedule.RuleConf.SaveRawText(data.Config, data.Diff, data.User, data.Message, data.Other...)
+	var err error
+	if data.File != "" {
+		err = schedule.RuleConf.SaveRawFile(data.File, data.Config, data.Diff, data.User, data.Message, data.Other...)
+	} else {
+		err = schedule.RuleConf.SaveRawText(data.Config, data.Diff, data.User, data.Message, data.Other...)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -37,6 +43,7 @@ func SaveConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 
 func DiffConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	data := struct {
+		File    string
 		Config  string
 		Message string
 		User    string
@@ -52,7 +59,13 @@ func DiffConfig(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	if err := decoder.Decode(&data); err != nil {
 		return nil, err
 	}
-	diff, err := schedule.RuleConf.RawDiff(data.Config)
+	var diff string
+	var err error
+	if data.File != "" {
+		diff, err = schedule.RuleConf.RawFileDiff(data.File, data.Config)
+	} else {
+		diff, err = schedule.RuleConf.RawDiff(data.Config)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +88,7 @@ func BulkEdit(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (int
 	if err := decoder.Decode(&bulkEdit); err != nil {
 		return nil, err
 	}
-	err := schedule.RuleConf.BulkEdit(bulkEdit)
+	err := schedule.RuleConf.BulkEdit(bulkEdit, getUsername(r), r.FormValue("message"))
 	if err != nil {
 		return nil, err
 	}
@@ -11615,469 +11615,460 @@ dIDgRCiAz+vsRwGHrwlAVWUdyR+Hw+MRCYkpbn3DsMQOIhXF8BxvVgNodfvY7PU+fbPXHylLjyozIxCx
 
 	"/js/bosun.js": {
 		local:   "web/static/js/bosun.js",
-		size:    142799,
+		size:    143849,
 		modtime: 0,
 		compressed: `
-H4sIAAAJbogA/+y9fXfbNpYw/vf2UyCaTEjVMmWnTafjROkvTdJpdpu2m5fO9nH8eCERklhTBENAkjWJ
-v/vv4AIgQQIgKduZzT5ndE5iiby4uHi7uLhvGI/H6FFB5qQg2YygHPPlZLCiK5LxKI44G6Dx4y9agA7j
-dYF5QrPDOS1WuFboFckLwkjGGcIZwmu+RJxekOyLDS7QG/ENTVA4X2czgQCFQ/ThC4QQKp8ATPlYfPgy
-YdEzwmZFkgPIBA0GD+uvX9GUoAk6ajx+y0hhgF/B/wXh60JV9PCLq2E4fPjFeLwiHMeYY4SndM0RRizJ
-FilBhcBMC5STYpUwllDZlO8T/pJw3NEYBVW+qBGgXlYk4DSF6ti4qoyhOS3QlLK1rFc09BmZs46KNZi7
-Zv22qtox1jhbrFNcdMwIBXVY0DUnPWEZzhKe/KMLfEopZ7zAeQfcH+/XpNh1AMVfdQCss5gUbEaLLrJW
-NCYp0yBiTGB0nuQ5miDdaSsar1MSBvpVMEKnMAJBtngluioYyZ8A8JRmvKBpSgqmn68Ws4LgKFu8Fj3g
-fhpxSlOelG+zxWvVs/rJOonwjFTvZ2mSTyku4mD0xdnw4ReavGhGs3myCE+DuzCQvxZ0k8SkCEYouJvS
-Gaz22sMl57nxoJqKdQQjZBUfoVphc6VbsNGSr9IHL2lMwgpKfEiGpymJTxAv1mRUe1WQ9+ukIN9jRk7Q
-HKeMlK+vhhV/qNMZ1VBslyQLg3EwQvVaecJTcoKCZ5gtdTfW3pNVnmJO3hbpCQpyXPAEp2wca3BoTqPM
-rBx7E/FTXqSBQbeTvoSTFfMS+UK+7UMgIOokDhD2Ioxc5oWXrueXYocQvK0fcQJZJ20CaT/SioIW/k57
-rl73Igxgu0kTYL1oWxQ4X3pJ+5t824cyQNRJGCDsRdiSMu6l60fKOPotIdt+tAlcnaQJnEBZc2mnFMe/
-ZK8JLmZLe3U7iWdJKri3l/7X+n0f6hWyzgYopL16V/JeL31P4bWSuPpRKTF2Eikx31Y/45kk0NOMJ7P+
-9EtUnfRLlP1mcMI4LXZ+6lJScPSjhuo1kSVw91yWcL3IzNf+dfZMCKfPM96Xvnzdvc5+XfNedOEsoxy3
-j68B0muMS/jucS5B+22L2SyJSebvyRclQK/NUUF3748KsBeRcCjy70NPZjPCmDyj+LajEzR4BGgO04Tx
-x4/Gxo9Bv/rHmWDbHhp+Jltk0tFCRka2h4Dx8aNx9d1PBOVLUmwT1hTrChInBZnxN/QEBePALbiZ0mOU
-ZJwUM5JzsRXna7Y0TkV33w9REz+cf+oPxScQUiNhPDgxRFnJRps49EczWYLFweE0+K/Dl0mW5AWdJykp
-gjM0QYGQToOHzuKKFInFBrmqPbl6aHXFVU1+L9aZEN61/AwiekEpfz2jOanL5xpmhCqImhBePo3u0ixU
-J4KnS5wtyOs1zIcaQrIhGR+h2boo4EtekE1C16zZbwZemGFoostEd2Ud8vlDXym2pAVPk+wCTeSO5OmU
-8khmHKt8R7PayQudln1qPI+qRR4G38NL2DHRaXCXqe5VnVSejODLe2sUArzmy9ek2CSlvGEMDCAbqYOJ
-OiWN0N335kCN0JMKRW3U2CcYMYmTcZqHYiYP7Q43wMSmvRGjWlW0aWJM5ii8owZbVe9aXmptZOs0rc+G
-KwtbHVkk5BYSv6k4OZpMDF4eoAO0QQcokMy8pe4PSLZHnjDNBWjT4ST3yuqgJEt4rXsY4TzJFt5+xxvy
-XJ5z0QRp4Oh19fihq5jaWF1Fn9RfOYu/XyeEm4X+Uzxwgm5IwaQ+rgT+TT5ygtOcZJzF04qwOp7oJf6D
-FujOBB2he/esl0mmXjqRy/3M0eY35gt3h635Ek3MhVUHM15EL7KEh1V/rvlSYR5VNb5lpMjwihiPXtGU
-MOP3r5V+b9g2Zf5g0L0tK0rNvX9//cvPEeNFki2S+S7cjGA2jlCAUNBaw5RT3KsGks1oTN6+evGUrnKa
-kYyHomy4Gbbil8WuW8OmFXdB3p/PC7o6X9Xwr5r4xYZQoAnKyBa9kvt8OHxogbxXIP+5JsUuhB2mAfU+
-WhFeJDM0Qav6myJ6vyZFQpQM8r5RULWwaGtNjjOSPk0xY3UmwTFfM2DS8+TSxVDlGzSZTNCGJjE6GqIP
-SD9EA8B7OHjYYFpsm/DZUuN3ccIZZgQNZkXCkxlOBye6FQr1ARrEYocpBrb0Iouus4uMbjNXySSbU2+5
-LS6yJFu4yulXvqIZLVZuWpncCL0lQZuzVyNjMsfrlLuKyDcD36ZhD/4Gp2siBv6D/Y4R/h9kV5sUF2Q3
-QlDGNSHgBcyHdRaTeZKR2DW+MUkJJ3UKTi/I7qxtryMpIw5cNhI0kQT274OFs50eZtFGtYFarGs2WxIh
-8v2QpJwUDi4yLwhb1uqdA6iLj8C29T6KyZwUTSZSr0ggbNSIzF06WWFOXHwoF1UI4U/0iDh858kYp6Tg
-7DuJcCLEGAe3lG/Rx49oMBgOrTGK1BIwTkgx5tg1NfTMUw1CEyQg7SWgN99kRXAWx5hr0OhNsiJPsvgZ
-5sSxcqKCMJpurOZfOaiGhWnQTAprYDTOP8iMw/sm1vrvPJonGU7TXWhIuG6OHUd5QVdJ7azRmF3/QBP0
-1VH9IS2SBZqgvxw1nqfJYimEq+BPX0/x/fivQf11jIsLeHs8f3D/r9803q5AsAn+9NWDb8jUerlOQWr7
-BxpD7fW300WBReH7D9CXAFp/PUuKWQrs57TWCafHD45GCP4TpJ3VFQCnD1rfwgsAgVY7CztfnzU6eCO6
-Mv4qYiQV4xv8KaULGtRnSoTznGRxGLDNwnrFeREGSyKqCUaI/cP5fpvEfClfV/WzzUJV+yRNw6AgMx5N
-rQrEhA9PT6umoFMYh/uqY84a8CTjgnm4GyDqcLdgJmQDcYq0KbCa0NEDgjgnTHEZjORscb/etb6eJ2la
-O2mKfadcSqfHZw+bK1wV3LWUOoJS3jHJMV9GcYJXNIvdA6Mn4l7DINC6ezm2aLU4uFhJwUsk2HSsFpw4
-dMKD4wdH1hrU5bZiiR653zN0MEEBSgHJtkS3bYE63BtMff0/gZMZMvP83zL+K+KeHowX9AL0INtlwom7
-eyXQoZ7Lx5pjdaxKJ0Zj/L+6ziTosRZbWqKbED1oX47B8dHRn90oVIe21XLpXzp6HrUtH8n6nR2ndoV9
-OsyNTXVZC6WnR2cGsc7SbSziuKt0EYzQN/ejci5dg3nd9zKv60zr+45pLTgAL3DGElH/M2XxE2LEg4YY
-oYTHp3Sd8brXU126rMm0TUZlIjk4eGidIuqVTNCxU+qiT9yCrFPQL4kxirk0hGbVfh1d2zFFiAvL9Xye
-knIaNzhgj2WAmkuhNjtGKDEmSGLNDihdjWfowq3GOLSH3QF9o2WEbryU0J5LpdHfhIsjAV3zsBz8kWO6
-mwof6yDMaV6b0jhNXfMHp2lDGwJPlNHAoUR24EGNFYIsxaPjXGwtm8dQfSviw8MeCweEiiUtBBl3w+BP
-pSkkGAr5yOoo8Zpkrcu/ecJUZYLhHsdE0V447SVOBYP4ANpIaSSQAnZbxDymHl40lQgl7mpO+dtpE8MI
-fw0rP6HZK5wtSAgnGKAsSkm24Muhu8LmpEa2oc5WWJY2Kc7iqTgP/wBOs0JE/P33338fv3w5fvbs8Mcf
-T1arE8aCh19oT1ypRCqh68VLsJTOcErCgFSm+YKkmCcbIjrnxOiO+ZqvC3KCBkmG/swG1Ykrx4yfoMGf
-2SFeUOM5Ew9jE3IFT1bmE/vREp4szSf2oxiexOYT+9FLeJKZT+xHO3iyM5/oR3IAvhCjUs6QYp2St0Ua
-4osRmhd0JXpJTxo4u+ck+77AMzH38EWUZDG5/GUeDj4M1NjCiTmljLigrkwo0Nr8jFdEArH1lPFCzLay
-DgNY29NN2CRbhBU9B+h4ZNRslF0Xgr/JhSza912ADsreCIAMn9KopHFoFrknesarZ1K9Fkn373BYL6ob
-cr4o6Dr3IdFQqhVq81gX6cMvrqrBkubx/5eGazxGgvOejMdgaFa+Wt/JMVrivKCXu4iRYkOKKKbbjIuO
-znYwIGL5T+4fHX9zePSXw+Oje7o/JveP//zVk6OvrPmgkN/KbIDKe86IgeBshy9fHj57NhjaqIDmvqiA
-Mw6GHfOkILCh0ouEhNL4BnuOYOw7Zs4XcpknBVFHWbmB7WrmD6mIg4HNyBY9awi34lWkdp4QfizUjyE6
-kNjQl+j+1+hL9M2R/u/46OjItJMpItAEDR7qH5MBOpDYOf3byzev5XQampELDeW7gaUW4BDT2Rr2hhn0
-B5ogwmY4lx0jqBxAXeqhMiMclOgOBFHg6z4e1Dq5IDg2utjsVfH7+X86azJWIRYiQJ24iOVpwsPgoTZT
-zmmBQgGdgMiFEvQIzbDalx+i5OCgOVgzNEEzfJoYctB2maQEhbNotsTFEx4eDUEgDFBDwoeixuIVC9YW
-AcQsmZU8QzZVIjwauvQk60z1golaFlPIjWqGjtAU6Ulg9DwpMCOOrndM+8FghA6Ph7XiC8J/2Wbi2F5K
-CNIeWQ1oIH0uD6mAC+rFmS7urbpeeoQkKTDrm4S8XtJt5dDHWkmqwA7Zkm5tsprIdoR56GuiGqGdOM+V
-JI7HsLucaObMOJ5d0A0p5indRjO6GuPx8YP73/zlLw++Hn/7zdf3v/qm8r6ShpcwKMiGFKzub9VoX/UC
-nO7NuayOvtJLKWFPigLvFJTHCHZ65jot6RMpOPWzNJmRcBgp0kp+8hCEItjIklVDJJWM+40WSf9PIAGr
-ju4IPqoAQzwSY26SL3gARh8/1iydEKz1IhavxB+wYDXeviSM4QVst+V3F9xrjgv+TG3Mxi8X7HNpoAJI
-/d0F91TMpYRmKpwM1x+4SoDnEcLw10klXRczRaL8KqBgQh2ukyY0uNgLWPjiwgfrE0Dkt48f0Z0F4eje
-PXPxO9uGOVnQYifbpX8YkHJiVWMa5QXllO/ycht8++ap96gJmwPdogmS55VwGK35LBzq7b2afcOWgczo
-1jt05Ts1n9oo3YfMT0agWp8Vna1heEeHMCt0vFmrd2IZBdNwTixdEhv+odJl0eGCqFwPa66ia06aPcYg
-PgBNKshIPgobak3lnmd0UbFrsDQFIqYhp9NQ4onIZV44VUIzDN4rlv9DhSYIXOWAyXpcD61WBCqQCRyd
-ArxZhO/DAd4sTgrMyQll0Sxff1hSxidfwrh8eTUYocGDlfhfyL2P0bdHwdBpWW5RcynhU/VArJiS2RzD
-7F4Bwi83oGhG5W5re0CssyzJFi0QHE+NivAU6ikIW6ec2dU5fBuktimnTKubBEm1c4n4BHHLGcPonWGz
-3D3ecqIwemtYer02lPT9dF6lxwiT1nZQGr2S3eD08lBOYRr0P+VPJ6hEei64VelDscuJrZcvX4FrKwOM
-Tk9WrQzcLM5N/QCB6DBwhYV5XS6GAxQI4O8yuoWufIn5MpqnlBah4GRRRrfhEI21zOSpDrCjCeL06RIX
-PDT7yGsjcLctW6+mQhh1alClmDSnxXM8W9ZqaTXP6o8+tIhu+eBx0bcqif5W0HVu4ud4sRkhjhcXbXpH
-0TRRmZL50WO3McX8AG0HExSMPMTZHegqLigTAwsDKojtr9isUXHloSKOVCeKPw7lqH+eTHHRuoKunIuk
-5FQ17t40UdoeQ9TyGdKsUbwT51bx170we9WpPYpaFO6GN327Jz0jvF1137pPGTy/ufXYBQU3DUa1nefj
-Rzh/dhYV/LQqqncfV1Hpoi+DCkO3R2/ZWM02CmL5pIolq7nLacNJsblUC9tYF1vOs6jS9mAHThfeOPpN
-qpUMd2acjhB3etAiqS/C0in49EDwpg1Oz1xmBMezkiMqtgEKB081LpMocrKILs53K1xvT47Xye3cnG4P
-Lufq31buJuVbtXu7KX+GOT6BAR453/+MV+QEarHJsSuEqX2axJdnIGrZgkKzCeocAeVaxMkLsovptm4M
-vAshQC7bqXwTsWUyB2/ce/eQenRBdk/BlX+Cjr9qkzeI5V1v2TTBJuY87kC0f5+zjhH8+zmddjQ7lGKc
-lphrgpwCyShP5jvLwKnertjiN5wmsfd9qQ4xT/SVV3QiePlLtmjfSKy6wjs16kBlEDaqvCPqdLNxMYNU
-mx2u26ITL8iOVR1zQXa2gGvpwAyMrnmnUJ5WYK0e7PZOdkF2wci5c5kO+KWSU9QXjKBa59HU4SJfLUVW
-hT0tHGhb3R/W01XSIRlY499cijICrzHuxiCvQcU1kefX63jeGBuqXVyI9ifmGrEZp9LtnTQmug34H2TH
-TsyetUF+hkl8Ul9xddobA948ppZJDeA4aFWwj0t9Oe3WRRoG4+B6ru+2ICs+YLZTr/2bRifz3UfX1AjK
-/wx5cBJXjMb0OYFtrgSpIg2TWLG2Dk+ZypYAh+iy9G1EXGBTx56RralLB0EDNQJxG+X1cWbQjD3qMbs6
-SCtRz3GSkhhxihaEI4PibcKXKIlP0MDsFnSABiN5vpJv7FCSqz35aFsvuVm4kW2iUlqHbm7rH24ZbTT+
-RdrIbqBBAjsZs8JqrDQHrWRoZf2NKJlJJJWS6rrU/EiZ5Su9FyVLyngHEeY+WLNGde6InnAre3Y0TD1G
-iHD0N8J1aK+Fx9o3zIQpVi2fM6ewOlplEPB64mndAUQTVsCNDA3oplucnwtJS6/BiCSbAcjIoddpaWFP
-oruVPciv8EHuXdnqytuZ4DAxJcLW7ctYAi9uZSfrMb+aU6Z1fvUYKtSyOsKqO/1ronOXQJ9uFqto3I7t
-tDZM1s7aZ8pfc51+iinfWxAF74MqC0OX+0EF2Uh/amZyqGzDL5p5ObCZ1mFdZnMoZBIH8eBXUtR9N8Dk
-CwBoIgEb5mCNBk1KjA4IwKtA4HsDxqAMTZDxqwE3SwnOIOlE2PBjumMUcp3PZ5Re/IZT0YrKE2egjqiC
-rIHjQKkKuWZEs/EK1Ksf+sKYH+7h+hGzKn1GPVWCSUDpR3YOjmQjdC6OpNU4mTk4HgqgR+i8cjM7b/iZ
-ISM2+xyfnidndi/kkfQdnSCnMlip68I8+j7hDN1rjPrQkduk2S8GmopXtnaW0cgfgONUvTVNuOV+JJ6J
-BoAupNEG+a5BtUuAhn5SE9nUqf9PjIjq6mZb9QfIlNp5OXp+xWVzAPKq+a0jIFbh59/1wCv6d3rR0umF
-7PTJxNvr2tUPerx3h1fplVr7+2+Ev1J82L1X6QVUNr4H0rcVA6uQri3nPYtH37uHKmXmM5mKI1xbmjVr
-h6h3Ss2VscaLR2g9Qn89cp5jzXbWt5yu/nO21teFe6Cudq5utNbe1oq52u9aPbzOBWo18asuG4/nySXC
-8SrJZLZ3vsQcLTFD5JIXWK6+GS0KwnKaxUm2EPKaDKYx0sKzyMA4w2tGmCiKVhhy4KOFOKngf+wQzmJU
-epYio1BegKWFIYJZku4QWuELWdsFyZgka1HgjCOVWcckgiFOaUXCedhc3cOI4JmZHlG8cy3uFWYXdrjb
-eXjuZtUW3twXKlbxY4EEvstdz2caBEo+TpAs12XctOxjuhI0AUy2UqHhkGcmAJMeeaWHL1Py5MDI4Deo
-J+MToixLVnlK0Ez7HolxZ0u6RRg90gvlMMnyNX+sBhlkW73gXog3lcK1Q871lALx1ZJNVZIz8eehIRF7
-cER3k+wPMhNi8WktaeFZrcc8pa3eK/sjHKzNIgMdsWbmUfUgHTUAn7ATNJhxFfVVJSMNHkH/IsEXJgNO
-LvkAgvUngzktVocKwQChbHEYJww4zGQw41LHo1hOOByI95Dqv3qpKaveHVK4kYJNBh/QgnBOitfwv84a
-OHgcgJ/1jbXtZqrmfTTtwV0uIyThO5vtp30fIV0clFg30sbrEKJKJ18+cTst6kCgeoE3fi9HTuv41W8f
-cB23+u0GhiSzG5wKTnKgLQrls48f0QNbh1OF7+sS5SPlJmOVUZ5GP2LIRqVKmQ87CsqMn7FVVj//+LF5
-2tdKYJhd52KxoAkKfqIYdjmV4TaK7B6RofMkPgfLU1Wj/OnxQl3hJK1A5U/P6NRCCI1Rqj/3jBYnIADn
-uGAELi1wGej382idkTdLIn1mZsuCrogT5qVMdShTyzrdcHEWP0vmc1vv4hzJH0kqGj94syRIu6HJQQHx
-ZEpIhmYSNEJvhGyzIjhjaEfXCBcEJRmS2esQnYPIsS0SLpAwuiI0I2BiCZjCwSL0hqJNQraIL4l+CMGP
-8CB4jTcEPUtwShdrEoAcI2raJmmKGCEIo3WWzBMSoziZzwVFBNEs3aEt3mlzUZHEghiBTyrBID0hSoT4
-IqvCoK9LMsax4JUKuFinBJE44bSAimc034nai5LOJOMUJTxCv6vWMy4IAwGNc6lkY6IF24Qv6ZqjmIJw
-tUzYCE3FjrEkGTRotWYcTQnakGKHZrgg83WKMgq7ue5FgnC2c3Shoe1U0YaFMeUvc0MxV/Lh6TpJY8hA
-/0NBV88v88KdFgNc+jynqYeWGJeR7RMjoHXACWtmJIQwu/gSTdBx/YUQibNYDuj7NZH2ZduTSYXZmevu
-dACwg7MqXs6gA8S9w2Ox2OqF9MJuL+eSE53NRAeiXbbAmMSXzVQntveCZoPvsndZGeUboIN6VQcoQB/e
-ZU0XdvEJ/o2tp0p8+vAh+gkzHr2GNJtXVyfiCWCB0+/VFaKZeAQ+dmADu7ryYZ3SeIcm6L8f5Y+la1kD
-la/co/zxG7xgJ973XAg9j32v/+3Dh0JMbnT3YoTubtDJBEly/TX+27894sXjRzx+/OHD3Yurq0djHuuf
-G/1zzIu2OkkWtzRpLGn+bw/AlRi8wJ7tpLx8RgeaNCJMUJmpL4PDZFVARaoO3mWDYbTCuSGap0b6kzTi
-RbIKh3YKFEB5Cv9rt8hDdHwmJu2sABW0mLceqDqqWjMk7B80yQRx4nVTRwsz+mCiYr/3nMtGoLuroK9Y
-ICOKNZl+yCtroBxSycEEGuGEa9/vAZD3ShMiju1ZwBGbCbFb7zbrjCcpwnNOCn38ENvVOo8xF/vuMyHW
-i43Hwldm0RTo3tBQscZRrQ/bzCT2VmG20am9F132RjIwuxvtaV6IYR3/33fsSxn6/1GP9kdwlkqkVP8x
-pfRinX9c4VlBh+/YQXj6bvvu8F307u7ZwfAd+/Ldh/FiZWNfYT5bOrYcNWAfGt5X9Q3E4ZFtbRZ+GElx
-G4TZwDY4aLQTQG1/0EowmETkkszCahCcLnSwxUj3UCh52lzcqO6kLYHue4DSBEJDJa0CrQMOhAcB6NOz
-KCQuB/iqIwA5sBvGu1QxGqlUrWdtinXUiFju45Kj0losMVuCy7f2EF2qQ1IQDG/kE1M7DtnuSr35jkuw
-sxXmd9xnKsMxrS5YqcQBbR7YjcOZE08tcZQ9Jp8bz3S2rY15dgQFtYy/cp9NaPZKR/oN4BY3NCd8tqxO
-YS5/NofDUkFgivRx42ifU42MYLKzXQfRn+AajFqF5xK6Wa8asAk6b2IzMJZALhj5LJrT2dpaAOrdgvDX
-Ug4IhxEDA8PfC5zDvY4OLyVVimbhYJqui4ErnYKDyrs4zzs9FHr3tWs8m7+bGdp2GceXPo99PCNv6GKR
-kh+TxVIngvYTC670gNDVDE/HQo86GlFS5vGa6Xbr9rStixaHyRXz5QkK8IyMVzQmNR8kqUGx/bg3J6iK
-QO0zGg0uUutocDtHZiqTsppSpfmKLMhleWPE4vllHg7+77t37Eux3mHzPkCDd+/Ygfit8q4smlK3XhhJ
-FocG2uZ1W+IzxbOLLS5ipu7as7tgW+DcddspUhcYvCaQSHFD/BiWNCV/p0XshSigpbIWby8jNTe5itJV
-DLpzO4JtsF0U8A/gC6VsrQ2knXSwzC20IPx5SsTX73cvYhmreBiAVmCokL7IOP0tIVuPSyhb0m1YxX+w
-0yQ+a59rS0gfUbvdp0kfI1zDBalUsta1q6jXBQGt2d70pz3Pl3s3daFpzQQnGgQJ2RpNqNxh10U6cshV
-N/IwzAs6UzHDEsbB8AjXYcU6yvi1GMWjM0+08S069Tn87z6JRx2q7vAI9czay890QfirmsmhfTcq5dTq
-AqrrhQhVTebJ7MLdbKfEP1ZK8HMh6DuS9aL95hFyml5KmS/wxKKWkrGgXmUo9+REQPVwE6OWrnQBMGVh
-WO5MHER2pQ/wGYb8yVT1xxdIrD/uSFz3U6ddq2yZp3c9lHskF8fKQvsEt7jJbMr9C3lxl8MIs/TFtCCn
-7OiR5p0L0mG1YoQ7t0KXJCP4dpnPCJIuNS2xOut/w+Dq2HE4dWJSFtYGHmlKdR10RQ1RwiDoUKajusNp
-9eAGAYfKpibwi+8hp676xavrV1KzAjctw3ZtFfgjdP96tUKzxhPgLpBK0h4ZsTQgUQueshC+FHSdxaEs
-WtHcTAUBPAY98mR1ty1CznQchpE7bpWNCDfS2f9r2qJ/5rQ1PREa4+aYEhrYMzO6q3S4TLTMz7I+mVYI
-jdE3R+0XChqniUYueJBg3TlXmgox+NuQWZsuLKXmyaVpGo/RE87JKgejMBhL/9swlMwp/W+UZIgWMYFp
-yAhH6xy9XyezC/THepWjKeFbQrIqjzDOYlnVvmdSKKQPo/DDdRo1jVq2PG4at5zHEaD839er/A0uFpCf
-xr6d1ZW61TRkWdlbzZlnNDLihPFQGsKSM+f6qlX3B5qgBB2g44foD6vKP9xVGgP5NKWMoGmBZxeEI8wR
-47jgiM4Bk3KpIBn4LUD32lpLsyFgTXl3NV6ZzfjD3wz9uZ7ghTTbFKtK23L0nHrHvpy8Y1/WrDXjlbRS
-lHT5axStAbw9pU17jpSmGhCSAJfT3NHeRvvpVW8NQklT58aT44Ibi6PRGL1ALNsqxBmIotpae2dy3e2+
-qfsGtKdHZyNJ2+lxc7DKuk+PzkAXU1rGDOtB84zvPWM6hk/ySyP+rI5sD0UO4fpS5r8pl65qNMCZy+2J
-LC1rABDBr3D8IYy+HF6NHV0BAC0NtLzK3PY37w0cYsmcS4upWNon4bv4YDi22qqzb/S4fsOIGC6ZMePB
-rWtM9IlSZfZoPwe5zB8g6jgiOVF/S1GPWjwVXI3Qfff52uYMnvtJ2yt2n96QnoX60ConoJoFLaf9Zv6a
-1VAsx3BlJsNq6yGtUKec/pRkJFzZK9/fAU2f+JvrtRwdFshjsX6TLU5AknZqvtqEOTHb+5jFtGqtqZKp
-n9yd+g0Foi4Odli57XxA+qRRrUPTVblXcjx9KKmjeNOSJM/Or0frNFTuzD2L1+uvHJx7FS/l92CE1FUE
-Tcl+2BuZlvItXPpFf1TgNVy1qnQi7tcntQ3A6BvLrbgXOkNhVOEylXQ9ESgll4VDPfflrPDaBz77I7SL
-2SiiOW2TkGSUXcfZG0gW6DoxNQ729+51H+xLMuvd0ure0u+oX1MxOU7mgMATLGWetlsVN+U1ZeWZXCoX
-Hhn3lHTgv9+Jv718h+rM7rrPx9wFo+e69Kgqzqn3wiPaUbTsEB8Gg/O6ygMv7OgBgPFQ3uvaJifH/Oea
-/wyrrGnbswUkzSnFYJ8vE8blNQdQAhRIP8pndsmaBcYrLlv8fCl3gqpkH4m1w5r5uVsmK9mrPSPL/mlw
-/rGtR/NumlQot8FNVJA8xTMSjsPT0YercHg2HC9GKLh7/G59/+hoGrRWk1Ici21LyPW/QjCKWSnJeLEb
-IatuMUM2UUwzoqOlxO6xibxD0UNZW46TrVqrV+Xsa7jB7EKMsiDZTq3Z67I0E7j70rRa1X0vTzMLXfcS
-NRPHLWwPzlvPyqKtW4IhA2ykK0TXBnGd2/H05xNz1k1UBa5Ivip/2ot6E6lglLtsRiJerBl/wn7kq1Sy
-ze9pvLtNPrb5NCysuars06OHQ1X++E3m7TFA9OhWBbpvv+qTlEy5+u+vf/k5kosome9koWeQDU7wlBEK
-EGomHm3sEVAEjvfueyqqgzRA/l3+dLttK9LoNhNMVsYwd+phpymdKjPH9ymdhqf2JD8boQ/ghneCIMJ7
-nKc4yR7OlrhghE/WfH747cC+ghhvyBMWCvwjddcSIPXkMtbUJ/N5D8o9+ryxKB64XP4GEungxLGIbde8
-gUqLO2jmxe1cCXvLVeqkAhPq40c0+JmiZ8l87tDNjcfoFWGEl24R4H+fQMBmQVDCUEbh4CPD3r+7dblG
-kTr4ocyiJuYZVGkkRNtH5hBzpMdo36o7lIGnUoW+xpskWzxEv6YEM4L+jpNm8KZvxgk8tzHjYNBPzK7+
-H52W9e5RY/Qab0j8EL0iypvSn2uw7oO0zmKZjOf2Ze0andebfylm/jw2qO5SZlbnmTYtKZikgedQ8fRm
-zuCedfrGorteNQla662XkOHsA2cXKkhJpHwMGQZbE2s8w2w5pbiI++bW6M6ecZMsGWkpi+gsDI40Ag5l
-uFbkwUWURsYM+F2BmXxLvvMILCUiMwugfDhwZ022VeAAbSjAJUpbuWpfDGPcOCtfuYksyLwgbBnWGxTx
-Jcn6nV2N3nb4enbYHq7MuUCKNp6wXz1vYTMBvxLCZ0t5smKlhaWFnTSnw7Wu/ui856OejkxNCscwDwYj
-711h158tyGWh3S+l/ZM8j+KkIDOebEgYcKYk+LZ7W410Supo9rZIT1AwznHBE5yysUoeEi35Kg2qrTJN
-sosTMyxAMg2SktUIYc4LK0WeUo+y1/r2mRYViEGhkIfpHGDgrmMKZ4zmpG3OFnURrLtfxHl0ra7N7dc5
-QPtJg8iZgeYEBZMm4howT1ZEADUeLwmOSQEvqhaMOgfFrPrmIwN+SlL/mdjhQ8iwFxtqAaOAvRCql7/i
-AouSg3twCeLAo27w3bCOBvIOxM5y9evU65OhTtWUU9x79jnqhNvINlZNnhlYVjNflc6iVY2GCUV1bVzC
-jFCwStI0YWRGs7i8QwUsYfJrzeCxkdaOhqVDNQMS16iuChg7ZWcaXe2C7hpYfBqfLZeny7PV6nR1Vha6
-qjUJboivNaeaJuFmaBqRfFfmVm9XTPVFRrfSmrQy3uIFNXYaaVbKjCfgKiMxPG7Yk1RR8Tcwm90w6yh8
-SYYCR+dUJhKwD4YQd5Vl6KA2tJICASIqPUDBMKj1GURluidCjjknhSBhDC44Yfxx9zH7uPy4+siGh3hB
-xw9rvazgpd/bRvWUJrYxm2QwqFgxp8dnwxFand4/K/XLASRXewlXul65eOaAMzHQzohVi1ded2e4uwU/
-FICIZIWjrlXaOuGagCrwvZq1bgOJJuB5Bvpzn5nEWrqKI94lG5xaSIb1+exEZriSGxMKppO7lE4hMgCf
-UYjXcHpAI6eBpmprRn9KMu+dd2LgIlFVCIrYG3orQYB3iiZVUKOUu1RcYxjgpiKvQhqpEbRzfhgwy4KI
-MQnUhffb7Rb2IZzFYgOCK++3tEjjWUpnF+MZzTak4CSGXfS7hNGJJzxKoz6YVJwArpV/+RIulV+tWgjX
-JYN7+fHkqOe9rNUmqoivLYcRar2btV4pZJO4QAfo/rC8UHDPO1NhGohOCklTbkWtOUu7BDLFXN5myeU/
-ncGISvdmMtqhYA9e41r3/+I19udfvKaG+l+85vZ5zeskm/1zJRmo8fZEmWqNrMDD7We6Da3TyF59QmnK
-k/xT9YmeakStOvH39OhsGKl6ww8IxFDx8gQNppRzuhrs1YSAszd42u8ov38DgJ+LI6OivN7VMBxKI2Xq
-0zfOmATBCMmGRzNepP9hXyWqP/2vPR6PZYbUhCFGEdxve0gySFCEMzQlaIbXiyWEcxXrDGGZcXS7JBmC
-ThMFZzhNSQxOfC78ZZ7SvGkBMVtU06+he/eQeKjv2715O9k24bNlrSof0hlmBP31xM+uNjxSOemfkTle
-p9bFvuZHjP5GbAQ4givh2iFljBVAS6+8hGavxTN/MY0YTaSjgvIvAUygAXkHiT/Ld/JFK7561Q1ynkNA
-lST0oOlWaX7aIregj4+/8ncy5FKxKLljkfLpwsc+s2GGYuupc5TbS6UYognYehqJry+0v9K7bDBsH8Fa
-5KIoK4MXAZOM2bh3D41P0Tt+NpZhfWw9PU3OhjKksXVg2mkGHzdRj2qqqHyEEnQIZLTP3o7VkInVsGWf
-cEkAfh1peYvSR8BZQVjyDzxNSb/NqiCMF8mMn6DgiaHidaujcZpO8eziBAX3IKYl+QdxKpUbO2BOGRcy
-eY+dEPa6sglWVjTxlmZhABCEcZrXWkk2fITWiTdSVhrvVSt8fKEO5cxsdpPxeSMa9poWXNpMq1sGDEuT
-euhQs9ZJ3l/SQPuFnd0FWWoYQc5gRgtOCkdmsrLnaMF/Shg/Qa7DY9lw96p3SepWGpCqo2vmKySOR+xk
-PF4kjEeLhC/XUzgbrdJdNluO4/jro79M//oVie9/+2389V//+pe/fOscHrzmFPLx3cLgeFaWa9xKg7iS
-YG86agrN6dGZO7tg/771zOFkRX5KMjeLAWGWxdMf4FQpzsZfwbFPHTPh0DH48+/jP6/Gf44P//xf2ize
-UGBjTur30tX1zmAgGqpwmrBmapABsMUiyWpXqXOan6Djo2okimSx5PVH8mhwgr4ynqVkzk/Q/QdHXxid
-c2snObaUduZmSl/taJSmOG9kwExGyOdc3cB7mpyhCbpTf+LWE/lcuoWYLSoTX+p42vmnhalyCO9kqK6e
-UCfeoBaTEIxUZtQGzrKv5NtQgfv2hTst79FeZwjQB2Vc3wr9VaR+lBS4O/+OAmvJULsfHRqf4MgGw8Aj
-NG1HjrA4+0QpneGUPKWrHBcknIpnPVgIKker6gP1za3DlDdgV1CN7PAbIzv8RgnNzkoFrguy64vpguz8
-eKa4WBKVZPQBJFaRVHqlNbMA2EpXSRaWD0fo6weOmuxC+NIsdOwqBAL+ZvGjLlgjDH1pID1QDDDiNK9+
-SO7mxltSU1VwaCI57IOEbRZ/T2K4LwtEta344VNgbxVkWaisQnDc6hewaTeKy9dimhpbDBO/pVorgqsX
-wtOjkazJFQgOOJ5cJmqxss0iwpcJC30J8gT2UFbqAaFFAnpf2UsuHSp0DFnlfOfrGLZZKHrg9FCqs9w1
-4jwnWRwGbLPwJfYT208YQC8Eo7K/W4HldJDQcjp0VN9ROS9wxoQAEIyQ/JEKxhxUkxMG/QAFo6A5e4Oh
-qx9hsPpVDteYiYovkRhexNND8fdaFB8BfeUyc9Mmp0gU0xVOsvDUWU38FTAKuYZNOSo2eJUCiiMVcGfC
-zQy4SmSaqbSigr+hq6Hteazrxpc96saX+9WtDUT+6n3LMCULAmfkfed9nGx6jj5PD2UtriEDqTVZkfOS
-EPnl2pWD/lz2sJDDVSeKr45Qb2TGgt0uCWJfFL3oXkGqq4PoMmpbEjD/E7DgeSDEeTkEXupp2mwptSKN
-dWsDNw1Hakcf2bGFzoO++EBdZcKzNAyiKS58jQPyY8yxRCvlDD1eLUVAB+7pDmSOkDg4tdVdn6buxSgm
-r2A8sboP6aEvf6eB8dKLTfKnsFq5sZyUwz5ohdSdmNJGZ4lyM+lfRG9Wdfq9pfztKk3W6LCl2R6NhJ9K
-moXBiq4ZWdENiURPl7/OL3uX2/VvockZ5MIuo1WvSf4sTWYXdQJG6I82GuStZ2iCArh7DtzTxB4IM/MP
-v3LUPDcGMoOIKHrWmVa3duCMu+FbDrJdVoCOU2szl4j58RsI1NFVXq/QgoERcOLonwoK6bMmmqC74eBP
-8t62lhpQedLsQovkZbGMpqI7FmGQUbHg4xHyZW4yP13GFNTaXwhUjsGSr9IRmtJ4FwzLpG55SCI6nzPC
-w6EQDNsGxLsi+p1h7e0jxVOSendH2DzEPutLqdyyU5S7hFjTHRIpueSHOJstaSHEGRBk2uAFVzpqhYgF
-SHAYPSCrdlSx4FRBdL8TcNdkKImx7YSCW0QPhubm4d1xJJPziKbu47s9bozkrYMm5bObDFvL5t67S457
-9khjNz2+4ejrPXbbchps3+fsISgbaoB5ORkcv9FEH5cScF4KxblDFIZ72Idu0RWpeIfzFgkZlZOo0kaH
-l67N3pcUE9WMkDXbDvjIr/jbTN6SfhqI9XEhnZxHKPib+O+N+O9X8d/z4Mzw08/mKx6yEVqtUz5CbD2f
-J5cjRHNeqojFdzSRfz5+LHXD4N+u7935IaWYh1rahrzZ7Gf8c5hBOiYV08JkRIuMaw8c2nRmKs4FElGn
-zDRczYhMK6lkBuLMqPNONrRQQoPQdyg4gsgr9fsEBUeBg9iPH9GdhP2QZAknYTa00AWHhj8+NpMim3Rg
-9HhST7wMnbVeTSEYD8rMU0oLGc4gNjY8RGNU/hKDYc4NjMaqWE63oRwqA4vEbBaAnK9qRpzK15aKXHXF
-BDUBy26yplvZdNEMHHH6Q3JJ4vBBre2P0DE5fFAbXgWtssZZ9pGMLNAEZegROhIjdRiI8Qlqtg0BcoDC
-g2JoUGc478tAszAQ07nN1mzYRBwTUC+G46OjoxESy+iDZu1NM6qucLrjpDWqqm+N978eoeB7USWCma0v
-n++qP2mPeNur+mnv6g0LHEkx48ksGCGpWzIslfD7n2OtbDNW6kxlCU9wWiqsnY8/fkSGvZLxXUoitRM6
-FQnS9t8a5q0/HrweSvyeom4cAwgZM16DpPqjVg0O8ktHVP2VWyNMMg7XtiVZvubqTuzBSLXVFQeqrcES
-Qmz1XebcL/z23O9xIQ3e2ySL6VZsWWKa/qBDS42xlxAjwcHsOGiP5RVp6+v9o/rMUhbY5mNthW08VobY
-o6Nm05BvUju9WMRHCH+OWEkE8ZKSKDNeEtXdW9C1XS+aFpwDOQ2X5Uo4fnD0T7DPtBhkug0t0sYiTsjY
-upVTw+8seFrESYZTbzQDzT+FzaO/GaMmEQfHR0d/9mmJpXmG07ydNqduczz+ZPaQT2bLGnCaN/OW6Qp3
-+1a461OhaLqrxrongFjDPgcATd9WiEEN73DFwrrwd+8uiuVs/WbOK83BR8hxW6f4bKNpIo+R4EzXdWEn
-6qFUcp2MjbwQoh4vbhDpq7xY3SpXj6LFTXhf67CmpCzwyJO99fq0mItsok1dVcO9NrEowytSWdy/dSsi
-xOeG5m3VYeVOUdFW1X78wF3uxnsFqmyYPazo4rMzoF8JYfZ7nMUMyklqzkYoOvYVFlykziC8HfLJ+Gcd
-v2Us7ypQ7jTKwOHRWdDcvzN5xgE6hyezCxbKOQVX/biB4wJb93Ii5yJoukg5XcmQZgnNxPwbOLdv9FT0
-ZVfWn/3Xp+ZTezVFtv5/grXtagZ/Y63W3ZBsViL4h2/g604ER6N92NRv2lnKt2Ar4y9oShfK/BsVoLTz
-ceXx+L/AhcK/GKT0M1gMukx8AzB4DkZoIL0y2gq0GpdvUvWuX9XAoFqAqo6ErIpt+OAMKcGULl/QQbLY
-JfCgyieNKdO5UVU0xcVgKBXZxsR2oxEoepusB+JsuEc3AiFd0LuBb7rK1RPKFdHHBj2Q3HMwqu07YssJ
-h9c0LTdMyGoFDd37u80Eru9L/bcC58t/yun72H36bj52+UCj8vj97ac9feMso0bCo/bzuf1yQTJSYE4L
-z/tpsWZLiM8RAFOIx/GBPc9iACJZ7AAhkGvsewF4goL/zwGxwpceKlZJ5nmT0WKF0+QfrkRO9e5pB9B5
-NT1QbEm3Txo93abs0EmiTlDwKE42CBb+ZFDQ7eDxo3GcbB47U0c3YNGMpofp4vD4fs9SsoJO1ArtN71p
-6VdAvtpX6zNCd2d0lSepN8sVWCmfp2RlKTyi2TJJ44JkocfspZ3UOks7rz2q3OyeZJDWEScZWEdc8dMm
-tvvt2Cxq7Eo6W1Z5bjRmcJvtEGfZnnU7+8Xtxg+m4RfxpX0xSJ3ecsn6KS0R3e9b+f9GHaGt89M6Qn2I
-1M7t3rnd5s99Y41Xt2v2J9NoBWLv9PpWwtGujBw4Lo/MaIzuH3nkGSilbNqVMOBZpUnm8JnS4e5ydpUb
-Z2vIe4ALggN/SDZc+1n1XUFwm9fTtCD4wnOOleHUfWsSv/qdFUGaFpt2VRh++sb4skNFmoUBlA9GEi2J
-uyBBnCj36e9VoTateLlhuDH/L1GGt1X1abThMZmzhsOxeOQlRcHM0iT/FfNlO8mJGEWA7cLX7SHUrTtq
-caBuQ5xTuPnoEFIUgA88TtMub/kkP8yxHPl1kYZ/Ek9uOQLj00VeXIemnaLJ3e2iL5hehe3WHQukZ5dI
-DnKLUlE/cm3uCOrJHtNWKjECmuNZwnedjmbdrmjdOJprpA/nMnhHe0Nm64JJp0q1YnwNN/2md2LWvKGL
-hVNdDcJJSmeCOyqJvR6y0dShlIcSVzxRSmea1nlKsegHJVO4a4YZ9UblF9uz+hIut6FAbQV6A59FsBG7
-Iir2hLr8pCGVGO/hejSVt6tYhmQtVTpHKvgT+foYH88ch1/5+qu//IVMv/W+/jrG86+x9/Vfv/2a4K+8
-r+fzv8yPjryv8TcPvrnvr3v+l2+Pp3N/3fBxvPZJ1Vgchv7Vi4269+1F8Em9hPv5/e93/vc0jVtKL+lG
-Xj9wjf0LynawalsSyGhGOgrFCctTvKugW2j/VVSAJvKHKZOezJJilnqrkm0RvNcX8QwYX8lLoGzs3dLV
-PElT0YTtMuHtbVAM066kzTdfs2Wa8UNlww+O7+eXvpogIcc1R1qmJrneSDucAgS2kgZxhvIeisVW939I
-Qf2XFZY6MWNb9NriTHx3yh83NWmCzF/g7ZMsK/eWbncKS8ZqXETS/Kh9SyaPDHyWZGQ4LDex+0uMxzIT
-4BSUILqmMkIEsjrZe7K89EL8fzijWUwyRuIWc0xLFYM42fjsULpoAQnWAUG9WJOugm7bcBV0215eSST3
-B0PpxT94WhDov7esfpfKdTEfH2nUODJxt6P+H2r8L9vs9lsNSD/L5r4t0ltrrF4/uCUIF1W2xUtQ8i8L
-Mh+MEI7eFmnZX/CdZqKeZHZh2TNbsUvTXURzkklMIzQ4n6Y4u2hrqM+7CP2Pjs5TzMmCFrtbX4UK72fZ
-6B8pc556btJggfOzbKy+nu6W26vQ+uIz0Xhs7/Me5V6Bt+Uufx7xZUE5T4nhbFMaZl7El236kgyvIAeR
-iq2uhw8WCenOG9DHIedn5ezjbjnQ0D+jgD/nA3JIjboVrVVfJryt5na3IFB2JFYknzwy+dybUjqT0yJ4
-I28ukvecQDb7y8TnFQXSi6gpiRYyajocojGED/kLrJLsWQIpTqUHnVYjtpYQIzYSX+Dw3Ar6XwD3e0sH
-+3uW4Jqzces+IgPwxTFymjAy42Gs7qhtYyIQbRdfoscTJME7soyV5aCiWhF02JYL1h/XrUPVKzMtxHa2
-C5o5L6s/TeJLR946s4W5Mw7J/BCtXk44JEfN+anJInyOclVxmKxyJaMDFJQzVsWF5fz0+Mw3besNq4Je
-RamjM33fQ5+yOzTRDlN7tkCWj3U4JHtf8LCMcBREHSolxwgS67fiEh+j7E6X3YmyPfIQCDoe6XXZJyVB
-tYRzHrfjV+D/BbCXvWB/B9hdL1h1VXtzIsDw90KglXGg2lQTqqPL/EvL/abNg07rvEXtXje4Om3efQtU
-JV0bUZt1TwxTadYT49Dmnl0pmkqti9Tt6E5tK/eGXHI/pfK2Azm43X3Xr1rg1uyV8smApj5G2oXb4QpS
-lfkeXBRkod/RY8Mf4NoNrCwxmqTv0OEDdIIe9Ev4o2n6Dh1+i07QcXexerqKqlZIXIFOUCC971o6L4Ns
-/1XrIvGgTQaZwv3nNCZCNvj+e3rpAy51in06bDqNBG/0pFtodNR0Gu16AVdJkaZRadS839t7dTqNtCxz
-v00qg/s+BFN3wwhefKmnZRsf9uiukZcFSe2izi8mtYudvX0cjFBb+iQFdr8X2O7Yb1U0we53RW3ABRSX
-nGT8tUwS3y6hQRrSCrxdbpGAz7MY3amV6sxXBHfhoEN5ua1G0r6LqDJhVeRZMp/rKwR9pfx7T2lllOzT
-J9DZCK5G6IFL2jED8J7SVOXOD4NTebYynINHTR/Ws65QwAZ8TTcs3rXqh1veI+l1qw6/m4Ql0yRN+E6c
-gOFX2n6K3jvWxFfZMoljkvnq6lZbew7a/wqhlJ++IZSfU5TjLcQg7hcGeGlE8l22RfJBNJbSD7Q0uTS6
-6r3Oc/z0BpzuF84XkzlzJkdqa+2th+Z1W4vl6V8eqOV3WvjCzE6PzoT8DiPehu1JL3Tq7jBIQ1nwZ4Ld
-RussEYKWv5LPPNaw1ErIqKpL6RE6GMrYL/gRzVLwZG5bxvv4aSE7CCOskh0aT3tvqeh/X9DkHj70qNOP
-3l+NnZKsNbVipXqq5SHz7+AljwJVTgfcTsK1aQoqzXaom7zXLPBHn4IQ5U2ai8oQsssqJB7QPGvTbIu2
-tJqpShRNcjqyjULZKF+zZSgqQWOgDDRlgiG0acv2SQCJlDd5tPOwPB2deKpH46xFIQKYLj2YdGyhqfHz
-YoLTmwz+RRPk9s9CVR7xHjYInUu8OZxmMu+Z2i7EP0Wj24MKVWnE+1UNqcRvpWrP2gFeJfbNtkml9lXV
-sd516F5Z9YhshaNl+HYrGLvbHqHjspvaqjZX8a2NULkEuilQtwmHQMkh9MUQjZErdqkcPXmxvX/0FM69
-hEAYhMMJlHWXAwoP2iAEcaWbUhuBACgqfNwupJSEeZUq/gYhfSWxrAxfokd9KsMtGhx/Ze6nMMMq3iRa
-MoIqWpZmJdb9DDkP1aQU06KNeFWNYJllKNwq2VvO8NWOL3vVXs57gwjsUan1yRCxa+UdlWBUhmUJsVdG
-X7WRK/exIxWTFh55M5t7j0v97gNAve4EQL1TN5S17m6z1l2/hBFtmZeRK2cEJIPuzndQmj2kg4Y4FYSH
-fz0a9suUcNgZvGAUuBQFwspK0JkEYRCDyuiYrNqoAa3euTjfvffnNKlfrfQ2SyCB8TD6gyZZOHiIBrd6
-aFIeiC9ieWUaGo8NrSB6EaPDx/J9F4bnWSxOrxUaifXwMVJvumTkV+Cb08JQa1ejHql7URuuoOWtUnAb
-apdBfo7CpPP8qz/QnlOrwtPkLHoBqfRbSDdwqN6Q/NfGdnQWKYhubDOa8SRry9+POrPDl51aqO6HL48m
-ekQO0DE86u5NpHoUEJnl+xREfTq4oNvuTkH1bi7o1t3RyR4djWT2CWiPV1pqflqicc1P+/CgqlNrLXLM
-fNGiSon0/0Sf30oX7mvqR1WUj2HbwFlW82Sr3nY5wRoebTV7i8+x7YUnGb/+mMyuy8ENmTmI2GZxglu2
-J7THHTuoMvdtEpakyVTGLfa/baParZoauj68WHZWaQ+6CQfUuJS5p00J0qM7WrIyybUWw8L6EoVavPgS
-RUcPhtLs3LOOMltTDUWfkqXQVc2izhkBBRkv6AXxtk2HxIWieb3bIZEeqrDXwQhF/VoxT9L0lkkRKA1C
-jqIWhwO7BWCsGHhvkGgUuvTSrhRaTqMA3MEoTQOmsqtPhZq8Pbzryyvz34DDQy96ur3lSBbX8Knd4JrY
-ZjidadOg6jlRgXEzlWxBhy/YeIx+JhtSoIJkMSnQlF4ShrYJX6KUMIb4EmfoW5QnlyRlCBcE8SXZwZc4
-mc+T2TrliFMEMQydPK8i+hH6dg9e9+0t8Liy7uszOZqFA1C8w9ZTm1M4a43zQnXzx7W5/k36QWouOslE
-pdbfOAuBDNAtjNQC5tqytXRT2/e2qz5jZgfYfO5j1fSRCP60ojFOXy/plhTBMOJFsliQQucPuGbMT02a
-Ap/9Dtd8vwLv/ZqoO5ohxUX9vqsOd61bCnvYMwcR6puHSHxU83rJnMgUJXN/7pc6PIiTckft70fbF28Z
-vdExFGWxumvqjbx69afjPNOZpAn9PzcM+6yxsuU3Cq1RWDr0eUHsbTrkxpJ2nV7Xe5re2tk6TW+siyWY
-EekAin1ZCjzVN5zFlUdRKG18pdBU2fKGw1b3cfN6ONBwq7w7HWpkgOqV/LfnTa+9Utmg2u1rkOGh724o
-8xfYORymKfVvPL0uO4Ubhm5IhS8bQW8ixGox76Hba7RRJB1f20ZJ0VyylOBP8/m8RwHzTAbXJR7ff9Cn
-niXOyaEU5pNsAWnFioTlz+OFP2qvpx27ny9JVzpjpI9DbU7NFYDTD7l6/cxpZi0nlUqj13ppoEcfp0LM
-IPNFxOi6mJHn4nuLV3fElsmc/wfZ3a5rU9VaNJEtUvPOx+aNrhWNwJzMV1w5h/uvQKycx60yzkynVZln
-0pI+X/Fn6wLkSX2Kr8pH4rjYeHx0NvTZc/r7LVW6NCOLMrp3D13HGV4iqhI793W+N8rJDuzhRN/nhOPw
-dvdP9kYayD5+eHsYy645b29rcd35bFaX43CckS2qzoh9C1YapUotVK0MpRaay7SsPFkRmaG1L/LKJmmv
-xeugLicqGo/Rm2XCUEoXDGF9vzPcEopIUdBihKZrjnDKKNrS4oKhKEI0jiMn9psfdd1Oz6v5Soxo8Pvv
-v/8+fvly/OzZ4Y8/nqxWJ4w54lMqD1bF+bxroeG73OhMUWsniS0Z/Z/SjBc0TUnBoln5PQyei359yotU
-JveHIRGb+90l5zl8SelMmmTEj4Kuef0Ao+9ZhAIjVIKPkAQ2m3u3ur88yRbWRemAIloQHgZjnCdjGPOm
-n0XE1rMZYazhMtrsVVWVRIEm6LQhe5zLUqJ7n9dj20lRjCAc3jVQpCgiFVoLF6M4AV6vV27LNbxc0m3m
-vSP+XFQfwaAwF3HigOK9R1JVfTCRLjZP6drH+OD9D0nBIDdBuZRhytXftXmQ/oS95fWrnmErtdGS3rSk
-aEasmgUbMrgsuceUQBM0gF5Gc8JnSzEb5X0PA3QA3/xVzZMMp+muHjdkT22ZgaxGtIIBflOL7IIpB3tW
-k2a1kXGa/1rQHC+sfeDKQs8px+lPSUZYa2YxxW7qPa/8PFqwy6MKibsr0DkwjpoLr1alZwW65jiIwkUR
-zZZkduGXKfjBQSefbMws1Rfc2XDRjgXhT2WtnU2+IDs3v/nEzRb1livHF63fqxsEJm9PQIhJrRMEuLXU
-gJPnlDVY+QiQ26fNnkwdUMOuEhVELLWmROQ4mvdiDcjLHp6K9ooVLXmyi0E0u9JeNNBpT9K0dfLI4J3T
-AU7TwVk3utdqIfadkNUUbnaarBgGpq3WYp2Sn5KszrkEsx8hx8wVNa8L0eLBeEazebL4Dqek4BO4jljN
-0IdWkXlBVzXpsntLErUcTNDgni4LVegfWn4aCHHt8OXLw2fPmsGnNQSiAjeC5fJktWqWlfnvPRR7NsGy
-PlkQapNf9yGW05LURmEnoWptr4vUGmMQEsfjMXpUkDkpSDYjYGyZDI4OQXaMOBug8eMvRGPf4MVrIti6
-I262fCKByueS6ygK5LuHX1wNxTxUKH/rRvibF91vJrJXmJNfcu1g1IbTgHSjNgDMGuSNVx3IJVBYixPg
-y4RFcJiZoDm6dw/N5a+PH9EArzk1vGQkKF5cGKDilwBtgs01PQpQ/XaBLgq6zr/fVbD6wcePZsbUWi/I
-ltgd8BLnvfrgJc7d3Vu+NnH/55oUuw68ABPKZr5e5zkt+Ai9t3oaLxYFWUi3dPRetPe9+ezjRxSw9Spo
-dNGK8CKZVSXUbwHdBC3kqleA8KvejzXIalIaBfTDjx/hqF+bcRqD2P+hyJ33UUyKZIN5srEOAeMxmuLZ
-BZrRVb7mBFWQwMnQ+xo0KD5K0ly7Ibw0kExQsMDrBWkccesCRRnw0Wx1NBNnEeKUbxw1KejuunphE3R4
-UVXfHAhtZGrsjGdiZojVa9RwVR/92Bj0mDmnUsy4PEeVYPDbBcrxwsAHv9Ts0Zy1Br6YynVmlKkeqYLG
-SqyVzf5mFzae+UvDrrjEkNd/mRhzeTxGT2m+Q0A2OAOBEpYhThHwIjTdobnCzyiiaQy3mTHQ99SWRG39
-N+fVuUxYV3aYqbDYjNCFT87eoMlkggaDdh1NX03RXGnwwh98Fx/NK+69cb2tGLZbXTDXm4TDAC4GoBrr
-04szwfb9UiuSw/MTxXE5AsA5CrwF++4O4SxG8qC0JCuUZGLQpvC0mhVREyFo9Fb4gjA1koCU8iUpUI4X
-RA4tCpOIRAIxIpe5fDO0WNZ5tMQsfD9CgaIwcMZFqdF/rzq3Nvr2hZTNSiSE7vo2nXCjh1VBMSLQ12rj
-9JXeX6MM9VVd3bfC5ii7mB+sFEbUdZ7MPCLoV8/cT0tOqN9KpLBJR3lBORVCjoHbe2AxpJnmCdrLUMyF
-Xo6EPd4jwXGsVW+MtlqGQihSnbrLPfsi5ppIedx23mpm9PHQTW01jp8lueps4BjGZ/4RLLd+tX/du6e3
-tyZt8jHdZgyvckjybpY7QMFhIA428plrZ/Xu1ibOwNqUW5plbvPu5lV5B3zyzUsz3NIUErulwob8Z641
-7YDWEEcsBQJmBA0E1oHt7WQS495LHH5UEqNq27WQ+jpq7wIvod+d4axWkYIwwuFmZHcYtrelIOF2tNNz
-s0QDZ222qQMPTLraWZW8XxPWdZhWUDarZMqQHBwvD/GCNgXFynNSc1JJlMJnzP68WGfts/5cYLSYryt2
-0Ky6LWxQW+e0GtQsd5qc9RHYwD1UVH3eKGyPTrmCdjmhc+RN7SOnAeNFki0c80B/4PjkRaI/MUkJJ+h9
-cnrRkkilLXGd17FR0jmlNCU4+/wJpdM/yIx30PkLAEUXZMfCzbBvIqVPSL9fWe5a3WpZmet7UeB8+YrM
-C8KW8slvpGDSst+24hWUW22iXup6Wo27cGv7/sbd4K7YhaUbnTjbLl+TYpPM9rP7jpDGMkICh8MOXGlj
-gEsN2Bqi0FdJBn/wJcRUbRbiT0w24s8/klUJtdKASSZgzyyNdcyaNQjw267FkFilGm+EBkmacFLg9JwW
-8HObpPEMF7H4UX+VUX6e2I/qTwqyIJe5+FYiOqsriBQtGzk5opf4D1qgxxN0X8hgzZdJpl56LKS1o7W1
-U181O0BsjOe0FGSqXpDb6aiSIEZKPLE7cYazJ2tOZaB786WdFDNcEP66kZlsKHjFQNA6sG2uzIJvtZsY
-XdojTMNGHlp06I/r1FdnNC5sIHQM3OYZCCojuJgt0aRahpF8ZIqRAvAPNFHA0R/MvOQJvN3ki+k3Xzdb
-KYphTqcmSMuM6OnDBBHypQz0B/oO/fvrX36OclwwEv4xRCdSKNaMtVFJksUyoZkAf5HxsGr7ErNlOISM
-fEe2fRwvNs3zpR5rWnASn4vDlwcCFCHneeNlU5hRjdIiick23ztym9VxnyZnqtekotu1KEfofc0Zwm6J
-kg01JazuZqhtneBSqGGI6VSoR3LNKegv1cCr33cmKIA5GdhmQrnfVUXKBxMUiFVhFykT61WFjEd2MWOu
-Qr4mZ2/KvEUHFZxrvpqYsJWS626ZQ8fEZB5BrmpzOVZOqucrsI3UkQ3Y4ET8V88WNliJp6vm06V4umw+
-jcXTuPl0K55um08z8fRl8+lOPN0NfGwkYa9IiiZo/H/Dd/HBMHy3HYqTxd2xIfNXx5L0DX0yZeHK41mi
-HNm0HxtbT3mBZzyE9fpDSjEPV6fHZ8NRrd9OV6f3z85KtzcnlylpeDJlb+grkoYWWx6Pf6YcCUF+xoFD
-JNkC0TkoGEHFIPFH6AdaIHIJCoMR+mPNOBrcPzr+eoC2SZqiKUEbnCb1PC3ascUw97KR/qXCjZTfYzQv
-6OpnaqVUdTic2K17vcU5XB1jtU462rq2Inff252JrG0HlBtoIudARC7JzEqVLaq1RhuZInA5JdpqUtDG
-4LVsJWw9XSX8ibmh+Ldta/up3Zgn9rA1X0Z/I1z8zPDKimey/FaMjBQjG/2NHFnG45hM14uFS2VryB9G
-Y17EIOIMDJnOeAvX5AnO7DdfMMIByoHbd8mefWrqscdDk21cTQ8bR1oIbTBghKzAKrTCF0Tvr0htJSO0
-XZKCIIzyNVuimBKWBfYlCRahYh+zH4oT0wxzu0+u4VsEv3s4F8HffTyI5BHXvwxKdz6YwBLamsLjAB04
-Js6L+MYeto7RdPb+ebRN+JKuHb3NRug8midZ/Hcxts73H9CL+MTZAHQ13MM51DlK7SMEeSStUXkNaiXB
-o9uPEw2hrGTs5vMmJzIltGYBksWts+VJHL/B0z4kaSG6LoJafqDIklKlMaFdSh22EskIf6Fqr8j0ycaa
-zsR7BMJpvsRTcE0a4OksJvPFMvnjIl1lNH9fML7ebC93/xhELE8THg7Mw5TNbX1xK00PdWNtSUP2GPio
-I55uHw9HSQoVmJiMmWrxNuxHlr6z8lYom0lkUrl8G9T9SJkr1nF/ypaU8W6iLCHjb4S/wYv/+H73Unv/
-GDNSzDzPrISj5ClA6DOb9EczYUGw0nibRz0oqpyMbGHrjnzROhSgNzmVgGdes0q32qE5SuIQDBuGouEm
-owOael/rbWLl3c8LVnVpza9Ff7Rz1rmC/eC4GwR60XB58UlG72uOMh63FnfHGdVUNl1/PTWnmr0rcplc
-4urqw9YMjXBEVYvDaSVBdV7Y6mHjaH3lCRGf9cmEVLq+VL3g195D4yt/B9GMXsBdHjTmp96EHi4cfpOC
-NSE+iz5pEHTtFvozGEuPtFanGbxQdUvY03iv7ObSc1GW61dN4Lk1ypMPw2QqEoF/4sh94zcWxiMk94k+
-a7ipLXxvu6jhFCIl2tyUNjjtbP8F2YkGbHDatwtcwVnvtc+h+OM8sr0UhzS2LggSGzBKGMLpFu8YqFvm
-ScG4KGuHaTrUrtVuatoDYVJ5D5SO8vDM2JnwCE3behODnnEJckhnFDA63Cu3e+kVO92rkr3q0Gn6opTO
-cEqe0lWOCxJOe8Tf3ea5NngLcrM4uENcnRIemLx8tOPUixxSyIpwLParsUL0nfw7uUXBpDI9CSh0757c
-UF85E6p6xS715Fpd209h0EtdYGzf9bOc05BeOy66da2OASmSmej+wXcsyWYyAsWn670/QoMY79hApyG8
-iaZBTQFLvL+N4/5eE9fs+7Kaci+40HtBx9lRHB5AwL5ABygwdTPO08GN5jnMaGCJfi5qHmakt7DrFPWZ
-8AqHunxBuMtVCgF31EZGp0HRACl1NKZqxg0pVTOVRqYO1dzk60Nrbva5zxn9Th75z39ob2/09z2sirlj
-ehinMSkJtEgIbcc1aNI+RwvLRaynI79Z4Ubw81aoin456R1++Prju8W6zwg0TMJSffa+JcIdVbt60Zyw
-lgLjguximVbAcNBxBpTDBqGiylXaEzAnyEcXZPcUrjOeoOOvWtawnEN+J2JL2afjutpDVQsZp1ou4+su
-qfceFowMO1dN0PfMVqexC+218gBPUTknAnu9OdruM8QF2YEbeZvUuzrleNF6jLrGPYXSGa/ZZLnCRHXd
-Eim6hh5CudZb1bpCQLqYxupUxtV0nti78rH69QO+XjKjOyQNfuw+HIv+KHzdVTPV+PvUNNj4oT5ANMOJ
-Dla68t73s3/CAum6bFlxm05QwfSbr4MRmnKKQ3Axkh6+yXwXFlamMLu0dHoxDMDSCeY7tM5iMk8yEqMT
-7Q/TiUzZMCts2j/mO+Xngk4qvJ3YSj+ZCl/lOtMDI3DDJDNuTy7vnqr8aIboO8OrJuL0NXRfCB5a6zR1
-oASXGS9KfGmixJddKO12r5IsGAnKO3tohS8FJG7qZrx5JIzNq5Ib3buSZO6NnV2et9xOMT7PodLNqjnD
-gnvyKxx474bBnyBhZDDUFzaLiRc4TiMLwqUt4yXhOHSLkfuctUk2ozF5++rFU7rKaSYOewrpbZhtRM3G
-WfqWzx0qj1iU0kWosnosCOdJtkC6yaBXV0aaQaVa2Pf8EWb01TrLkszadbUvdDTD2Yykoeka7vCyueNF
-hMyEHAAhjlIKuDVgWs8zFcfSVIsaQ+GwpbjsDu6J7zVBwI079SKnyVktvHqiQqm9JwJN/sFErQzxCyZo
-0kdqqq8KBzHuca/90oJGq4vn+xc+8bNZqYSsb7wrnF8neNUMOdb5J3yBx3pA5mYooTeKsF5CprCYqKQV
-XTKSWYs04/8yDwdfDoboMTrsdY3VvEyaUUZLT9DgywH6rnpVOcajE9Pfvt0e0p4r35NhwEue6dl/3Xr3
-sbeo06EjTa2t4r3mrh/cWyWZbwNwigTNHQntIxEE91b4squ6mrjgqq505UhWmNvCoXFqVMnrmghMTkWy
-GHJvGG6Rxitn+LnDP9Qo4vEU9arlaoTeizW/49qpM6hyBblyWFpYgHWK3aiBBnJYNjG0uwjAJvadQCME
-bN+4OaVu2R9DyIWuhJ0Dg73D7DwAqe0WxIuCsHWqMhbj6DUw3tYTZ8/stR4XN0YL/v1OXkr+pM/lZGW6
-VvdlB14XzC0utADgsHGa+jbZAx3tMND9TNErKOJKaepWckBz/y5RaFmg7YLhRqUHanRKDPJmTuSKofF2
-SBXyCrj+c+0Zaq20vcyLN+SSe3xR1U5fx90Vy+Gr4mCCBneFaKn91E6TM3SABqJudIDei+/vfBellXdq
-1mnRvXzYfh2kixS8WYQucoYeCvpZim3B1DF9mmYABwgkj6tFM+Epe1ukLuuFgFtHbD1lvAiPRmhdChnB
-d4G8a+E7RxVrYISabVVhTV38yNsemexu/ensJQ6LSK8+d9HgzGHqQ804zV09bzBMpcPwzULztCOGVR2G
-GilUxcbCizVcfTJCD+Auth5rv88hrXomKgFNjc5zUWZIfpLnUZwUZAbJTALOfqX5OnfeJKE49wdDUSBD
-TU5Q8DyoYm6gc04anbIu0hMUTIKKzKoAJ6s8xZycoODRdM05zRDc7TIZTHmGpjw7VFLDADjc4ZKv0omM
-NZQP8hTPIN31ZDClnNPV4DFZTUn8aCzRPTaoS5Ps4sRoHVA7QiQlqxHCnNtebGJdKrIm6G4YyO+BLNMY
-LDkx7m4xny1DwCaWiNmb68LpwQL7lucd2tvqxSV/Dx4lWb7mkEt8MhAPB4hmT9NkdjEZqFw2cAfH8OEA
-FQTHNEt3k4H+NpB5qiaDeyl/iNGyIPPJvfdryh8K7gE5GVEgH9xb8IcCKlktECtmDrAozxaTPFvU4cdY
-fBs8dvAq2c1RTnO6IUXoSV1AM04yfgIt3utEUEazX3mXwpOUFPzHhHFauC8ktVaEnslvYbaPc1zwBKds
-DElAlxJTJKZvYNXui2ZX9f+zkpXvFVerkqx+aLhiVOedJ0WBdzrW8ILsrJND06JTgdZsnq5VISo/3bhd
-1dz80HG0BSRVpTa2usYyxwVesYabFuSQbbl5PcAXvlPCBq59klJfcM88hzRPHYxjvmZw7FBEHKDBPZym
-k+PBtdxLTP2gI3JJzgOZbPccpq/L2bg5fM0L5zYjhL1p5+5A52O/wWkPnhfBfResMQpia0bLyM7WLp95
-rllrNkojNxu2bBGCpdx6gI7Ro4owt37c/Cyj51msSNXFTgHPGVC7t/Ndv6qMXul/a4oDsjFZxLha0cD6
-o3jaSdU9BdmQghHHXWVX7UqdZg6VBhnDjjOZPswK4HPFoCEeu4alTT3g6eqmvP8zRS+xyrn/RC6nH+g6
-i/0pOrv9u7qDuWzvre67MX6kjH92u40R6VKFy4tfFohKKapAeG3hlD5fUwMCTyFrsGCwbGDBzhlYSpxp
-ESrXvGbWvtm6KEjG3776qdaudf0wp9GkzfzilgeGdhMxHLmaq9V2djIFfv15X6X6XTXf6IAS5ax7Uuv0
-q6YNutWlR7vwOO2LVSRcM1zP0tXYhk2Op8EIeeIG5cC6LzRwu3SOReNML0Tx+/bcNcXMMufGVUOA0n53
-rVkDylvAjBk+dDlj1qEtoUkKMJyTQhxkxjLZQfxx9zH7uPy4+sgg68HYocSW19CKclIVvHGPttbwagLK
-FCUq4cHq9P5ZVBA4rIUBsI+XwbCv/6000i4I/47jxcVEjNM9jhebDsU5jOcNBxQrFnCuLDGWmOMUBeq9
-IgY2kVIHeowcDpZXntQmNUN5aRg/PjoKGiwnX5+3sAh43fTzrHNHCWIk0KvR1+ArI0eL5dw/QQFl0Sxf
-ByMLojJ7nlT5rm0wwYlOhOhGGW+wIZ+S58y/0g2F/R+Meg07DWU99Iahqb/WHAIx19C/++dJm4hhIDg9
-OtM3MQW/kmJGMo7eMtIUI7TDfr726f+bU2xFVm3TB163Tx8JUtsWOuaMni8DyqIVWck7fBrJW/pMhZpv
-1c3JWDPSTDezNxW3MwehLZ/fHDwu5+BAzD13CgRBOwwomgjJF1IIRfLSu2ydpqPmrH4mftaPbzV70enx
-2UPbi66qTDCsOpEt+25G+Pl0xwlrm/MGUPvMNwFvgX2KeZgRHgHKgc0dC1CV8mJN3O9UQlgxXxWLVdCo
-ShF7go5NDaz+eOf5CCVzPCMnaPDlYISUloxm8PufyJSNnv48lgUoW1fNzGj6DfTZaz0h+ihPFDGmcMHU
-kyR2utbI17B84FBT/mouptxYTadxfnp0NkJxfnp8hr5E3565VSEyUxigfIMXLCoHHpxR6Jq3JN25BbIO
-jz10eTzOjf6Olpj9ss1+LWhOCr6rtQLAnE4MElOJ5NQqdSZGWj51uXRbT1oUMe31yJ1LPvfoZfhK5SNp
-x7S3f7FOPqKkXr7K/Yx03spB552sc36LPDNO2EU0ZxHL8Yycu4QJ1M7iBAI3RxvdHl0O6eLaZH06Rjv/
-X8Jh5+wTs1d1Sud1JnZqMrTKH+HMedk7nLsLmnscLgweqxOxTLzz2ce0NH4JdUPOpJGtbeHOjQtGWg9F
-G+cVTfIyXo3gtFminem2Fz0VjREIxCDesF/8tfTg1tcmEZms3o/lumy+VHA6+Xy3uvhFNktikl1DZSwg
-986uzWbXN1cmcaX2TeK6zfJOYqe9qqvTB6s144itxREGJarVAidm6qYgMMwRTpqOsP4oiCazlndG3uju
-Z4niXHkANGPGLS0snlmJ9Vz+0MraiyYtqjYxfUkExo3/IDu3jjAYywq/A5cE8MXc5XD1yb0LsoMHF2TX
-pjZOKQbTVZpk5Feckfr1ohuHXQ4iYqOYZuQndU/xvXvozibyuiD12JTKWeGIBKpV5bw6o+nZlmLGf6bZ
-2+wio9vsyVQGM73w7EgRW4PBq5Kp9GyMXss3jj0omtJ4V5UQv1xQddKvm1ULdJYXDvr05LA3culIVqxT
-8rZIQ3yhHYbDjVSa+lOWgn+NPflvliHD6GMpN/TpWBLxYs34E/YjX6VS3viexs2VcCPvuE17Mk0bcz+f
-t+6Bb0/UydT17o409jOapjhn9ZtWkpF9G4mJSmYDv9N4ZC8iL0+ocsXqwi3WX6u4ZCNey6xf2NYTnY0h
-spx9l8QyTudGyUbLzcYVJGYyX3vFOWElCy4dhp/In07QfSe33jOh9bqCpo3frauGpauuWpY/BCfwcZAy
-oAAyrDShfsKMazYKfg5fouOjo6MmF3HFd9Va0BXdJcoavU6U04ZDSoX7zKPX4MUDieQlcYGYqbXna7kJ
-eKPBuraMerpQZ0fLBSkWIRk+ROMxen6Zwy2HS4JyWD4qZ7gyYyMxC5wo+90h4x5uSYUpnNSXyG06PPS5
-57ldyuVkxVpEXI8MaxLsySn0ueUGko5mN0kO5Ez5s6SMq9RJypn3Ri13pzz9NO2GulpbDXNMXerdfae3
-90pv8xalZ792IHr2qxvPs197XZL067r9xNbt12M585ROGOBGcqqy4jQ8Y+JcqQsFnfpFnEcXtheEnb9f
-1RDnUEGct6i9PtPVdePMWwrPa7ggoDO1jMN3CdWTG3VpzYxB7X2dcARXtm8i7xVskBVlE8nMQ5Ej91C7
-5sJDY5zvReK9e20kQhcx86Idw5FjE12MEGfx9BnmpLz0QoUU/lfgS/UBwhCocfyumVpxXJuDtrq47Mlk
-RRjHq/wEceYH20ibn3EJiWh6C1rQRov/nSD75yyx43XkFReQjwD6JYrcPgxqyfpj930hVdatFjlc7QYL
-vs/ZsF8OAn+ol0E4WMHkkuWWr8ZtREvtFXsGf+WvaEUYw4u9znqQN3HRyXqEgGpYfcSKN76bSnP7WCfK
-yhUK35yL1ERWenm8wYs90nM9iWPYZvdpSJyX7YjzW2iGd0/Un9reCNxHorUZEI7j8PjBCAWMzGgWs8B3
-BQ6qbaWy9+JmEry2jmvLHN8jAeSF6XB5C3keQX/bDDoB4JtHFnQKNfpz0xzlvEok6LEBwDxoTWNurQlX
-hL3XiKg//0O5LW/zuPY6SUXJz9WNXZvElS2i7Zo6BeK6pU472lZQ+omt5U+JWSH8tID0mcrwrmcWkFoI
-pfP8wgYhcWLUJX5ZIHNaLIgBJH9bYGp3quCs7coM99dJKxrd45HEjd4LjpfWlenIPGvV3H5htL4nc1oQ
-9ePJHG6vJ1lcfdMAabJKLLd2eQUkd4jf4MlL16DrO6q/UoH5jhCrDK+cCWxgDxL1i26RWB9P3BTpz35h
-pqw6tG1kPoehL5BIa8pK8OdZ7AUGk3jVzYJ8pr2m5aPbSVFZjZ6s4pExnrdTg54ToOZDj8o5cmvYqx4i
-6HE18W6OvyD8VEwsR3ZYb8LW9pv9hAzQJR0wybjHC3I7V+MofM0YHf0BX1C67QyDa2BrO8SJPjtBwROI
-Inb4touPxnNS5ywZ3Y6QdI41vh45YuJuhcS3+YyukmyxJ5FN+j4xlb9ixq9Fofm/oPb+g24S/5m33ZXf
-YF08dK4XcXB0HkrUNmyqZ9DHjygIhuqqr2DUzGpk2Mtga3c1oZIcIQZs0giMYgr5+BSNPp4djHXk8kdL
-5VHnJ8ZNGjqExh0qvZE3OdpOn4Yyy+ECK5j2SU24sicMyapb9EgWO2JTlEBw0pQQbFCQn05qwpXPow/a
-LHtpFDg0LkI8OjElJxtECkcnDdnJSHMKk9wqpmSlk4YwVR8mJyNvqDtrYi7Odg3BVcy7Sko1fpUSVvVI
-yqHVbylyVr/1PPaIhdoZj8jcpHJ12BbnJf7/yTu63rZx5Pv9CpYoKhvxR9qHe8jZLtoU7S5u0Vtsu09x
-ESgSYwm2JUGSk82l+e+HGZISKX5IdpvDAeeH7kbkDIfDITkccmayDRuSBzBOKziwXObZbVruTd9/Xc8M
-MyM1TWfKW2K4do1QcoOrWB1MeGd+/OzrKiTC6Mi9OkdZnrFxcMFfpHTHnniPv6yqv7Rb6fOmSBi8cCrE
-+Ufb8CJFwW2DBzdyDAPYG1uXZXELKkR+EKCcEC20OkUGocAJpMRklvNpEDDOtha4mXyDgGFqtrByog4C
-5dO4BRYr2FBwsXC18PJY6EAwIMSx+uYAJ37vUuGZ4tYp4qwnTsdDwj1D1WBi6yN/ateS380VT05fen7q
-rDXRy/dIG1b7h2bHwlJ/M2Q80MS17z7N4vxe8mIUXCJgjemUeYcxINopr/xOX92R+vbxz4Q8PvsCeTqr
-RcQET7gd6QUuD+/Wg7v0d7YlsVdse45QS9H2U5lj4LGrjmlOhE3TjXOKNU6Uj7XAZJ24TMQVlgx+YbTF
-0GSmCoVpFCsoVCk09c8oYfFhxxxYgMAwi2Me4UwJgUb0MGjEF0Aq2iIxPHiUDnN8RLOGHZjSK9p+EeFO
-QLMTL8S2GJf6M2NxRd5F2yy/37F4g0HWLDdMHArfDl3uQrz04p9eFmEJ5+q2yAV8U+ehAQYfXQD8IbIB
-wj+7gGwPFTscsb5XdLq5GW8WfU8W5Q9tTzrkq1cCF5e5q/Tb7DJJd3HJMiWXmT/ipTXAoLN6S/7LmzIP
-4yis6hHNs38VLKPm+0f19wTnfXuxJViQk9O7NNpakvc4fXGIMUgjqOnmsSXlj2C7mLGzKGHR9tf4Lzh3
-ttkiPFxDAy0AKbNFDJkD9beZqO/mpxoKhPtKpxl0beIi1zM2aGjFqwPpdX0qJu1Gi99RkJQsloDee52l
-DgE+c/eMp/qL8qxOs758tf7w5d1J1I5V7ygcE4XcJUbYV3sTHORQxJbw3MQ3T3gwKm2iiL44Paksd5Eq
-Y4bcSZLTmelj2Qn95yBeJV0HiMLscpdXTNnW3NlsG5CPeCo5AibMHpTaFjcP+ftZwwEoNt1Vx7XHEKkg
-b2Y90iJ//dPPPfVsHPGnngbaNjNuJoeFeWN7BG0UoOrrzSNLfGLgGSR//zi1RzzTNkgxxOskWo7YXveH
-XZ0OdO+SPyWop+26hNgidirCqKro+MXHHS6cqNQOFNDTE4/h8GFTjXtaT1vABW4J78Cd0nyXZxyl1O8m
-2sKe7nr50NKG1QfR5lLXHN91nbpigH5EuTRNoX2Kqbpdzq6tLaMI60QC2qLaE5vlg4KM0gn6Bh6zU7QB
-HPu3itbpLJgLOFvEdPL/I/IYqXmpeGJaXD3/9ybDEKqfbZoIQ8OhtKSa7wYuPcIaAvsMkw+X3H4mNi8T
-q+nDG5aa2xJ/ml0BbUUPXBfGSle0rj6VBbXsJ2Jz5F5GX6QDhgrFvTLcsBmPQyUvftRhdqrT4gJJhUGG
-uwBO20PtrtIt1X6JOtZPWv4sSyO+Yc3DmMXvuTOfQ/EQxsB8s9kdo2xXCb6fUOweQ2we+FCrpar/EMKl
-VPSA7rin6mw28+Sc0jrtV0Yd0bZF4G6bdJn2VvV3xAWe+sNMA5xefOdpa/gbMsF/RLawzPDgvDGdN9Wf
-xaCsdXBgQv8eqkRiQjGczTqFTLjAFDGaT59J5A8boV7m2SjgRi9teWW9Ab6XS77O9csun1M/GF4bMf37
-vvJb6lUSXzhLibLQOHQeb/K1uyaM63x0NXl8Go2/jecb2KZerw9vzs9vbDm53PYNlIiv+SFK8GAkbhks
-hc4Hg3YNjcOawdt9KdTv9GjxMvyAq2H3UPHGhfOASc+V+cntTED09ZPH2RBO3K1NU/08IBWVCoX+0nKb
-s6C78jRhJ9eUHxXFHzzOAyrf3Nf7bUDOrGTyK2+XZqfRBRXHLjSvbst8/0EmkutBhS4WMPDyMou2+ebo
-2N/GV5ll7ug2MBkdtXmPoeUHzxuOk7lvAuC11VD5FxYpPF1uyRmhS1iFuxGfiUP0m0PHsQIoBYLn6RPb
-wDWi8h5FlLdVg8IEDtkZu3X6HGM8ivznvONVIHkxNJeSS3PPcqmz/01Q4kljE22fhYYw2g4lAe1wz0JE
-BJiHkvFRPkT5+XTwNy1DCfn9UG6ehx8FYD6CHxF7vrG5bdCbBA1x8UGP+nzLst/Sqm5dffo87E2IkXDi
-CQ96ChZo4BqfiizxxUg70TE5FkLBCgH/7ZQBKjirHuqkUxKzHetcVyShmSXumuffkode+gHArGea65YY
-gXxEuSsd9LR6C9hxlbYsktiy5W1InbCs5564S6AtVyRW6b6zIvyGuPds0GlA8R4TLMT+Nc5jnheBnREA
-grwn2G7XfnMdJ1XWw+lQ5Tsdd7lYsspq/rvGkhmeAJsdWjEm5M7duM63qJv9WSmaMeqiaokrPmy+nf3O
-yn1aVTJLayO6asHHvER0f+Q7l1VCFouw3woe+KohIN+/kxEFcey0LzWCM0KJ8tma6tTyjbfJWU+WpOGo
-q6ZTcH9MNLljIyfjBNEsWLmHtUm3JpkCMJ//8u7ynxdyCYblk/DorHibKJLizaTeN63qMixIElbkJoxJ
-WKRYDZrU0MKCB8sWXcTpnUiyuKYC25qSOrzBPKLLNZ2+XtPVOpOQGkBYlvn9mq4W8zi9c1USWKciRR9U
-P+xWncHA2Kis3J8knbZ7VUQ2JGwTLOxY2XqDCjXygmXIq6ou82zTJV1WQw0E683dFRPMP7vYpSuYGYj5
-jBTkTECfATQU+p4jChzzw04wnv9rDTZJEv/yKNdr3oJly5y9TDMRAO+qsT5TGJwvrLxLIyZtsjJ8jYlC
-i0MDukfUbEtBLasHMnpy6757YUM26VR6V10QGtUiknKbQZSuM11U8YU0/juNw2yDYp5tplWS3y/XNKrF
-LF/T1eNj89fTkxTtRfJm9Y4HckCqqsU8eSNEflHjqiCb4n/hvzAf04LF+hSqExbG2odS+UtUWf36YTGv
-E0vBnxUrHUUfWBWVKcb9d9RQZpSjBuwmmEvEUd75vJhr1ENpt3c3efygd5cA60tWsLAGhuVbkmYkquWy
-/p3kZczK9w8XwVRuboHGQ44nXj0+wprwS1gl5Dv3aP2aX5C/w7jVsbM+cLCnisJJZ039C34NeSJaKYfL
-NUVpkss9LvFPT2tKTFgE5Gn70zwDkd1Pb3dpMf3LXb0u082Glcs1TfjK7UJ7qPMpLjHLNX29puSmmop1
-WfRX7urQ2RB61umunQOLqggzGMv0lo9jo4vMHlhYjsZkRd6cn5/DllJNedgvvR7uHoBlRfqRLZYC2+oz
-u4NJgIAu2kIxI4MmezDOe7LZPRRJGuVZ+3/TugyrJIDG8TXiMohqqWNLARsHK2BNpzVT/BVpX8xxFZB/
-GQQVZboPy4eAp9QNhEI5z9h9IFgrAGwkF7tDFcxX5LJkYc0wZgTC8/Gj5JjT1Wd2j6va4MOVCfBfPVth
-R2WgDPh/9dyBNZKwep/WupX8JjW9HcW2hWXklapdCjX2heaA391DK1ZDNaOZCcEnp8aBY3TdqH9iFR5z
-d/4WHMqcHl78Iau4Kf0aoq2h5wkB4JPvq5yXfsfFhdcwIsGz95x/nMUvjKAFZhs+7XjDamPwHANn5WjJ
-4kPEFJ5Wh/2EqBlGqsOenJFRIbvxlhS8CxfknGdgP7fThw6o7LYScjn7xAWgMgSw0E5cAKJqs53KJaCQ
-1RCfqpaZk21WlHmd1w8Fm0V8+rtY1TP9WkkX5ZL3Rn/acxQuOMYxWTkhoyOMdkSeKK0NOi73nN14Me96
-/FUsBs1RsL2VovPNhFD1aGk59XlOeWhBwMFpz3httMd/9I8ON8W4rRBCHC0WG6OHva3aNPVA0dQDXVM3
-MXgUdZqJ2tSmp5uohqnpaCikraEQ5eMzu0czIUX74H8CAAD//6VeZf7PLQIA
+H4sIAAAAAAAC/+z9fXvbtpIwDv9951NMeHJCqpYpO216eqwofdKkL9lterpJenbP7fj2QiIksaZIhYRk
+6yT+7s81A5AESYCkbKeb3d/6utqI5GAweBsM5g2j0QiepHzOUx7POKyZWE6cVbLisfADX2QOjJ7eawE6
+DDYpE2ESH86TdMUqhV7zdcozHosMWAxsI5Ygkgse39uyFN7iL5iAN9/EM0QA3gA+3AMAKN4QTPEa/8Qy
+zPwXPJul4ZpAJuA44+rn10nEYQJHtde/ZTzVwK/p/ykXm1RVNL53PfAG43uj0YoLFjDBgE2TjQAGWRgv
+Ig4pYk5SWPN0FWZZmMimfBeKV1ywjsYoqOJDhQD1sSSBRRFVl43KyjKYJylMk2wj68WGvuDzrKPiHMxc
+c/61rNow1ixebCKWdswIBXWYJhvBe8JmLA5F+M8u8GmSiEykbN0B9/v7DU93HUDBlx0AmzjgaTZLUt65
+BgIeZTnIlqnRebZewwTyTlslwSbinpt/codweg8AwI0Xr7Gr3KF8JIDnSSzSJIp4muXvV4tZypkfL95g
+D5jf+iJJIhEWX+PFG9Wz+ZtN6LMZL7/PonA9TVgauMN7Z4PxvZw8f5bE83DhnboPaCB/TZNtGPDUHYL7
+IEpmtNorL5dCrLUX5VSsIhhCo/gQKoX1ld6A9ZdiFT1+lQTcK6Hwj8dsGvHgBES64cPKp5S/34Qp/45l
+/ATmLMp48fl6UPKHKp1+BcXlkseeO3KHUK1VhCLiJ+C+YNky78bKd75aR0zw39LoBNw1S0XIomwU5ODU
+nFqZWTH2OuLnIo1cjW4jfaHgq8xK5Ev5tQ+BhKiTOELYizB+tU6tdH1/hTsE8rZ+xCGyTtoQaT/S0jRJ
+7Z32vfrcizCC7SYNwXrRtkjZemkl7Uf5tQ9lhKiTMELYi7BlkgkrXT8lmYC/h/yyH22Iq5M0xEmU1Zd2
+lLDgb/EbztLZsrm6jcRnYYTc20r/m/x7H+oVss4GKKS9elfyXit9z+mzkrj6USkxdhIpMd9VP7OZJNDS
+jGez/vRLVJ30S5T9ZnCYiSTd2amLeCrgpxyq10SWwN1zWcL1InO9ES2bjmDwfSz60rfedK+zXzeiF10s
+jhPB2sdXA+k1xgV89zgXoP22xXgWBjy29+TLAqDX5qigu/dHBdiLSDoUZS2rZcazTJ5RbNvRCThPCM1h
+FGbi6ZOR9uD0q38U80srDb/wS9DpaCEj5peHhPHpk1H5205EIpY8vQyzuliX8iBM+Uy8TU7AHblmwU2X
+Hv0wFjyd8bXArXi9yZbaqejB+wHU8dP5p/oS/1yUGnkm3BNNlJVsdGAAV2NPTJYzPDicuv9x+CqMw3Wa
+zMOIp+4ZTMBF6dQdG4srUiSWJsh15c31uNEV1xX5Pd3EKLzn8jOJ6GmSiDezZM2r8nkOM4QSoiKEF2/9
+B0nsqRPB8yWLF/zNhuZDBSHf8lgMYbZJU/qxTvk2TDZZvd80vDTDYJKX8R/IOuT7sa1UtkxSEYXxBUzk
+jmTplOJIph2rbEezyskLTos+1d775SL33O/oI+2YcOo+yFT3qk4qTkb0431jFFzUh7zh6TYs5A1tYAjZ
+UB1M1ClpCA/e6wM1hGclisqoZZ9gxCTOTCRrD2fyYGxekBIMN+0th4lW0baOMZyDd18NtqretLzU2og3
+UTS+Z18ViK2KzEe5hQdvS04Ok4nGy104gC0cgCuZeUvdH0C2R54w9QXYpMNI7nWjg8I4FJXuybgQYbyw
+9jvb8u/lORcmkAP7b8rXY1MxtbGaij6rfjIWf78JudAL/Ru+MIJueZpJfVwB/Hf5ygierHkssmBaElbF
+479ivycp3J/AETx82PgYxuqjEbnczwxtfqt/MHfYRixhoi+sKpj2wX8Zh8Ir+3MjlgrzsKwRlY4xW3Ht
+FSrcMu3511K/N2ibMr9nSVyZMlvLjvYvb/72i5+JNIwX4XznbYc0G4fgAritNUxFwnrVwONZEvDfXr98
+nqzWScxj4WFZbztoxS+L3bSGbSvulL8/n6fJ6nxVwb+q48cNIYUJxPwSXst93huMGyDvFci/oTrRox2m
+BvXeX3GRhjOYwKr6JfVRCRlyJYO8H4xNLUzbWrNmMY+eRyzLqkxCMLHJiEnPwysTQ5VfYDKZwDYJAzga
+wAfIX4JDeA+dcY1pZZehmC1z/CZOOGMZB2eWhiKcscg5yVuhUB+AE+AOkzpjS9FNfBEnl7GpZBjPE2u5
+S5bGYbwwlcs/2YrGaJAw0prJjdBakrQ5ezUy4HO2iYSpiPzi2DaN5uBvWbThOPAfmt8yLv6V7yqT4oLv
+hkBlTBOCPtB8QKX2PIx5YBrfgEdc8CoFpxd8d9a21/Eo4wZcTSQwkQT274OFsZ0WZtFGtYYa13U2W3IU
++X4II8FTAxeZpzxbVuqdE6iJj9C29d4P0ChQZyLVihBhrcbKLh2umOAmPrSGiRT+sEfw8L0ORyziqci+
+lQgnKMYYuKX8Ch8/guMMBo0x8tUS0E5IaPAa2IczbxBMACHHNjgRrjiLg4CJHNR/G674szh4wQQ3rBw/
+5VkSbRvNvzZQTQtTo5mnqXE2+yn/nc8Efa9jrT6v/XkYsyjaeZqEa+bYgb9Ok1VYOWvUZtc/YQJfHlVf
+Jmm4gAn85aj2PgoXSwETcP/01ZQ9Cv7qVj8HLL2gr8fzx4/++nXt64oEG/dPXz7+mk8bHzcRIs7+CSOq
+vfp1ukgZFn70GL4g0OrnWZjOImI/p5VOOD1+fDQE+h+SdlZVAJw+bv1KHwiEWm0sbPx8VuvgLXZl8KWf
+8QjH1/1TlCwStzpTfLZe8zjw3Gy7aHwSIvXcJcdq3CFk/zR+vwwDsZSfy/qz7UJV+yyKPDflM+FPGxXg
+hPdOT8umwCmNwyPVMWc1eB4LZB7mBmAd5hbMUDbAU+R04XY1oaMHkDgjTHrlDuVsMX/etX6eh1FUOWni
+vlMspdPjszFcGwvuWkodUSnrmKBR1g9CtkriwDww+UTcaxgQrbmXgwatDQ6e4Tp9BcimA7Xg8NBJL44f
+HzXWYF7uEpfokfl7BgcTcCEiJJcFussWqMO9wdTP/+samWGmn/9bxn/FzdMjE2lyQXqQy2UouNsCdJjP
+5eOcY3WsSiNGbfy/vMkk6LEWW1qSN8F/3L4c3eOjoz+7bR3aVsuVfenk86ht+UjWb+w4+Snbp8PM2FSX
+XbUuco1YY+ldK2PpKI3W6K8f+cVcugHzemRlXjeZ1o8M0xo5gEhZnIVY/wtl8UMx4nFNjFDC4/NkE4uq
+11NVuqzItHVGpSM5OBg3ThHVSiZwbJS6kmdmQdYo6BfEaMVMGkK9aruOru2YguLCcjOfR7yYxlXwPsug
+sRQqs2MIoTZBwrFReC3H0zPhVmPsNYfdAH2rZXT7pbTvUqn1Nxd4JEg2wisGf2iY7lqx68ZBWCTrypRm
+UWSaPyyKatoQeqOMBgYlsgFPfYVAQ/FoOBc3ls1Tqr4V8eFhj4VDQgWaP2ACDzz3T4UpxB2gfNToKPzM
+49blXz9hqjLuYI9jYjiX3/zQqGDAP0LrK40EKGCzRcxi6kH9u7mANqfs7WwSk3HxhlZ+mMSvUbnjHQ1z
+yvyIxwuxHJgrvB50GuqaCsvCJoW6bzwP/0BOszAB9x//+Mc/Rq9ejV68OPzpp5PV6iTL3PG93BNXKpEK
+6GrxAgzNeWjO4qVpPuURQ9sFds6J1h3zjdikaK8NY/hz5pQnrjXLxAk4f84O2SLR3mf4MtAhV/Rmpb9p
+vlrSm6X+pvkqoDeB/qb56hW9ifU3zVc7erPT3+Sv5ADcw1EpZki6idAw5LGLIaD2GHspnzR0dl/z+LuU
+zXDusQs/jAN+9be553xwBuMCaBYlGTdBXetQpLX5ha24BMo200ykONuKOjTg3J6uw4bxwitg8fAw1GrW
+ym7SCOcTLWRs37cuHBS94RIZNqVRQeNAL/IQe8ZWJO81X7p/e4Nq0bwh54s02axtSHIo1Qq1eWzSaHzv
+uhwsaR7/nzRcoxEg5z0ZjcjQrHy1vpVjtGTrNLna+RlPtzz1g+QyRt2aH+9oQHD5Tx4dHX99ePSXw+Oj
+h3l/TB4d//nLZ0dfNuaDQn4ns4Eq7zkjHORsh69eHb544QyaqIjmvqiIMzqDjnmSctpQk4uQe9L4RnsO
+MvZdps8XfrUOU66OsnIDKwGgUMQJrqxBL2rCLX7y1c7j0cNCPQzgQGKDL+DRV/AFfH2U/+/46OhIt5Mp
+ImACzjh/mDhwILGL5MdXb9/I6TTQIxdqyncNSyXAIUhmG9obZtQfMAGezdhadgxS6VBd6qUyIxwU6A6Q
+KPJ1HzmVTk45C7Qu1nsVn7//N2NN2ipkMKkT52frKBSeO87NlPMkRQtGCiGJXBDCE5gxtS+PITw4qA/W
+DCYwY6ehJgddLsOIgzfzZ0uWPhPe0YAEQhdqEj4V1RYvLtimCICzZFbwDNlUifBoYNKTbGLVCzpqWUwh
+16oZGEJTpCeB1vM8ZRk3dL1h2jvOEA6PB5XiCy7+dhnjsR0+6PVoA+pKn8vDBOHcavEsL26tulp6CJIU
+mvV1Qt4sk8vSoS9rJakEO8yWyWWTrDqyHc8s9NVRDWHHM43E0Yh2l5OcOWeCzS6SLU/nUXLpz5LViI2O
+Hz/6+i9/efzV6Juvv3r05del95U0vKC+CL0Vqv5WtfaVH8jpXp/L6ugrvZTC7Fmasp2CshjBTs9MpyX1
+kUr6WRTOuDfwFWkFPxmTUEQbWbiqiaSScb/NRdL/60rAsqM7go9KQI8Nccx18hlMgKGN6sN1LVjrZYCf
+8B+yYNW+vuJZxha03Ra/TXBvBEvFC7Uxa08m2O/joIDMf5vgnuNcCpNYhZOx6gtTCfI8Akb/GqlMNulM
+kSh/IhRNqMNNWIcmF3uEpR8mfLQ+CUT++vgR7i+4QD8abfEb28YEXyTpTrYrf9Ag5cQqxxQtYiIRu3Wx
+Df729rn1qEmbQ3IJE5DnFW/gb8TMG+Tbezn7Bi0DGSeX1qErvl2POyndh8xPRqBanyWdrWF4R4c0K/J4
+s1bvxCIKpuacWLgk1vxDpcuiwQVRuR5WXEU3gtd7LKP4AJiUkL585dXUmso9r3wp0l2NpSkQmAATydST
+eHwM/jGqhGaMvFf4wIrGdU3liMlaXA8brXBVIBM5Orlsu/Deew7bLk5S9HtOMn+23nzAgJbJFzQuX1w7
+Q3Aer/D/KPc+hW+O3IHRstyi5lLCp+qBQDElvTma2b0EpCczIDajdLdtfE43MTrVtEAINtUqYlOqJ+XZ
+JhKZa9M+e3WnbX+dZLm6CUmqnEvwzw1azhha7wzq5R6KcNVVEEEGhddrTUnfT+eV9xc1PFdnvaanzOJM
+SU5hOei/ycexHek5cqvCh2K35k29fPGJXFszwui2OXFsF+e6foBTdBi5wtK8LhYDusVm28W3cXJJXfkK
+LavzKElSDzmZHyeX3gBGucxkqY6wwwRE8nzJUuHpfTTo8uatti3erKY8NbYtF5PmSfo9my0rtbSaZysM
+Xx713Q8WF/1GJf6PqNHQ8Qu22A5BsMVFm94Rm4aVKZkfnpqNKfofgpORdmghrtmBpuJIGQ4sDSgS21+x
+WaHi2kJF4KtOxH/G9/rgzR1PWdq6gq6Ni6TgVBXuPrjX5TGUpJa1TN9gAvTv+OZ15h5FLQp3zZu+3ZM+
+46Jddd+6T2k8v771NAsiN3WHlZ3n40c6f3YWRX5aFs13H1NR6aIvgwo9s0dv0dicbaQ8M3lW5NzltOak
+WF+qadNYF1yZ1pzS9jADTjML+LtUK5W4tywagshsC5pmODkFnx4gb9qy6GzQb6UUHFGxDVI4WKoxmUTN
+LKKL890J19uT43Vyu+t7t+Rypv5t5W5SvlW7t5lyjMg8oQEeGr+jHvWEammS06yQpvZpGFydwUTV3O7G
+KIdclmsRJy/4DvXIFY7ygEKATLZT+cXPluGcvHExEEO+uuC75+TKP4HjL9vkDS46nQGuz2zHHYr273PW
+0YJ/P6fTTs4OpRiXS8wVQU6BxIkI57uGgVN9XWWLv7MoDKzfC3WI4zQ+brEkE/xVtmjfSBp1efcr1JHK
+wKtVeR/rNLNxnEGqzQbXbezEC77Lyo654Luxyf2jqgPTMJrmnUJ5WoK1erA3d7ILjh4Rpp1Ld8AvlJxY
+nzukao1HU4OLfLkUM5hU/d2raFvdHzbTVSj6DKg2/t7A0MH1cdcGeUMqrok8v97E80bbUJvFUbQ/0ddI
+k3Eq3d5JbaI3Af+V77ITvWebIL/QJD6prrh7LXy4cUwtkhrQcfBWLvXFtNukESaXuZnre2J0fieznfo8
+7uWQcGtdUy0o/zPkwWFQMhrd54S2uQKkjDQMA8XaOjxlSlsCHaKL0ncRccF0HTuaHzVdOgkaUAvEtRxn
+HGf/2dVBWoF6zsKIByASWHABGsWXoVhCiF4ceregFXAoz1fySzOU5HpPPtrWS4NxewlNae2Zua19uGW0
+0ehv0kZ2Cw0S2cmyRlhNI81BKxm5sv5WlMwkklJJdVNq0DRxK0pQj9pBhL4PVqxRnTuiJdyqOTtqph4t
+RNj/kYs8tLeBp7Fv6AlTGrV8zpyi0dEqg4DVE08By2jCEriWoeHWW5ydC0lLr8aIJJshSN+g12lpYU+i
+u5U9YFf4WHblRlfezQSniSkRtm5f5Vv/5Z3sZD3mV33KtM6vHkPVtjq8sjsHPUpZdolPOItlZ3Rtp5Vh
+auysfab8Ddfpp5jyvQVR8j4oszB0uR+UkLX0p9oXzTb8sp6Xg+lpHTZFNodUJnHAF5i/IWvkqCUAmEjA
+mjk4RwOTAqMBgvAqEPpdg9EogwloTzW4WcRZTEknvJof032tkOl8jl5Zf2cRTHRPHEcdUZEsx3CgVIVM
+M6LeeAVq1Q9VLPnG4fqJZWX6jMq4VUak8CM7J0eyIZwzmGjjpOfgGCPQEzgv3czOa35moMVmn7PT8/Cs
+2QtrX/qOTiCzH50R7LtQZPCwNuoDQ26Ta7t3T8krWztLa+QPSVrprWkoGu5H+A4bQLqQWhvktxrVJgGa
++klNZF2n/l8xIqqr623N/4hMqZ2XozfoPQDrsvmtI4Cr8PPveuIV/Ts9ben0VHb6ZGLtddWDKfV47w4v
+0yu19vePXLxWfNi8V+ULqGh8D6S/lQysRLppOO81ePTDh1AqM1/IVBzepqFZa+wQ1U6puDJWePEQNkP4
+69GgxQ2wgrtf/xlba+vCPVCXO1c32sbe1oq53O9aPbzOEbWa+GWXjUaYtoUFqzCmrRvEkglYsgz4lUiZ
+XH2zJE15tk7iAG3BIlHBNFpa+MzXMM7YJuMZFoUVoxz4sEhglrJ/7oDFARSepaAVwjxsPBYZcJaF0Q5g
+xS5kbZjCSpK1SFksQGXW0YnIQCRJScK5V1/dA5+jOa/sHfxmWtwrll00w93OvXMzq27gXdtCxUp+jEjo
+t9z1bKZBouTjBGS5fWKv8K+oBCaEyZAusOqQpycAkx55hYdvJt97jpbBz6km40NRNgtX64jDLPc9ApEA
+uhsDgyf5QjkM4/VGPFWDTLJtvuBe4pdS4doh51pKkfjakE2ZTHKG/+hunRYc/oMwxmQquI1UkhaeVXrM
+UrrRe0V/eM5GL+LkEWt6HlUL0mEN8BkGp82Eivoqk5G6T6h/AfnCxBH8SjgUrD9x0KHzUCFwAOLFYRBm
+xGEmzkxIHY9iOd7Awe+U6r/8mFNWfjtM6EaKbOJ8gAUXgqdv6P951kDnqXvvuuchp1Xbrqdq3kfT7j4Q
+MkKSfmez/bTvQ8iLkxLrVtr4PISo1MkXb8xOi3kgULXAW7uXo0iq+NWzDbiKWz2bgSnJ7JZFGUzgQBUo
+3338CI8bRYIyfD8vUbxSbjI2P8yfGGWjUqX0lx0FZcbPoFE2f//xY/20r8rLMLFzXCwwAffnhNEuJ1/7
+vt/sERk6z4NzsjyVNcpHcy/yFQujElQ+WkanEkJYlqm9t4yW4CQAr1macbq0wGSg38+jdcbfLrn0mZkt
+02TFjTCvZKpDmVrW6IbL4uBFOJ839S7GkfyJR9h45+2Sg/qiBoXEkynnMcwkqA9vUbZZcRZnsEs2wFIO
+YQwyex0kcxI5LtMQE1BClqx4EnMysbiZwpH58DaBbcgvQSx5/pKCH+mFi5lH4UXIomSx4S7JMVjTZRhF
+kHEODDZxOA95AEE4nyNFHJI42sEl2+XmojQMkBjEJ5VglJ4QwgwBqCpG+rowzgSLZzwHxqhW4EEokpQq
+niXrHdaeFnSGsUggFD78Q7U+E0gYCWhCSCUbplQlvVqyERAkJFwtw2wI043AamJq0GqTCZhy2PJ0BzOW
+8vkmgjih3TzvRQ4s3hm60Kk6vivPa82b3eDzNt2EUUAZ6H9IkxU68ZvTYmDxQS+TPFYd88tnWkCrI3gm
+nCZYGFzBBI6rH1AkjgM5oO83XNqXm55MKsxOX3enDsE6Z2W8nEYHiXuHx7jYqoXyhd1eziQnGpsJB9gu
+g0ddcFVPddL0XsjZ4Lv4XZzTBS4cVKs6ABc+vIvrLuz45/6fbDNV4tOHD/7PLBMYLCI22fX1Cb4hLHT6
+vb6GJMZX5GNHNrDraxvWaRLsYAL/+WT9VLqW1VDZyj1ZP33LFtmJ9btAoeep7fP/+fAhxckNDy6G8GAL
+JxOQ5Npr/D//54lInz4RwdMPHx5cXF8/GYkgf9zmjyORttXJ46ClSSNJ839aAK5x8NzmbOfF5TN5oEkt
+wgSKTH0xHSbLAipS1XkXOwN/xdaaaB5p6U8iX6Thyhs0U6AQylP6f+4WeQjHZzCRSVbxXziwQVVRVZoh
+YX9PwhiJAwCo62hpRqOjo1zH+81lLdDdVNBWzIUDnUw75HVjoAxSCTp58itzGur2/Z4ARa80IXhsj10B
+2QzF7ny32cQijIDNBU/z4weEGWzWARO4775AsR5C4duzaCK6t4mnWOOw0oeDPq4QBd16G43ae+yyt5KB
+NbuxOc1TDhMY/b932Rcy9P9jPtofyVkqlFL9xyhJLjbrjys2S5PBu+zAO313+e7wnf/uwdnB4F32xbsP
+o8VqbNAkiNmy+TofsA8176vqBmLwyG5sFnYYSXEbhN7ANjhqtBFAbX/USjKY+PyKz7xyEAY2j3PlHkol
+T+uLG6pO2hLokQUoCjMBE0Uroj0z+5HfR0CbnkUhMTnAlx1ByIndZKKPnznCSdV63KZYh1rEch+XHJXW
+YsmyJbl85x6iS3VIct3BrXxiKsehprtSb75jEuzs7pi1M5XmmFYVrFTigDYP7NrhzIinkjiqOSafG880
+tq2NeXYEBbWMv3KfxfRQeaSfQ7e4wZyL2bI8hZn82QwOSymnKdLHjaN9TtUygsnONh1Ef6ZrMCoVnkvo
+er3yLZpz6tg0jAWQCUa+8+fJbNNYAOobpn2QcoA38DMyMPx7ytZ0r6PBS0mVSmLPmUYbtHL08jp4wNbr
+Tg+FvdavSat8bR+PbBcLdmXz2EcdQrJYRPyncLHME0HbiSVXekJoaoalY6lHDY0oKLN4zXS7dVva1kVL
+k3LUfZ6Ay2Z8hCrUig+S1KA0/bi3J1BGoO7hSJVzkUpHk9s56KlMKmNIO8lrvuBXxY0Ri++v1p7z/969
+y77A9Y4I4ACcd++yA3xWeVcWjnka40na09AODcM5ZbOLS5YGmbprr9kFlylbm247BXWBwRtOiRS33I5h
+mUT835M0sEKk1FJZS6tlBeemUFG6ikF3bke0DbaLAvYBfKmUrZWBbCYdLHILLbj4PuL487vdy0DGKh66
+pBUYKKQvY5HgrZUWl1C02hTp4bnITsPgrH2uLZPLCnkZb8hbGRc5nBtJJWtVuwq9LghozfaW/7Xn+TLv
+piY0rZngsEGUkM3qDrtJo6FBrrqVh+E6TWYqZljCGBgeF3lYcR5l/AZH8ejMEm18h059Bv+7T+JRB+Ud
+Hl4+s/byM11w8bpicmjfje4376W6WYhQ2WQRzi7MzTZK/COlBD9HQd+QrHfPeQRG00sh87mWWNRCMkbq
+VYZyS04EqIabaLV0pQugKYuAqPncrzi0GIbsyVTbhq/9oGd/a7RrFS0b39uHcovkcm2ZBX2DWzrmgJL7
+F/LiLoMRZmmLaTHLjt4eC9IzZgYwboUmSQb5dpHPiJIu1S2xedb/msHVsOOIxIhJJCY8IjFgIQaCNfhh
+RkGHMh3VfZGUL24RcKhsaogff3siMdWPn25eScUKXLcMN2srwZ/Ao5vVSs0aTYi7UCrJsfE+IkrUwqaZ
+Rz/SZBMHnixa0jww9EcATyxZ3ZsWIWM6Ds3IHbTKRlxo6ez/d9r+odNW90SojZthSuTAlpnRXaXBZaJl
+fhb1ybRCMIKvj9ovFNROE7Vc8CTBmnOu1BVi9G9HgpNC82TSNI1G8EygHlqASICMpf+pGUrmSfKfEMaQ
+pAGnaZhxAZs1vN+Eswv4fbNaw5SLS87jMo8wiwNZ1b5nUiqUH0bpwXQa1Y1aTXlcN27ZrgWdXfzLZrV+
+y9IFFzAx3M5qSt2qG7Ia2Vv1mac10hc8E540hIVnA9u2XVT3O0wgxHTLY/i9UeXvBwc2BGogn0dJxmGK
+OZq5ACYgEywVkMwJk3Kp4DH5LVD3+q2SG1lT3l2PVnozfrc343aCV8E2cVXltpx8Tr3LvpigaUe31oxW
+0kpR0DVubQ3h7SltNudIYaohIYlwGc0d+wqX1701CAVNnRvPmqVCWxy1xuQLBEzxL1RUzTiU1x/dinUW
+um9Ce3p0NpS0nR6f2erGiz8mWnc7mvWgfsa3njENw0dF9PizKrI9FDlc5Jcy/6hcusrRIGcusyeytKwR
+gE9P3uiD538xuB4ZuoIAWhrY8Coz29+sN3DgkjmXFlNc2ifeu+BgMLJmX+lx/YYWMVww40y4d64xyU+U
+KrNH+znIZP4gUcfpOA/3u4SjpRZLBddDeGQ+Xzc5g+V+0vaKzae3YhYqCDUB1SxoOe3X89esBrgcvZWe
+DKuth3KFeiKSn8OYe6vmyu/NGu9Ar2XoMFcei/Mv8eKEJGmj5qtNmMPZ3scslqvWXLcjRV9Tv6FA1MXB
+Bit3Mx9QftIo16HuqtwrOV5+KKmieBuu+qOQp5QSQenO3LN4tf7SwblX8UJ+d4egriKoS/aD3shyKb+B
+K//QHxV5DZetKpyI+/VJZQPQ+qbhVtwLnaYwKnFpL/siUEquBg71frCvfeCzP0KbmI0iWiRdOWI7z95E
+MqLrxFSlCpl0J/KCzGq3DG5/1K+omAwnc0JgCZbST9vHva4pK87kUrnwRLunpAP/oxtcD76H6uz6MzZ3
+0eiZLj0qi4vEVlgkHUWLDrFh0DivqTzxwo4eIBgL5b2ubTJyzD/W/KdZZXXbnj2rBw72+TLMhLzmgEqQ
+Aukn+a4l82qbraXJz5dyJyhL9pFYO6yZn7tlspS92jOy7J8G55+X1WjereUWlC06WEVsxr2Rdzr8cO0N
+zgajBca7Hb/bPDo6mrqt1aAhHrctlOt/pWAUvVIei3Q3hK3JILr1gyTmebQU7h5b3zoUPZS1xTg1VWvV
+qox9TTeYXcAEiORmas1el6XpwN2XplWq7nt5ml7oppeo3fH2YLz1rN+WoMkAW+kK0bVB3OR2vD+Is279
+MnBF8lX5aHA99lUwCoaF+iLdZOJZ9pNYRZJtfpcEu7vkY9tPw8Lqq6p5erzuSFfeYN4WA0SPblWg+/Zr
+mbqdwQT+5c3ffvHlIgrnO1noBWWDQ54yBBfANSPIO5iK0PE+6zhIE+S/y8es7T6T5DJGJitjmDv1sNMo
+mSozx3dRMvVOm5P8bAgfyA3vBCjCe7SOWBiP8bK3jIvJRswPv3GaVxCzLX+WeYh/qO5aIqROezK2cD7v
+QblFnzfC4q7J5c+RSJ0TwyJuuuY5Ki2uU8+L27kS9par1EkFoegaqF8SwJBVg25uNILXPOOicItA+QdC
+CthMOYQZxAkdfGTY+7d3LtcoUp0fiixqOM+oSi0h2j4yB86RHqN9p+5QGp5SFfqGbcN4MYZfI84yDv/O
+wnrwpm3GIZ67mHE06Cd6V/+XTstq96gxwojkYAyvufKmtOcarPogbeJAJuO5e1m7QufN5l/Esqxz+hmq
+s0wb+6xUBp5DxdOdLoWJuU7bWHTXqyaB0yM2SJWQ4eyOsQsVpCRSyzDYmljjBcuW04SlQd/cGt3ZM26T
+JSMqZJE8C4MhjYBBGa4+yYsotYwZ9FzLAFiBtQgsBSI9C6B86ZizJjdV4AStKcDp2aBcbV4Mo904Kz/Z
+7uGapzxbetUG+WLJ435nV6233c5EuXWQa30u8DS9s3p+o80ERCLDjeTJKissLH3iRW9z9UfnPR/VdGRq
+UhiGGW+itd0VdvPZAvvdJ2JKsoMpiYIw5TOMl/BckSkJvu3e1rIb8qPZb2l0Au4I7e8hi7KRSh7iL8Uq
+csutMgrjixMNr2IaPOKrITAh0kaKPKUezd7kt8+0qEA0ClEeTuYEQ3cdJ3TGqE/acTPV5vVgbOkXPI9u
+1LW5/TqHaD+pETnT0JyAO6kjrgCjMRmBaq+XnAU8pQ9lC4adg6JXffuRCecKSl4maAnurdletALNhVB+
+/JWlDEs6D+kSRMeibrDdsA6OvAOxs1z1OnXznqtOoiJhvWefoU4s720bNVlmYFHNfFU4i5Y1aiYU1bVB
+ATMEdxVGUZjxWRIHxR0qWGReXvNeGDy20tpRs3SoZmAdeVe5WXaanbmmC7orYMFpcLZcni7PVqvT1VlR
+6LrSJLohvtKccpp424FuRLJdmVt+XWWqL+LkUlqTVtpXtki0nUaalWLtDbnKSAxPa/YkVRT/dVsunlf4
+whhcQ+eUJhKyD3oUdxXHcFAZWkkBgmClB+AO3EqfUVSmeSKsmRA8RRJG5ILjBR93H+OPy4+rj9ngkC2S
+0bjSywpe+r1tB5UUdvXZJINBYyGdLoawOn10VuiXXUqu9oqudL028UxHZDjQTi9eedOd4cEl+aEQhC8r
+HHat0tYJVwdUge/lrDUbSHICvo9Jf95202Zl6SqO+IBvWdRAMqjOZyMyzZVcm1A0ncyl8hQiDvmMUryG
+0QMajAaasq0x+uNY77zDgfOxKg//d1tvJWwp2SKKoEYpd6m4Rs9lrqW1PPLVCDZzfmgwy5TjmLjqwvvL
+y0vah1gc4AZEV95fJmkUzKJkdoHKhS1PBQ9oF/02zJKJ2476YFJyArpW/tUrulR+tXIHnSXdh+vjyVHP
+e1nLTVQRX1kOQ2i9m7VaKWWTQKvDo0FxoeCed6bSNMBO8ng02CdnaZdAppjLb3F49YczGKx0byaTOxTs
+wWtW/8tr/pfX/C+v+Rx4zZswnv2xkgzVeHeiTLlGVuTh9gtmjxiMb9EnSRKJcP2p+iSfalytOvz39Ohs
+4Kt6vQ9AYih+PEEDlhDJytmrCa7I3rKp+4kaQPycwQQU5dWupuFQGildn74VNo91vhX+TKTRv/KdCQT2
+uvZ4NJIZUsMMsgTofttDHguewozFMOUwY5vFUoBIIN3EwGTG0cslj4E6DQvOWBTxgJz4TPiLPKXrugVE
+b1FFv4ZeI/gyv2/39u3MLkMxW1aqsiGdsYzDX0/s7GorfJWT/gWfs00kvJZoIRz9LUxAMJ+uhGuHlDFW
+BC298sIkfoPv7MVyxDCBreZfQphIA/KOEn8W3+SHVnzVqmvkfE8BVZLQg7pbZZ/BKfr4+MuT1iCrJiX3
+G6R8uvCxz2yYqdhmahzl9lIRy7CubDP18efL3F8JgxrbR7ASuYhlZfAiYZIxGw8fwugU3omzkQzryzZT
+jE2UIY2tA9NOM/m4YT2qqVj5EEI4JDIGt1kNMa6Gy+wTLgnCn0da3qH04Yos5Vn4T7SD9NusUp6JNJyJ
+E3CfaSpeszqaRREmXDoB9yHFtIT/5Ealcm0HRFM7yuQ9dkL85BdNqC8n+prEnksQHD0jK63kWzGETWiN
+lFUXd8pW2PhCFcob3PH4vMWGvUlSIW2m5S0DmqVJvTSoWT/UE+PuKWnAfmFnD0iWGviUMzhLUsFTz85N
+EeDnMBMnYDo8Fg0f9JbUr3uarwCPR9nJaLQIM+EvQrHcTOlstIp28Ww5CoKvjv4y/euXPHj0zTfBV3/9
+61/+8o1xeNhGJJSP7w4Gx7KyTONWGMSVBHvbUVNoMGmTMbtg/761zOFwxTHAzshiSJjNgukPdKqECQRf
+0rFPHTPp0OH8+R+jP69Gfw4O//wfuVm8psBmgnuZoZN1A9FAhdN4FVODDIBNF2FcuUpdJOsTOD4qRyLF
+VILVV/JocAJfau8iPhcn8OjxkeHmm9uf5DD3VGxI6Zs7GkURW9cyYIZDsDlX1/CehmcwgfvVN+MW3th0
+6X74UFaGP6p42vlnA1PpEN7JUMf2E69biUlwhyozag1n0Vfyq6fAbfvC/Zbv+50hSB8Ui/xW6C999VBQ
+YMkxrMBaMtTuR0eODzmyxjDYEKbtyIHh2cdHL4OIo0WSpdyb4rseLKQcrbIP1C+zDlPegF1C1bLDb7Xs
+8FslNBsrRVwXfNcXE3r4W/FMWbrkKsnoY0qsIqm0Smt6AbKVrsLYK14O4avHgz6F2JVe6Pixhbxsu/gp
+L1ghDL7QkB4oBuiLZF0+SO5mxltQU1ZwqCM57IMk2y7+PQzEUmky/Et8sCmwLxVkUaioAjlu+URs2ozi
+6g1OU22LyfBZqrV8unrBOz0ayprOLGRcPbsK1WLNtgufXYWZZ0uQh9g9WakFJElD0vvKXnJtyjW+Woud
+Zx9iRQ+dHgp1lrlGtl7zOPDcbLuwJfbD7cdzqRfcYdHfrcByOrjDcjp0VN9RuUhZnKEAgHZgeoiQMbtw
+UBn0A3CHbn32ugNTP9Jg9aucrjHDiq8AhxdEdIj/3ojiI6KvWGZm2uQU8YNkxcLYOzVWE3xJjEKuYV2O
+CjRepYACXwXc6XAzDa4UmWYqrSjyN7geDK11s6sedbOr/erODUT26m3LMOILHgc3mPdBuO05+iI6lLW4
+FhqQhZwXhMgfN66c9Oeyh1EOV52IP9+aPZqKWLC7JQH3RexF8wpSXe36V37bkqD5H5IFzwKB52WPeKml
+aRhjovYXfd02geuGI7WjD5uxhaFVP4t1FQnPIs/1pyy1NQ7/KApIopVyRj5eLUVIB+61AOStxINTW93V
+aWpejDh5kfEE6j6kMVx3YryyYpP8yStXbiAn5aAPWpS6Q13a6CxRbCb9i+SbVZX+DkWyqV2FyRoOW5pt
+0UjYqUQl1CrZZHyVbLmPPV08nV/1Lrfr30KdM8iFXUSr3pD8WRTOLqoEDOH3NhrkrWcwAZfuniP3NNwD
+aWb+bleO6udGV2YQwaJnnWl19YJh0A3fcpDtsgJ0nFqDwU0MBJXrFVowYJKvvVJB5aPBYQIPPOdP8t62
+wbi1gDxpdqEFeVlslkTYHQvPjRNc8MEQ+GDcWbLLmNLeX0AqRxft8kPAeE53UCR1W3vcT+bzjKNbp0jW
+bQMyGN9YxWjePiI25ZF1d6TNA/fZwb29d4pil8A13SGR8itxyOLZMkF3f5cEmXsd/P+oFSJAEPfQf8xX
+7agC5FSu/6gTcFdnKKG27XjILfzHA33zsO44kslZRNNxz3HL+Lp10KR8dptha9nce3fJcc8eqe2mx7cc
+/XyPvWw5Dbbvc80hKBqqgXltTmdXMMmPSyE5L3l47sDCdA/7wCy6gop3OG+RkMtJVGqjvatBt/qx8mSx
+7SDp85X4LZa3pJ+6uD4upJPzENwf8X9v8X+/4v++x+u/i66J5yvhZUNYbSIxhGwzn6OLYLIWhYoYf8NE
+/vPxY6Ebxkrj/N6dH6KECS+XtilvdvYL+8WLKR2TimnJZESLjGt3Ddr0TFecIxKsU2YaLmdEnCup6L0X
+a3XejwcNlNQg+BbcI4q8Us8n4B65BmIxKXSY/RDGoeBePGigcw81f3ymJ0XW6WDokX9cDyCMN6spT/My
+8yhJUhnOgBsbG8AIiiccDH1uMBipYuvk0pNDpWGRmPUCSEU+I07l54aKXHXFBOqARTc1plvRdGwG80Xy
+Q3jFA+9xpe1P4JgfPq4Mr4JWWeMa9pGYL2ACMTyBIxypQxfHx63YNhDkALyDdKBRpznvy0Azz8Xp3GZr
+Lj+YzDn5YkB/1yHgMvqQs/a6GTWvcLoTPLuLGh99NQT3O6wSaGbnl8931R+Ku6t+2rt6zQLHI5aJcIZG
+SgLVLJVVU9ontVa2GSvzTGWhCFlUKKyNrz9+BM1emYldxH21ExoVCdL23xrmnf9Z8FooGe+Jw6GQMe0z
+Sao/5apBZ31liKq/NmuEeSzo2rYwXm+EuhPbGaq2muJAc2uwhMCtvsuce89uz/2OpdLgfRnGQXKJWxZO
+0x/y0FJt7CXEEDlYMw7aYnmF3Pr66Kg6s5QFtv46t8LWXitD7NFRSwy7wcR6YrqngQlmiJXEPzm81XhJ
+qLq3wI1dL+oWnAM5DZfFSjh+fPQH2GdaDDLdhhZpY8ETMkttJoxdAz5JgzBmka0ANuET2Dz6mzEqErF7
+fHT0Z7fVPCOSdadBxJT45ZPZQz6ZLcsRydqxjfO+Fe76VIhNd6y25dwTANewzQGgmNIwaXiHKxbWhb97
+d1Es59Ju5rzOOfgQDLd14t+lPw3lMRLB3GGPTa1LqXTddiyT9Vhxk0hf5sXqVrmO97jUqrd1OKekKPDE
+kr315rToi2ySm7rKhlttYn7MVry0uH8ztiqbbmneVh1W7BQlbWXtx4/N5W69V0Bpw+xhRce/nQb9GoXZ
+71gcZFROUnM2BP/YVhi5SJVBWDvkk/HPKv6GsbyrQLHTyB8WeJGs7TuTZRyoc/DGvMwjquRVP2bgIGWX
+Xq+gtrqL1LaVJdQT82/p3L7Np+Lkztdnzqf2aops/X8Fa9tVDP7aWq26ITVZCfKPQesCzJ0Ijob7sKm/
+585SZy0zV9OULpT5109JaWfjyqPRf5ALhX0xSOkHb1HqMPE5ZPB0huBIr4y2Aq3G5dtUvetXNTGoFqCy
+IymrYhs+OkN6jqbLRzp4HDgtwbRovVamc60qNGo7A6nI1ia2GQ2i6G2ydvBsuEc3EiFd0DvHNl3l6vHk
+iuhjg3Yk93SGlX0HtxxvcEPTcs2ErFbQwLy/N5nAzX2pf0zZevmHnL6PzafvY8vp+0vj8fubT3v6ZnGc
+aAmP2s/nzY8LHvOUiSS1fJ+mm2xJ8TkIMKV4HBvY96iRcydTtLM1QTjlGvsOAU/A/f8ZIFbsykLFKowt
+X2K0UkThP3ln97QD5Hk1LVBoUX9W6+k2ZUeeJOoE3CdBuAVa+BMnTS6dp09GQbh9akwdXYOFWRIdRovD
+40c9S8kKOlErtF/3pqVfAflpX63PEB5g6qwwsma5IislJghoKDz82TKMgpTHnsXslTupdZY+bnezexZT
+WkcWxmQdMcVP69getWNrUNOspLNlpedGbQa32Q5ZHO9Zt7Ffru2+6S+Dq+bFIFV6iyVrp7RA9Khv5f8d
+dYQ7q44wP0Tmzu3Wud3mz31rjVe3a/Yn02i5uHdafSvpaFdEDhwXR2YYwaOjQUspZdMuhQHLKg1jPraG
+u+cXaKuNszXk3WUpZ649JBtr0vou5azN62macnZh/hzIcOq+NeGT13tl0+5eFqZH2xhfdahIY8+l8ujc
+iP/yoAuSxIlin/5OFWrTihcbxn9rZfgfGx2AtAV8ntUcjvGV22FUmEXh+lcmlu0khziKBOve2kOoW3fU
+4kDdhnid0M1Hh5SigHzgWRR1ecuH60PMAIvQmzTy/oRv7jgC49NFXtyEpp2iydzt2BdZvgrbrTsNkJ5d
+IjnIHUpF/ci9vmdUT/aYtlKJ4SZrNgvFrtPRrNsVrRtHfY304Vwa72hvyGyTZtKpUq0Yd3Cv0296h7Pm
+bbJYRDbr01WUzGBSSOzVkI26DqU4lJjiiaJkltM6R78vJFXKFGP7FvdW5Rfbs/oCbt2EIrUV6Q2crjie
+8mxgBmQ/55BKjLdwvSRKUl2oLAzJuVTZKAYA4P6Jf3XMjmfu0PL5y7/8hU+/sX7+KmDzr5j181+/+Yqz
+L62f5/O/zI+OrJ/Z14+/fmSve/6Xb46nc3vd9Of2D61ieBj63168ZS+ST+oV3c9v/76zf0+ioKX0MtnK
+6wdusH9R2Q5W3ZQE4iTmHYWCMFtHbFdCt9D+K1YAE/mgy6QnszCdRby9Lch7H7ehfy0vgWpi75au5mEU
+YRMul6Fob4NimM1K2nzzc7acxOJQ2fDd40frK1tNlJDjhiNNZW840k1qCFtBQxSaoehQjFvd/+VpYr+s
+sNCJadui1Ran47tfPNzWpEkyf8pQs1nsLd3uFA0Zq3YRSf1P7VsyeaTrdmTWYoXpvsRuLzEayUyAU1KC
+5DXlI0TvDXsyvpaFDjGPO48zHrSYY1qqcIJw63S0KKUE64SgWqxOFypvW3ClyWV7eSWRPHIG0ovfeZ5y
+6r/fsupdKjfFfHyUo2a+jrsd9X9R4/92Gd99qwnpZ9nc39Lozhqbrx/WEoRbclvnipT8mG7WGQLzf0uj
+or/oN7rp0hnA6R28CQAgTXc+XtApMQ3BOZ9GLL5wbhDK9l87Os+Z4Isk3d35KlR4P8tG/5Rk4q4bjDg/
+y8bm19PdcXsVWlt8JoxGfRzm842+2OXPfbFMEyEirjnbFIaZl8FVm74EnQ6y4sBaCx/EzAOdeQP6OOT8
+opx9zC0nGvpnFLDnfDBJjXkrWqu+CkVbze1uQaTsCBuRfPLIZClDOg2aFu5beXORvOcEH7yrcNBSlcCa
+Qn8ho6a9AYwofMheYBXGL8IMi5FqqFAjtpbAERviDzo8t4L+B8H9o6WD7T3LWcXZOOgOwL+CCUzx0h7h
+BeqO2jYmQtF2wRUGyEnwjixjRTmqqFIEDttywV630s0rZlqK7WwXNNeiqP40DK7O2lu4Fl3t4bl6ORSU
+HHUtTnUWcTYYdxSnySpXMhyAW8xYFRe2Fmhe7sAiG1YGvWKpo7P8voc+ZXcwyR2m9myBLB/k4ZDZ+1R4
+RYQjEnWolBxDSqzfigsAQCu7y8vusGyPPARIx5N8XXaNHADkoDDBJoz7gP8HwV71gv0Hwe56waqr2usT
+gYa/F4JcGUeqTTWhBjdNmXC9d+6PXOeNtVvd4Kq0WfctUpV0bURt1j0cpsKsh+PQ5p5dKpoKrYvU7eSd
+2lYOlTsdceJqcLv7rl+1xK2z18ong5r6FHIX7kdtZb4jFwVZ6B/wVPMHuHEDS0tMTtK3cPgYTuBxv4Q/
+OU3fwuE3cALH3cWq6SrKWilxBZyAK73vWjovpmz/Zet8fNEmg0zp/vMkQH8G8d13yZXXNiNQp9inw6ZT
+H3njca+Omk79XS/gMinS1C+Mmo96e69Op34uyzxqk8pgIhnzlT3M5iqflm182KK7trMgqV3M84tJ7WJn
+bx+7Q7jqBnvUC2x3bLcq6mCPuqI2sJP4leCxeCOTxLdLaBlMQANvl1skIKaHvz+BnpUAAGR0Fw4cystt
+cyTjPmW8sgheVZ5fIbj/3lNYGSX7tAl016a4uMdHHQF4z/GeadqZPPdUnq005+Bh3Yf1rCsUsAZf0Q3j
+t1b9cMt3AEDC1OF3G2bhNIxCgd7u8ilqP0XvHWtiq2wZBgGPbXV1q62v/zeEshSfbh5C+TlFOd5BDOJ+
+YYBXWiTfVVskH0VjKf1AS5MLo2u+1x3vGXC6XzgfuocZkyP9oaF53dZiefqXB2r5O0ltYWZ4nRRcD2jE
+27A964VO3R1GaShTgUkUB/4mDlHQslfymccaBl9qSj/Hv5Ieoc5Axn7Rgz+LyJO5bRnv46cFzSAMr0x2
+qL0d/A8OmtzDhx46/ejt1TRTknldEtw6Dwov85CNu3kUqXI64HYw6dIUlJptL2/yXrPAHn1KQlTY1fgV
+uypD4gnNizbNNral1UxVoKiT05FtFAAg8NfonI2VwIgoI00ZMoSjO0oACcqb3N957dGJp/lonA3G7Ziu
+vPbYQl3jZ8VEpzcZ/AsTOLV3r0wR3sMGkecSrw+nnsx7prYL/E/ROGytul88cp5K/E6qPmsRKXDfbJtU
+al9VHWtdh9c9IrIVjpbh261o7O56hI6LbmqrWl/FdzZCxRLopkDdJuwRJYfUFwMYweOjltEL6GJ7++gp
+nHsJgVgxHE6o7NgCwa7goA0CiSvclNoIJECs8Gm7kFIQZlWqQPvNg1HGVWXsCp70qYxd3aSya/sMK3kT
+tmRIVbQszVKs+4VyHqpJidOijXhVDbLMIhRuFe4tZ9hqZ1e9ai/mvUYEu7p5hohdK+8I542wLMA0nhR9
+1Uau3MeOVEyadzQY7Htc6ncfAPS6EwB6p24oat3dZa27fgkj2jIvgylnBCWD7s53UJg9pIMGngq8w78e
+DfplSjjsDF7QCuC19odeaSXoTILgBKQyOuarNmpIq3eO57v39pwm1auVMKsp5TTxf0/C2HPG4NzpoUl5
+IL4M5JVpeHlwqRWElwEcPpXfuzB8Hwd4ei3RUCksrr50ycivk8t2hlq5GvVI3YtacwUtbpWi21C7DPJo
+yO88/+Z/1J7TRoWn4Zn/MjhrJ13DoXpD8t8mtqMzX0GM+2SVF2G84bfJDl90aqq6n348meQjghoifNXd
+m3mPEiK9fJ+CvTo4TS7HfTHl3Zwml+aODvfoaAAo2jNp89joGY3bf3i0Tq206Im5RaUS6X9En99JF17f
+0L+GVWwbaCTQPdnKr11OsJpHW8XeYnNsexm0H/91Ztfl4AZ6DiIMvGBOP+CuO3bKnc9Fo0kUTmXcYv/b
+Nsrdqq6h68OLZWcV9qDbcMAclzL3tClB+ng727MyybUW0ML6ArxcvPgC/KPHA2l27llHka2pgqJPyULo
+KmeR06tgJtLkglvblofEedi83u2QSA9V2KszxLss+pRDd5E7JgVRaoQc+cf7tICMFc4Q+hW6cjqSZRmN
+AnQHozQN6MquPhXm5O3hXV9cmf+WHB560dPtLcfjoIJP7QY3xDZj0Sw3Daqewwq0m6lkCzp8wUYj+IVv
+eQopjwOewjS54hlchmIJEc8yEEsWwzewDq94lAFLOYgl39EP1HCEs00kQCRAMQydPK8k+gl8swev++YO
+eFxR982ZHAZrkOKdtp7KnGJx3Ifp378l179NP4TzXmRCofUv2aSUAcad5SoBc97gNtT2ve2qz5g1A2w+
+97Gq+0i4f1olAYveLJNLjM71RRouFjzN8wfcMOanIk2Rz36Ha75dgfd+w9UdzZTionrfVYe71h2FPeyZ
+gwj65iECgLx5vWRO0EXJtT33S3OvcuWO2t+Pti/eInqjYygsrqm38urteZ7pTNL0P28Y9lljRctvFVqj
+sHQ5MQbWpiPhyq7T63pP3Vs73kTRrXWxnGVcOoCy1B3cwllceRR50sZXCE2lLW8waHUf16+HIw23yrvT
+oUYmqF7Jf3ve9NorlQ1Ubl+jDA99d0OZv6CZw2EaJfaNp9dlp3TD0C2psGUjgH1uXNXvodtrtMGXjq9u
+t5N/wVIwE8nc7RcVcFjmRHL940eP+9SzZGt+KIV5vKVtCO4sDbP198HCHrXX047dz5ekK51xLjG0OjWX
+AEY/5PLzC6OZtZhUKo1e66WBFn2cCjGjzBd+lmzSGf8ef7d4dfvZMpyLf+W7u3VtKlsLE9kiNe9sbF7r
+WmwEExyvCZNv7VcgFv3dLHPcXuaFtKTPV+LFJiV5Mj/Fl+V9PC7WXh+dDQaD28w1qOjStCzKeGvhTZzh
+JaIysXNf53utnOzAHk70fU441/s439XSQPbxw9vDWHbDeXtXi+v+Z7O6DIfjmF9CeUbsW7DUKJVqoXJl
+KLXQXKZlxbxrMkNrX+SlTbK5Fm+CupioqDN6uwwziJJFBiy/35luCQWepkk6hOlGAIuyBC6T9CID34ck
+CPx7n+aoa3Z6Xs1XAibg/uMf//jH6NWr0YsXhz/9dLJanWSZ27Jj5Jwv6IgyyNV4tc7EWve4FLaR0R8T
+TePFfzzN/Fnx23O/x359LtJIJvenIcHN/cFSiDX9iJKZNMngQ5psRPUAI4sMgQoMoQAfggTWm/ugvL88
+jBeNi9IJBQbFee6IrcMRjXndz8LPNrMZz7Kay2i9V1VVEgVM4LQme5zLUti931dj23maDikc3jRQPE19
+FVqLIGMjwJvNymy5po94T3yj6SVZCEODkpmIwwOKbQLlVR9MpIvN82RjY3z0/YcwzSg3QbGUacpVv7V5
+kP7MrOV/ZtbipvN8ZbSkNy1P6xGresGaDC5L7jElYAIO9TLMuZgtcTbK+x4cOKBf9qrmmJIw2lXjhppT
+W2YgqxCtYIjfVCK7aMrRnlWnWW1kIln/miZrtmjsA9cN9CIRLPo5jHnWmllMsZtqzys/jxbs8qjCg+4K
+8hwYR/WFV6nSsgJN9/+TKJxipnw+u7DLFOLgoJNPDsamvhDGhmM7Flw8l7V2Nhlv2Dfym0/cbKy3WDm2
+aP1e3YCYrD1BISaVTkDwxlIjTr5OshorHxLy5mmzJ1Mn1LSr+CnHpeY1WEQTdR/WYGcPz7G9uKLpMTMx
+iAZvMqx4ztJnUdQ6eQjIO3VYFDln3ejeqIXYd0KWU7jeabJiGpi2WtNNxH8O4yrnQmY/BMPMxZo3KbbY
+Gc2SeB4uvmURT8UE+y+foeNGkXmarCrSZfeWhLUcTMB5mJelKvKHXH5yUFw7fPXq8MULpw0BVmBGsFye
+rFbOoEmzSCwUWzbBoj5ZkGoTSaWuHsSKpCBVJN2EqrW9SaOxUUgcjUbwJOVznvJ4xsnYMnGODkl29EXm
+wOjpPWzsW7Z4wwVMwBA3W7yRQMX7a/3ecfkNbw3HeahQ/r0b4d+t6P6uI3vNBP/bOncwasOpQZpRawB6
+DfLGqw7kEsirxAlghJZPh5kJzFGRMJdPHz+CwzYiccY1ULa40EDxCUHrYPOcHgWonk2gizTZrL/blbD5
+i48f9YyplV6QLWl2wCu27tUHr9ja3L3FZx33v214uuvASzCebOabzXqdpGII7xs9zRaLlC+kWzq8x/a+
+1999/Ahutlm5tS5acbxgviyhnhG6DprKVa8A6anajxXIclJqBfKXHz/SUb8y4/T9n4rcf++j8nXL8FK2
+Or8djWDKZheA1zptBIcSkjgZvL/XUHwUpNVxFXRrSCbgLthmwV3bHXKgB3zUW+3P8CzC0541Kejuunph
+QzqsqK7vtSBsIlNjp73DmYGr1x0bcEoE2qAHmXEqBZmQ56gCjJ5NoIItNHz0pGZPzlmrS34q15lWpnyl
+CmorsVI2/rFZOP6xR2naFZdMwIQQlR9GI3ierHdAZJMzEClhMxAJEC+C6Q7mCn+WQBIFdJtZRvqeypKo
+rP/6vDqXCeuKDtMVFtshXNjk7C1MJhNwnHYdTV9N0Vxp8LwfbBcfzUvuvTV9LRm2WV0wzzcJgwEcB6Ac
+69OLM5jAfNx6ABiN4OeEBcUIEOdI2SXZd3fA4gDkQWnJVxDGOGhTelvOCr+OkDR6K3bBMzWShDQRS57C
+mi24HFrwQp/7iBj41Vp+GTRY1rm/ZJn3HnOMy9pcY1yUGv33qnMro9+8kLJeiYTIu94GaehhVRBHhPpa
+bZy20vtrlKm++Md9K6yP8rWNUWVcXeeZ6UeE/NML89uCE+ZfJVLapP11mogEhRwNt/XAokkz9RO0laHo
+C70YieZ4D5HjNFa9NtryHxKKVKfu1pZ9kYmcSHncNt5qpvXxwExt/ONnTe712DaML+wjWGz9av96+DDf
+3gbGnTW5jDO2WkdcjWte7gDcQxcO8nfjfXZrHafb2JRbmqVv8+bmlXkHbPLNKz3cUhcSu6XCmvynr7Xc
+Aa0mjjQUCCzj4CBW58QsFylizHuJwY9KYlRtuxFSW0ftXeAV9bsxnLVRJOUZF3QzsjkM29pSknA72mm5
+WaKGszLbJKeXk65yVuXvNzzrOkwrqCarzJQh2T1eHrJFUhcUS8/JnJNKohQ+bfav003cPuvPEWOD+Zpi
+B/Wq28IGc+tcrgbVy52GZ30ENnIPxarPa4Xt10hic5M5bFvvj8T4gzBeOCetAfT3t51pQXjEBYf34enF
+2c0S11kdGyWd0ySJOIs/f0KT6e94VXs7nX8jIB/1kN520DeR0iek364sN61utaz09b3AS9Nf83nKs6V8
+83eeZtKy37biFZRZbaI+5vW0Gnfp1vb9jbvuA9yFpRsdnm2Xb3i6DWf72X2HkGMZAuIw2IFLbQxxKSfb
+UBT6KozpH4YhPQ7bLvCfgG/xn3+GqwJqlQOGMcKeNTTWQVavAcHvuhZNYpVqvCE4mO6Qpyw6T1J6vAyj
+YMbSAB+qn+JEnIfNV9U3KV/wqzX+KhCdVRVEipatnBz+K/Z7kmIu9Ucog9U/hrH6aLGQVo7WjZ36umEY
+YIKfJ4UgU/aC3E6HpQQxVOJJsxNnLH62EYkMdK9/bCbF9BZcvKm+9QaAR3ek1WnaXLMGfKvdROvSHmEa
+TeReg462U9/1vS5sJHQ4A6tVLuMsnS1hUi5DX77yBlXA32GigP3fM/2SJ2yx+jD9+qt6K7EYE8lUB2mZ
+ET19mJCgtJCBfodv4V/e/O0Xf83SjHu/D+BECsU5Y61VEsaBTGiG4C8x32fR9iXDu5IpI99Ro5xgi239
+fJmPdZIKHpzj4csCQYqQ83XtY12YUY3KRRKdbb435Dar4j4Nz1SvSUW3aVGivntsPGLmLVGyYU5JVnUz
+fJA7NgYaDI+DBgQuZdJfqoFXz/cn4NKcdBslUrnflUWKFxNwcVU0ixSJ9cpC2qtmMW2uUr4mY2/KvEUH
+JZxpvuqY2JUNE7vSMbErEyZKJqWcVM9XZBupInMy5wT/V80W5qzw7ar+dolvl/W3Ab4N6m8v8e1l/W2M
+b1/V3+7w7c6xsZEwe80jmMDo/3nvgoOB9+5ygCeLB6MSTDuWRG+TZ9PMW1k8S5QjW+7Hlm2mImUz4dF6
+/QHvh/VW6DQ4rPTb6er00dlZ4fZm5DIFDc+m2dvkNY+8rGkO+SURgIL8TBCHQBN+MicFI4olIPH78EOS
+Ar8ihcEQft9kApxHR8dfOXAZRhFMOSqow8Do2KKZe7Nh/qTCjZTfo4/Kzl+SRkpVg8NJs3VvLtmaro7J
+TNvT/cZbe983O7NaZaHcgImcAz6/4rNGqmysdtVSqzYl2mpS0NrgtWwl2Wa6CsUzfUOxb9uN7adyYx5M
+SBD1f+QCH9F/r94lDb+VEpU7bKK/lSPLaBTw6QZdUM1Xajcbg/mE0DqhyXTaV7omD+63mS8yLgjKs5Tu
+5QPbY4+nJo87PWwMaSFyg0HG+SoDkZDlIN9fQW0lQ7hc8pQDA9RoQpDwLHZFN6EZTAwv8cQ0Y6LZJzfw
+LaLnHs5F9O8+HkTyiGtfBoU7H01gCd2YwiMXDsA0rW7tYWsYTWPvn/to9Es2ht7OhnCOvpXBv+PYGr9/
+gJfBibEBcD3YwznUOErtI0R5JBuj8obUSsijsz58KRfKCsauvx8Yu09KaPUCPA5aZ8uzIHjLpn1IyoXo
+qgja8ANtSqnSmNAupQ4G7Z6k4qWqvSQz7KAztB6BWLResikXOBXZdBbw+WIZ/n4RreJk/T7NxGZ7ebX7
+p+Nn6ygUnqMfpprc1ha3UvdQ19aWNGSPiI+6t/NwlKQkiCmDSYe3YT+y8jsr74SymUQmlct3QR1eMHkn
+lC2TTHQT1RAyfuTiLVv863e7V7n3jzYjceZZZiUdJU8JIj+zSX+0hmCV460f9aiocjJqClv35YfWoSC9
+yakEPLOaVbrVDvVRwkMwbRiKhtuMDmnqba03R2EqNxeTX0v+lztnnSvYD9dmQeq+5vJiok7iKkBUrQa3
+FrtMpKqJf+yuJ/7xNhWZTC5BefVha4ZGOqKqxWG0ktR4YauHTVsnnwZnfTIhFa4vZS+0Z3rQ/B2wGb2A
+uzxoLJPgNOjjwnHd1SXxj59Vn9QIunEL7RmMpUdaW0vxu6xbwp4GZ/umSb6vyvWrxnX3yoehMxWJwD5x
+5L7x9wxvCZD7RJ81XNcWvm+6qLGIIiXa3JS2LOps/wXfYQO2LOodBTww8VnFYPEf45HtFR7Ssk3KATdg
+CDNg0SXbZaRumaPfPpb1bZuYrnYtd1PdHkiTarxHeXqn7UxsCNO23mSkZ1ySHNIZBQyHe+V2L7xip3tV
+crzfpSpUhvmoA4/482S1Zin3pj3i7+7yXOv+RnIziETG1SnhIZOXj3acek1SyIoLhvvVSCH6Vv47uUPB
+pDQ9IRTqVvBf/7UxoapV7FJvbtS1/RQGvdQF2vZdPcsZDemV46JZ12oYkDScYfc732ZhPJMRKDZd7yO0
+qbJd5uRpCG+jaVBToCHe38Vxf6+Jq/d9UU2xF1zke0HH2REPDyRgX8ABuLpuxng6uNU8pxlNLNHORfXD
+jPQWNp2iPhNeYVCXL7gwuUoBgGZkNBoUNZBCR6OrZsyQUjVTamTGrf5N1aHVN/u1zRn9/tq3n/9gb2/0
+9z2siutB62lMSgItEkLbcY2atM/Rot6FfR35K1IS8vNWqJJ+Oem3ewvDvYS+mklYqs/eD8Z9AnLT+oRt
+KDAu+C6QaQU0Bx1jQHk4z78UaU/InCBfXfDdc7rOeALHX7asYTmH7E7E43umAp2hqqmMUy2W8U2X1HsL
+CwbNzlUR9C2zdXX7lUd40tI5kdjr7dF2nyEu8JZ7wRZtUu/qVLDF2R3fU0i2B6g3Wa4wrG7c69Cxrx5C
+udY3qjWFgHQxjdWpjKvpPLG39Y69h9p6SY/ukDSM98ax6I/C1l0VU429T3WDjR3qA0UznOTBSteDPe69
+60hYIF2XG1bcuhOUO/36K7yNXCTMIxcj6eEbzndeOhh0lpZOL5oBmJ7hW9jEAZ+HMQ/gJPeH6USmbJgl
+NvUCvlV+LnBS4u3EVvjJlPiKV30wEjcMY+325OLuqdKPZgDfal41vkjeUPd55KG1iSIDSnbVhpJd6SjZ
+VRfKZrtXIRrkV43LqQyQDG/HxyprkLY8EtrmVcqN5l1JMvfazi7PW2anGJvnUOFmVZ9h7kP5kw68Dzz3
+T5Qw0h3kFzbDSUW/pQvD0pbxigvmmcXIfc7aPJ4lAf/t9UvULCQxHvYU0rsw22DN2ln6js8dKo+YHyUL
+T2X1WHAhwngBeZNJry4JoAQfqm37nj+8OHm9ieMwbuy6uS80ag5mPPJ013CDl819KyLQE3IQBEzAVcBu
+l4MPTiYVx1JXi2pDYbClmOwO5olvNUFgu2pF8O4aPbx6okKprSeCnPyDiVoZ+EQTNOwjNVVXhYEY87ib
+QovbXTzfv7SJn/VK37+s7Ja08eLVZTcIXtVDjvP8E7bA43xA5noooTWKsFpCUAqLiUpa0SfjfV6LNOP/
+be45XzgDeAqHva6xymvUoqUn4HzhwLflp9IxHk50f/vb5Mq3ZBiwkqd79o/v9PakVp3vvI+K94a7vvtw
+Fca2DcAoEtR3pL0kAvfhil11VceuOqorXDnCFSblHtjdWFTyujoCnVPxOKDcG5pbpPZpYDth1vxDtSIW
+T1GrWq5C6MMg53cid+p0y1xBbh8sxDpxN6qhoRyW7mAfFwHaxL5FNChg28bNKHXL/hhQLnQl7Bxo7J1m
+5wFJbXcgXqQ820QqYzHz3xDj7eNz2ZW91uLihnrW73ZElP+sz+VkRbrWcU9lkqr4kqW5AOC269tkD3S0
+Q0P3SwKvqUjm9qEHq6Lm/rtEkcsCbRcM1yo9mEAVg7yZE5x9OqQMeSVc/7axDLUCx0wTb/mVsPiiqp2+
+irsrlsNWBebseoCiZe6nhmEeB+Bg3XAA7/H3O9tFacWdmlVa8l4+bL8O0kQK2y48EzkD5zaW4qZg6nZ6
+AZtAKHlcJZqJTbPf0shkvUC4DVqcMpF6R0PYFEKG+60r71r41jUVO5iUbKsMa+riR9b2yGR3mz/UZ7hX
+n5toMOYwtaHG7KNeu4pO6TBss1A/7eCwqsNQtXo6QKG+Al1th/CY7mLb+xYA8yGtfIeVkKZmUM+Q/Gy9
+9oMQL8fAZCauyH5N1pu18SYJxbk/aIoCGWpyAu73bhlzQ51zUuuUTRqdgDtxSzLLAoKv1niTyAm4T6Yb
+IZIY6G6XiTMVMUxFfKikBoc43OFSrKKJjDWUL9YRm1G664kzTYRIVs5Tvpry4MlIonuqUYfpeE601qmA
+XsyXPQQmRNOLDdelxIOj6LnytyvL1AZLJfe+ZGK29AgbLhG9NzdpZDV9Wb7B3lYvIfm7+ySM1xtBucQn
+Dr50IImfYybeiaNy2dAdHIOxAylnQRJHu4mT/3JknqqJ8zASYwbLlM8nD99vEjFG7kE5GcGVLx4uxBih
+wtUCsnRmAPPX8WKyjhdV+BHDX85TA6+S3eyvkzXeVuKZuwXjvnksTqjFe50Iimj2a+tSeIapO38KM4Eu
+xL1WRD6Tf6PZPlqzVIQsykaUBHQpMfk4fd1G7bZodlX/H5WsfK+4WpVk9UPNFaM87zxLU7bLYw3Rtasr
+IUYJWrF51ouBSv17ujW7qpn5oeFoS0jKSs9a4r6xwWuWslVWc9PC/w1abl532YXtlLCla5+k1Oc+1M8h
+9VNHJpjYZHTsUEQcgPOQRdHk2LmRe4muHzRELsl5IJPtntP0rY+0afjqF85th8CsaefuU+ezizswDG59
+uu8iq40Cbs2w9JvZ2uU7yzVr9UblyPWGLVuEYCm3HsAxPCkJM+vH9b8l3jGhSM2LnRKeM6J2b+e7flVp
+vTK4jRRcmyw4ro1o4PxP8bSTsntSvDU144a7yq7blTr1HCo1MgYdZ7L8MIvA54pBw6TemBtk5KzL+78k
+8IqpnPu0r2TwQ7KJA3uKzm7/ru5grqb3VvfdGBiW8tntNlqkSxkuj08NEJVSVIGIysLJQdhUg2BTyhqM
+DDZzGrDzDHmaOS1C6ZpXz9o326Qpj8Vvr3+utGtTPczlaKJ6fvGVzU1Ec+TyDAy76uykC/z53/sy1e+q
+/iUPKFHOuieVTr+u26BbXXpyF56OSLh6uF5DV9M0bAo2dYdgiRuUA2u+0MDs0jnCxuleiPh8d+6aOLNO
+z5qrEPRrsWFi9SSlrAHFLWDaDB+YnDGr0FvTJFozIXgawwRGMtlB8HH3Mf64/Lj6mFHWg9HYGB+vyklV
+8NY82rmGNyegSFGiEh5gjgM/5XRY81xiH6/cQV//W2mkXXDxLfpTTHCcHqLzZofinMbzlgPKFAs4V5aY
+hpgTdiYloIENpdQBT8HgYHk9sE+TwlBeGMaPj47cGstZb85bWAR9rvt5VrmjBNES6BVfAKDOV4aGFsu5
+fwJukvmz9UY7dOd/pdnzpMx33QRDTnQCHyiuosaGbEqeM/tK1xT2mP3HNmFqynrqDU1Tf6M5RGKupn+3
+z5M2EUNDcHp0lt/E5P7K0xmPBfyW8cBsDJqtNzb9f32KrfiqbfrQ5/bpI0Eq20LHnMnni5NgBvqVvMOn
+lrylz1So+FbdnoxNxoPbUnE3c5Da8vnNweNiDjo49xyLS8XqnAYUJij5UgohX156h65Ow/qsxkuQa8e3
+ir0I71Gt9nG1MmRYVSJb9t2Yi/PpTvCsbc5rQO0zXwe8A/aJ8zDmwieUTpM7pqQqRTWF+ZtKCIvzVbFY
+BQ1litgTONY1sJ3zfAjhnM34CXogDEFpyZKYnv9Apqz19OexLEjZuqpnRsu/UJ+9ySdEH+WJIkYXLjL1
+JgyuzGn48DMtH5joT/XFtNZW02mwPj06G0KwPj0+gy/gm7Ox1R9ZoXzLFplfDDw5oyQb0ZJ05w7IOjw+
+62scpuHU+huz4f3tMsb743gqdpVWENjArr4pkJw2Sp3hSMu3Z33IalHEtNcjdy75fjA2IhArlY+kHdPe
+/sV58hEl9YrV2s5I560cdN7JOud3yDODMLvw55mfrdmMn5uEiQ4WhwjMHG14d3QZpIsbk/XpGO38vwmH
+nWefmL2qU7qoMrFT7bfmj3BmvOydzt1psrY4XGg8Nk/EMrHOZxvTyvFLqFtyphzZpinctTDfedbNebFJ
+VsabIzitl2hnuu1FT7ExiAAH8Zb9Yq+lB7e+MYkVVm/HclM2P8/a+Hy3uvhlPAsDHoubJNzO9s+unc1u
+bq4Mg1LtGwa1gIYw6Er1ttpkArINHmEgVK1GnCxTNwWRYY5jyudxzyiIOrOWd0be6u5nieJceQDUY8ab
+qW5njcR6Jn9oZe2FSYuqDacv98m4gdfKm/oA3JGs8FusRPpiohsxOghc8B29uOC7NrUxBq6gGg3vmvyV
+xbx6vejWYJejiFg/SGL+s7qnGF1bt77VBanHplTMCkMkUKUq49UZdc+2iGXilyT+Lb6Ik8v42VQGM720
+7EioHkaDVylT5bPRfyO/GPYgf5oEu7IEPpmgqqTfNKsW6SwvDPTlk2NsuTUVb1tFjzd2kTsMe1upNLWn
+LCX/mubkv12GDK2PpdzQp2O5L9JNJp5lP4lVJOWN75Jgd5eZR7btyTRv6vPWPfDtiTozdb27IY39LIki
+ts6qN62Ew+ZtJDoqmQ38fu3V2JatvskTylyxeeEW62+juGQj/eO8yahRDfQOwqDzeug4EeE8lFtWNko2
+YppcjSSub8OAmGEQBkPiMbeazqY4pjLFarjtFclU6/OyIIYQySS9hkYburtSsrFHtUtn1//FmWbqG3bO
+2bIRpRLI8nELb5VdNsdq7Bx9t22yWCOs3HMLD/Fns9plq8bByaFfFG+MBfZlf3nHU3flddS9QMZ2yUZd
+xi0fcK+w7TFFyAnl4KlD4S3V+UaLax++gGP0vR10RwBWWtC1arCsNkxcufWcmc+BHKMixEZeNSCJc5GX
+Vd5vpJjgdjjhW4WKakJZY0dLlo1smg/GMBrB91drugdzyWFNDFZllVeODoCz4N7Nbxm610LF+A9xielz
+E3j7OUjwVdZyCLKccnSCLVmnPrfsUdIV8Tbpo4xJoVDZpZJrKXfvW7XcnBT307Sb6mptNc0xde17963v
+1kvf9Xu2XvzagejFr2Y8L37tdY3Wr5v2M32351fD3avMuUXKXpU3qeY7FayVQhnpBAAAAAjW/kXTT6Z5
+w0O+i62pgmB9Nv7vtrpunZstz9JOV0h0Jh8yeLdBNf1Vl15VG9TeF077dKn/1rde0kd5c7a+zE3lb/fV
+bVloDNZ7kfjwYRuJ1EWZfhWT5uqz9S+GILJgigGOxbUoKuj0P1xbMhgShkjRZ64TNNNCZQ4OreBob8kE
+W61PQGR2sK20CmvX1GDTh62ZVk/o/3eU1aYZ0SUvQaGMFdQvvm/2clFL1p7dwRZ01ziQrenyP1rwfY5b
+/bJU2IMBNcLJTiqXrGh489xFPN1e0Yn0r3zyVzzL2GIvbQBl1lx0sh4UUDW7IK547bduVmke/LGsXKH0
+a9vSckJW+AG9ZYs9DvbPguDFr3s2JFgX7QjWd9AM655o3BuJ+0i0TQbEgsA7fjwEN+OzJA4y17SFNrdS
+2XvBeo+Oa7tboEeK0AvdJfcOMoGShr8elkTAt4896RRq2s6S+2SxF2WqSYuViOZBa6L7xpoQPRj155L9
+9C6Pa2/CCEt+roEOudOEBGi9yFCBmO4xzF2xS6j8TQOUAl1KOHo0hl9k1fiLbGyR7ovwikUThAehVhc+
+NUDmSbrgGpB8NoRg0O5UwjW2q0JFK1UVMw22fNcAT2ZN8GRmBUch659JXAs8wTc1K6M2cmiEqo2T5Uig
+DaN7vHRb83FVPNRp2nzH50nK1cOzueDpEHgclL9ygChchcIYecKF4RyAX8i/kLiZKVuUIRoQr5+zMWiq
+H7tFYn06MVO0P4emxVeeHrcy9cjAFvOWq+wK8O/jwAqMhGvdjORnuYO/fHU32VTL0ZNVPNHG825qyOcE
+4ufwpJgjd4a97CEOT8uJd3v8KRenOLEMiYytuYVFZ1a7LjElkzsIhqTcyS1OCl9mOI3nszJOLjsjNmvY
+2k6T2Gcn4D6jgHfXfNbL8ZxUOUucXEq7VOXn0WCfhCl7kPjbepasMNXffkTW6fvEVP7KMnEjCvX/I7WP
+Hg/+0Asseqedp3VhTryJJ1jj6UjJA7qeCJU/rjtQt9K5Q3dgNe2SjGFqQinCUrjipBbDlynko1MYfjw7
+GOVB9h8bupfrRqSHuvQlj/YyR/Vv5aWjY2OaOaVVa1JNTPukIuU1JwyPywsfMXda88SnBIKTuoTQBCVB
+7qQi5dmcT6nNspeGrkH1g3LaiS7CDU1HnAUvgeSjnpGXJrnBW5aEtpOaVDc0cPpcBDtpCnZN8GTWAE9m
+dvBcZjupi3XV+WLcUWoK4Gr4XryrifK4AEq5XXvKB1J7RWOmPdPs1p7zBVURlLUXJik392rlMCnXbtN1
+Y8niBe9zoWYQZniue45G2XTVTKJRzw+y61B+G5Ih13V1+fabcdTZUWNuryKwfQSAYk2D68VJzAfuiXTt
+qk8IaNUS8Ey8KTf6T3vXSG+2rhHXPtqNcGyazWUW7mJyk8tKV5JqHgdlUbUOehXMV0lZWl83vVDQqirL
+F4usV2FagmXhYkX2KozrtSybr95eReXaLguXa71XccVWy/LqRW8EJSvRE7nn73qjSWZNNMlsbzQ5d9Y6
+U72xoeiR/Fz3NUlX7auhk2cZ17wVTmlF+iSCR1B3aGqjdMItyZc7753w0jtlQ3ZPxQUX7UMTcZZWvQkD
+Y+jJZRgHyWXeF577nAoKumhdNphSJd7E//fm2xVRX3qJDeHD9efb1SqXSksirjw/RK4rMepJ8kwIBmPD
+dWc+wmezix/xNlOppa2oZFVCxapSVtPCqu+DSsrCWsY2sCUsBABgswtKWtgUFemCVVRbT3QKG2DZbMmD
+TcQtWJBAFgeBzH2oJUeEaoJEaEstN7sgYmRauWqZ/XMdFt1Bl/3NLt6oREgwgdyV8IIy1v/CeZDBsxk6
+l0U8WFD6RYNlUZYin7HnmNOxQPQAr2qMhfbJVhhTKTeK4UtbARmi0CgiX9sKmVyYaz1i9GS2BsA2vJnb
+nJl1xlUrieo+eiPnHHrbPl+GUZDyWLvlsD0XrjH1qBW8JP/BNE1YMGOZ8Jwk/tuax07TM7o6aeGofxox
+a09jhkrDtV7WKL3mIHkI2XLZc/MyMNXtasX6syWfXaC35P2Jdo9MS6+RPhwLaatFDZkF9Zmv4MetSHNb
+kMyiEMbYtKGN3EE7LmkyyvMx3BRTxZIpbVMQwpMJom81YzYCYFrGU/9Dy10Yd91kfd1jRpeLqByrzlHY
+534CS28CtXXcUmSzDgyJ+1vXiUxTV1koqi3WGEuDDVrvmD626Ft05vXdtl8WaRXSG5va8yjJuLat2e+5
+Lor8QMesPcqweKdBGwLA7no4EMWiznVse0whIC/8jtnSf/ldd8yUao/Y+y+nbeFLqwQy5oXJ+b3xgURf
+t9+2ZpgGLYMEnXfeL/Zxz++eXjeiZY/tdbWJRNgz8FOfYSrd76llXjVy+WqTURfR6U3XhTv3CarvBL35
+lYQ0fFRVEbjaURf2gjQ81MrdpPp6n0mUuXw3rDD2MAr600bgvWjb417CcgrlMnXGEb3nyNl0iPU7dIm/
+LQy+1GWgz05e0OmEVpoPB+eoM6So4X12ijK1a/dWUYajuiNVznSXwv+HpjzlcJ9oMdqGIPDPbzH0ofqT
+LRM5PEhD5yy93kMbgvsMzx3W7PFFpugio+qjNWG91CXemV6BdEU7KQsT0Kkjsh/TtXNmPQfK6LI3eeCN
+XkpG49jLxjJDnUJUGWZbkdwippehDrcVuNkeak6iUFLdPqP2zaDQwhqRHlSF8+A7GcRpETwkaSJZLKJ9
+hG3UYFT1Hn10HuQXV1LVfQiRs1S1wIlkDLvv+y230VUa3S6MWvLwq5T+ptk1sOKC/SyS9aFSkbbk32uq
++Iw6YdxD6Kx0WSNyd9oM2q1yvPYG9gzA7hpIeWWpGs6CT1EnnIAjvYVvwK77iwwPkthzpdKrwl55Z+r/
+yUTyue65K9fULRPvE6Z/XmbtmvqKpLHtvv3cGCcCXdcybosEzyPvdPjh2hucDUYL3KaO320eHR1N3b30
+GzQj3iYb1KaUVgbDR6t/pllCk2Wb1zrYIsIAALbVeyTyxCS2iu1DJStXQSNNek6br+xBJDX+SWVUeL+m
+09Rf97ikTi9FcfL5NmdAd9pShZnc69ZueS0zwJDwLWP8MXedkUxpw7dJdo2cRAMbmofzNFm9yK+Y7EBF
+oTU48LkxyylvonQG7XW8DVc3rIOuqXQGFmdled6wnMzbFgCZrfrOf1mLPF1ewAE4E+TC255Tvzh07DsB
+8wkhb/BU28A5oWo9imiubIN9J6FtZ6zDDG4uyP+S1KJJ9r1lzSa5x0kus/e44Gp28UloYLOLviSQHu6T
+EDFDzH3J+CH3rLl7OqSTTl9Cft2ki0/TH2vEvEd/zPinG5t5gb5JUJ/QLsqkkFzw+OcwE2WIV1dmhWYJ
+TwVvsU31cias4JxcRSbkMVIudHzyqRRyCPy39g1RwYQw1r4EPOI1c8WSNe+PpHrLbBPOCyxmPNOcl8Qo
+5J4jQyixpdm3iH3imJkk1WzwDRFLHnfYiesEOmMLSN3PCqSFuPNsUKtAixqUrQRqXxE02Cs2uSCo9QRb
+b9rPtuOk3vV4OtT73RnUezHlmVH9d05ffDoBFjt0WUwk1t1YJBckm2EC/mpMiP7Fljk6ufB/5ekqzLL8
+/uZi6uoffkhSQvc6iXgLKvysLgTQ8ODbCgL0yvMcnI61+nOJ4AAc0F47PUULWafsephA0aPjfSfu7aam
+DGiVZNxgaq55ukLeVNUmNSfAaPTTs+f/epKzYGSfIPM2kzVRXZfp53LfYSZStoYly2DKAmDrkMCwyoYj
+1xL75EkQbtX1q+8che2dA4JN6YbhyTvn8Pid8/RdDAAAAFApwNI0uXznPH0yCsKtDUhhPVSXdyL4Jnrq
+NMMosE9uNDtNdlVC1idd1xomEthoQUWIZM1j6qtMpEm8eOqYwUgCIbiRHXBJN1M/icKnuDII8wGs4UCV
+PsDSUVgveX3PgGO0iVTHy/8b09DCsp095vxa1mDYMv0HYaxSY54W2mcHB+cNT7fhjOc62TxtURNFJf8Q
+yh6zYltyRQ7u5nnVy7DtExOyYQ3oWXYCzkyoHOvl3cLOu7g6VfFQKONjDgMMdMBpHi8OUQk5eefMhFrl
+75ynHz4UT9fX+dR+snz09Bnp8iRV2ZPR8pGa8k8EcYW8KvlE/8f1GK55UF1CYslZUHmRak8K5OnLF09G
+Ymn48FvGU8unFzybpSHdCGKB0FaUBQJ3E7plyPK99vrJqEL9k1GzdajFqzYXsOtTvuZMYIclFxDGMBM5
+W/8ISRrw9LvdiXuYb25upQ8lnuDphw/IE35i2RI+ygDit8kJfI3jJgIrPPZgB4jWk1bI6ht6y+QV1fk8
+nLxzaDbl7J5Y/PX1OweaZakgi8MVmUBxyq4O51G4Pryyg4s0XCx4OnnnLCXntqHdiOSQWMzknXP8zoFp
+dqj4smpvvqtjYxm2rNZccw88ydYsxrEM53IcC1nE33GWegN4Co+Ojo5wS8kOZbq3KhztHojlKXQjezJR
+2J7+grkjVUEbbUytSLe4V5zWPSyi3XoZzpK4/HUoUpYtXaycvBEn7kzkMnY+wQbuU+yaWm3N6a/N9icj
+4gL5U4OgdRquWLpz5WXbrhIoRzG/dFXXqgImktfRJnNHT+F5ypnglCuEysvxc2Cf09Uv/JK4Wu/DVbPA
+H3q2oobmCVLwtzeoQSxZ9l0oqlryadgMLlXbFn2Dh7p0qcTY+5V8B/U9NOMCwRrVDIFcThsHDu+8EP8U
+Fx7I7AllcfxmDVkjrLml9C0jXUOHCwHiy/2rrEa//W6MqGAkgv3vZP/JLq72mbmONul4wUVj8CwDZ+zR
+lAebGdf6NNushqDfPZRtVnAA3jpvxrewlk04QY/mumPzdS1nIZ9nal76P8oJkDUm4Lpy4sIiujRbA04R
+RQ5G+HSxrLnY/HWaiARNwf5MLn9bV3Usv3Kmw6TS9432lOcoYjiNY7J2QqZAmMoReajV1uu43HF2k59l
+04O3ihkUR8HSKuWgNcrRj5aGU1/LKQ9FZKDBKc94ZZbPcffoSFWMXQuhpqNBY9NoYWetJknd1SR1tyqp
+NzG0COpOrKAdk5zeRNVPTCdFoVMqCml+/MIvSU3okH7w/z8AzF1lNOkxAgA=
 `,
 	},
 
@@ -13671,26 +13662,28 @@ SPqB4BklRlOoeIcSf2DuIe0/biuvU9w/L/bK1W8S3T91r0vsKP6PAAAA///zo0lFTRIAAA==
 
 	"/js/incident.ts": {
 		local:   "web/static/js/incident.ts",
-		size:    2426,
+		size:    2860,
 		modtime: 0,
 		compressed: `
-H4sIAAAJbogA/4xVS2/jNhA+S79islhYUmPQ6TWqukiDAGs0KIp1c1osCloa26xpUuVDayPwfy9IirZk
-N20uEufBeX4zZMKgWtEaYT4XNWtQmEUtWwTcGxSNBrEm88B5TRNUSqp70EYxsS7ThPVX7oGKQ5km2KEw
-OlK0NkyKE7mUzSGe9UZ+F5GoJee01RhpLmnzB9shZwJ/pwL5WVGs2PpPg/uTR061+U2KF7EV8rt4WAqp
-dpTPm/3Jk6EGxxGNzT0zsT2ndEzTdCm1FY9SGCU5R6VJfTrnWazSo1E8m8LX7KN21cmmkH3cGNP6A5c1
-dZ48oaQ1Qa5r919Z4cOAPFy9vyj9FLyhe1/6z8a0C1Qdqx0/2g2y5546y70rL/QnMv/ifme5roN0vnh8
-6rmF62tHFWikqt5AdfZCAisvyqDBGqh6NcIa1/4V5Des8SaSkAzxEIEKPuysNqBt2/IDRJw4E1TD3xbV
-AVqq6A4Nqg9pkig0Vok0OaaJz56s0eTZjLZsFtqUFWmSEG3rGrXO84Ya6vtYQPWz9x8DGIAEKnB6ZZok
-R5dErxFQABXk5uDKH3p/MuRS3eIBKkBRywZfvswf5a6VAoXpW0Y8qsgDR2V+xYOz3WcA2SyY/+RsVxnc
-gjvALWSTLR48Y4sOfcdzQFeAd7F1Pr0psHGaruYdaaTAZ0kbJtYwmcBNF+pewCuEOEo4ulRO7RCW89Jz
-hlcrWFGuseztMqgqiDG9NVih20lHtF3+hbUrcn8ldpksgqQMem7uz0qO6gXjSIyyPpC+kL5pfTfo9l+c
-xOKXvZJVrmzKcnxRPKfbKezkzrWsI662hcNPgFYrtcmt4lO4hozT+m+YjTJ38lG6o3yRGGW1edCfzY57
-W+QX2QS4JMfgyjcoz/v2DZzEzvn/8MaKCcr5Ic9H6m8U0+N+ADW/eKGC1wEv7l+HOtajrhtnPbz9lX2D
-Cm4uWBFDb0J6MoGLK8Vobq9u5N0UWNHjwId7sRgiFPQsvDufWOPnizXv3RWnvXReFMlwwq9hN1Tp37cI
-g4dADjXeCYW4On0W0dyTp4jCDpXGfKR5frmggkA40L81InEUiBVsf6X1TLWJ8+0aAD/8eHd3VwSHK6kg
-98sfKrgrgcFPMAqXcBRrsymB3d4WEY1UwaB2QbHHSEAJkoWhxmq4qSALrjOHkBHfht2TRbPJ/60mqICV
-I9UI7ZxNAYsSZjN42rdUNGA2CK0Hplx5orZKOSi4ngUbS4V0G+wd0/i5MBzelvQ0yKjUJcgGgxxfouO3
-ovwnAAD//xznoa56CQAA
+H4sIAAAAAAAC/4xWTW/jNhM+S79islhE0htDznu1qi7SNMAaDYpi05yCoKDFscOaJlWS0toI/N8LkqI+
+7GSbi60ZPpzPZ0ZiwqBakwphuRQVoyjMQyVrBNwbFFSD2ORLr3mNI1RKqgVoo5jYFHHEuisLIOJQxBG2
+KIwOEqkMk6IXKXLWomLYaxTWnBwWkDK6ANHsVqgyKH+GVjJaxNFK0kOA6hf5XQShkpyTWmOQuST0T7ZD
+zgT+QQTyASjWbPOXwX0fIifa/C7Fo9gK+V3crIRUO8KXdN97MsTgNIWpuXsmtkMNjnEcr6RuxK0URknO
+Uem86p/TJJT11iiezOAp+axtOZMZJJ9fjKndA5cVsZ6coGRj/Lmu7P+6ES4MSP3VxUmvZuAMLVyvvhpT
+P6BqWWX1wa4/u++k4dy5cofuKV9+s3/Dua786fLh9q7TZpYILVGgkajqBcrBS+5VaVZ4BKNQdrDcdZSt
+Ib1g1JmIfDK54xSU8GnXaAO6qWt+gEAsa4Jo+KdBdYCaKLJDg+pTHEUKTaNEHB3jyGWfb9CkyZzUbO7b
+lGRxFOW6qSrUOk0pMcT10THM+g8BjEgCJVhcEUfR0SbRITwLoITUHGoMve8N2VS3eIASUFSS4uO35a3c
+1VKgMF3Lcseq/IajMr/hISv6DCCZe/NfrO0ygSuwD3AFyeUWD06xRcu+4xDQGeFtbK1LbwZsmqateZtT
+KfBeEsrEBi4v4aL1dc/gFXwcBRxtKn07RMN54TTjqyWsCddYdHYZlCWEmN4bLN/tqM11s/obKwP9ldDl
+/MGfFB5n534AWak7mEZiVOMC6QrpmtZ1g2zfcBKKX3SgRtmyqYbjo+Ip2c5gJ3e2ZW1ua5tZ/nhq1VKb
+tFF8BueUsagf02ySuT2fpDvJF3OjGm1u9Fez485W/oukni7R0btyDUrTrn0jJ6Fz7n98Y80E4fyQphP4
+O8U8ZlOqucULJbyOdGH/WtaxjnXtNOvx7Sf2DCVcnKgCh96l9OUlnFzJJnN7diNtZ8CyjgejcP1rxgZL
+T140r9MW+/UhpGFr5jeansvGrOR+7m18YdQNJGV05ibkA91fSwWp24ZQwnUBDH4KeQ1vxJyj2JiXAtjV
+VZflpDoD8ok950tq546GRRoNNZngRtss8rPR/Z4x6TTmk9WMSp1w42TjhhnTc/8FEOrE6EeXcLAwjnm8
+Os/neQzpvjTCfN14sYjfKkwA/dprxrgPzmIokMs2WLxzUq6wRaUxnSCHTwcowQt267y3o8IuyhvB9meo
+e6JNWLB2AuB//7++vs68wx/wzYf7FtcsflRjD+yG1BMR8wdDTKPhooTEu07siE70jV/+Sc/L/3o3QAms
+mEDDbknZDDArYD6Hu31NBAXzglC7zSDXTqgapSxlbM+8jZVCsi1GRI/PDfuXe9zzH5U6JeMJ7y3tj89Z
+8e8AlqVc6CwLAAA=
 `,
 	},
 
@@ -15198,31 +15191,33 @@ sAS5H0QZPIokuDu0qx6Xvp0MgukspcWbeHXK/88xH95BFg2PafZ3AAAA//+Fn2SG5AgAAA==
 
 	"/js/silence.ts": {
 		local:   "web/static/js/silence.ts",
-		size:    4110,
+		size:    4585,
 		modtime: 0,
 		compressed: `
-H4sIAAAJbogA/7RXS2/jthM/W59iEiyWElZ/ef8FerHXWWzTAl2gh6JBT0FQMBJts5BJg6ScLhJ992KG
-lEgpqfdR9JJoZjjkPH7zcLZcLuGdEVthhKoFHLnbby7f/u9e205Vzl7C8iqTygmz5bWAjzeyxXM3tT4K
-EH85oRoLald99JzHbGH9CbsCrj6ts4UwRpsVWGek2q2zhXXcuIQWqkmopjPcSa0SFm/FRGGvrbMJ7fgu
-JUUj09NOWHczMwl5K8gL2FzBSctmnS1qrbbSHObcVnCzglyOJk6ke652YqbSSMvvW3E93HevdSu4wlfl
-AQ+fyApSGY3carMTqdWdFWnMDsJajk8NnD7LKEPXWjmj21YYW9Xjd86Cx9fOtKyEW/bKYnpYCezV3rkj
-fbS6plATYXTnUL7tVI3M3GusphkvgdRXlPGfnTveCHOSNfKH27zsl0BFOT1AQvqqPv6G/4K8QOCcuAEr
-uKn3sIn3VZ6VF+ts4W2qCEGwCYc9GaVCNVEmVBMlA7aieODEMwS2eIDIKCXoRSmRUYpAjEKkEqsamVyL
-VJT57Eepp6M8ZD8eCIx1tpBbyC8Sx1+/houZtxTbF0LA/r9n62zRZ4sh57CVrRMmb7jjBNLbuxIouj+I
-rTaCeIHzYesQocQQqpmRk/OtPGBFqu5wL4y3BlNtyOXHfh3oWncKOW+R8UcleL0nSxJMnkrFDx4sC3Kd
-rkanvfLVxj9W0IGFEa4zCj97/EP4gg0c9EEol5+qG3SkqDpXE7r8CZGe+Ek1qRyfTOKBD1u4SkMUbAtP
-r8e3R00KFCm+SwJ5Tm2ILioJeDdG+zM60UQBVzEn/6RlhLvF4N7BBk7I7sllfwxzNYPKTrg8IAs7QoU0
-W/KjXIb+v9wJxwq8urJdXQtr8wgs6n7ekKGmQ4uGDdzekVmUDaUfxnwkiZhrVcfO7nN/4QLdWAH7UDt5
-Eqz0zDiVEpCXeH8JqmvbyedbsjuE4Ise+/1Y64NUu889N3/pW9/7lVv3ZW+lf/Hd776fPNb7FNGQznP6
-9zw5xIYN0P+g5vFAMFhPcfEjdzyPdR66Yp42yacnYKyo7LGVLmclI1sQuGmfDVBFBR8ChuwNgzeQHgu3
-LG+hfLp7syyqP7VUOXtiBd2K6A4m0E0hRvnJuwkB4Se42MDl5Rp8XNDwEa3YptCSsLmkU4hSQBtMbMLE
-i3vMrPOSNKw06cwpB19X3k7vRckK/wQtNcksIe6wNkznyHtgznSCwcrnHU+OC8R0pKCsT+ocXfaJxQB4
-19Mpi4mLkzWhxrkSWX6SRtrPzkgPQJgPPWrTjjtsxCOa4jD0SxcCasTpEOHJ5oX5Nh3OyD7MSeo7yfkB
-1Rik9djIjtrOO5kVjpXephcaWlIuNI09L5QrEggfliutRMFWsOWtFb0v9D4tsnRRhc2Qin9docnt06DN
-FyxGGWblBN+YIIwPFcVzFaGaqBAQceb4gJKok+LmjCJhKWqN0DqjQnCLKiP6zqggIqPGgM8zCh6zUSUU
-4HmlUHpRa1jvJmp+STai1bwh+PdJCYwIf1YDzzD9IrZm0rCbjtznaW4kejlaR6WQmOH7zdfV0NfjmdS2
-UvG2/ZTvhJtHBX+rYUxmP9Yew2i5eJCq0Q+D2Tm7JgW3lxaCle9ZESo3rkf9NzQMMuW9bGhWyaaExx7+
-I6/xRyU6fZruVdhED8kmm2yxodUfEK0H7gZw9XfFOvs7AAD//7KQz0cOEAAA
+H4sIAAAAAAAC/7RYUW/bNhB+ln4FExSlhGpyN2AvVpWiywaswB6GBXsKgoGRaJuDTBok5a5L9N+HO1Ii
+JWdO2mEvjXh3H3m8++54brparcg7zTdcc9lwcmB2V1++/eZemV6W1lyS1VUqpOV6wxpOPt6IDuxuGnXg
+hP9luWwNkdvyo5M8pIlxFmZNmPxcpQnXWuk1MVYLua3SxFimbbTmso1Wba+ZFUpGItbxGWCnjDXR2rJt
+vOStiK0tN/Zm4RLI1iTLSX1Fjkq0VZo0Sm6E3i+lHWd6TTIxuTjT7pjc8gWkFYbdd/x63O9eqY4zCaeK
+PRgf0QuETE5ulN7y2Ove8Dhme24M2/JIonnTa0xZJFTNE0I49m8lI9GQppjeayWtVl3HtSmb6TujPlzX
+Vne0ILf0lYHc0oLQVztrD/jRqQbzhAutegv6TS8bEGYOsZ7TpSAIXyNdfrb2cMP1UTQgH3dzul/8Kujx
+AFTiV/nxN/jj9Tmw7sg0MZzpZkfqsF/pRFlepYnzqUT6kdobu2XQctkGHZdt0IzEDOpREmyQqcEAl0GL
+vA1aXAYtsDgoYRV0QOmgg1XQOeoErVsHvadOMPCCYBGoFIyCLNip5tRONad2I+Gi23hJlSZiQ7KLKNiv
+X5OLRYQxn0+EnX67o1WaDGky8oxsRGe5zlpmGVbV7V1BMKM/8I3SHGVe8mFjufYCLtvFcmbfiT20ENnv
+77l23gC9NIb5Yaj8ulG9BMlbEPxRctbs0JOoDo6FZHtH0ASvjlvDpR34qnaH5WiQaG57LeFzSBN3CHBi
+r/Zc2uxY3sBF8rK3DTLaWfDY4ifZxno4MooHHGzIVRyinMRHV9PZExIDhcB3USDPwcboAoiTd1O0n8EE
+Fzm5Cjn5N5Tm9haCe0dqcgTxgFd2ZpCrBVW23GaeWdCFSljTFTuIlX+wVltuaQ5bl6ZvGm5MFoiF7do5
+MvYRh4L83N6hW5gNqT5N+YgSsUSVh97sMrdhAtdYE/qhseLIaeGE4RmNSF7A/gWRfdfNPt+i38nw8sN+
+PzRqL+T2ueOWJ33teb8yY192VvwvnPvd97PDBlyVOFVkGf45TQ6KSU3wr4c5PiANqjkvfmSWZaHOfSfO
+4sb8+EgozUtz6ITNaEHRF7GZjLCZe6oCwIWAgrim5A2Jzfwuq1tSPN69WeXln0rIjD7SHHcdUrcFqdGT
+0scoO7prEs/wI7moyeVlRVxcwPGJraR2nvhRK375MAU4coUmjLIweC06L2r9DBa/c8V417Xz092ioLk7
+ohXBHhYoHeec+dv1nlCre07J2uUdLKeJZ/6MFa70w5hz8oahhWpOLFQzswhT0eLVAu0QdRIIqqMOhNgF
+N54dgBphXohW08sVRG4+CGs3EYT1SLXZUx4JZq8yvg6WWej/E4nDK+yGU+DxVB5jYmcTKtBM97Dh4J9n
+bHeR/VhMkJtq6p8HZZYN1HBLC+fTE300qlIcApzMCRJYAGtpJpXkOV2TDesMH1x/GeLajgd6Uo/5+c+N
+Idp9HrTlLEkx7bSYlRUkCeKTV09CuGwDwNPkjPlInYCJyXQGiAQLqIlvZyDIwQCZKHkGAjQNiJG0ZwCO
+yAESiH0G5Cs+oLzgGViokYAMsmfAqjkFq+aF4LGDBOgomQPdTxjNO8VarNghqtqpKE/K9qQMnyyHhdb/
+cpikp8xsBSRm8g6rN3LDdeYvK/svL0GEbYRkXfc523K7jAr8DCf1ye/wB/8IX3wSslWfRrczeo0AuxOG
+eC/f09w3mzBIDl/R49CV96LFV120BXkYyP90a2APqWf/YTCOKPto5o/mff9k7aHA9syO5Bru8ir9ZwA1
+GhNk6REAAA==
 `,
 	},
 
@@ -15824,24 +15819,25 @@ UI9yAAAA
 
 	"/partials/ackgroup.html": {
 		local:   "web/static/partials/ackgroup.html",
-		size:    2379,
+		size:    2600,
 		modtime: 0,
 		compressed: `
-H4sIAAAJbogA/6RVTY/bNhA9b34FqwawjVZ2Wm+TNJUMbDcoet/eih4ociSypkmBHHllGP7vBT8kex3V
-2yLwgRb55s0bat6ocHhQsHmzbKkGlQugXOrmWBnLweaWctm5T+u2/+U0QDjVDdi8Mv2xMn3uBOXm+RP5
-qe3Ju/D7lv58f3//I5HaAZ7jnqnVUje3Aj8+vP+8/vU60HWMgXO3AtePH96vP18HSl2bm1E/fHj3sR6j
-ilW6i4LLPWGKOldmkamxpmszopvcCfNcZuHZZZs3d5dYpoDaWvZ+/64Qa4+vpOZlRtk2Gxk7pXIFNWab
-YiXWAetaql+cW9kIDDx3DhQw9P+Kym7i2iGaMaBCTSrUOYeadgrDf7cLapmSbFtmkWKOtoNFtqFKFatI
-8VV0NVXO82mj4ZKwWPlybtRFniWKPLIAv7hWqg9Pw26Q5oFkAL64Anott7VyR+1hQu6uUygpQ2n0fKYN
-wiyIRihW9OvIKNvOFuGUS0crBbzMvmFUP7DtuQ7Ktto8K+DNjYTJHK8lZMo4mEz56E/OSQPw39NFD7+W
-rTa2AZxM91s4OueL0BsJv2wmYaEus+NRSIfGHuaL0ynb/B4fEtHYScWKy/2V24Izk/bzxqP/Pw/+XD4h
-xc5F+RZaoJicS6QmycL+TGqJZSZ5T0ryVmoOycFfjIE0HW8mfeysBY0p93czP4Bml1fMjFK0dTCXvF/E
-Ni/E/ctEKFFBPLsrFK1ATbgo+iKh7gqp2w4JHlooMyaAbSvTZxNDxUvZGQ4qXcYygJMPB41+mb+FPWj8
-Pt1JvEcmfOuUWddyijBfDCJXQWV6oMS/3EFYGAKhojL7Q0hHoGeK7qhvMiKZ0cRCa8GBRkdQUCQogFAF
-Fol0/l1RTXxP7oHMtdG5NnZH1YI4pAjLscZGHVrh+S7fz3E2bucXeXMnGz37FLtg+RDIT34gD6NrUviu
-Q3hNsaCOVACaOKlAM+D/Q+DeqG4HuanrUdpTYpkWN35gErir/g4tMQGd+Locj6llhVTcgl4q0A2K0ymW
-4i5ZRmuvxH20ZnTkhEsqww/k+rsp6zLzQ17/KXn/V2p7LveB/IWjw85/cPWAG5zNfBWjs8eiIg5dHrqF
-oMsb2/q5I3l/OmVpI5L67QkX+8EUax9qHsZRXNPyTwAAAP///AxGI0sJAAA=
+H4sIAAAAAAAC/6RWT2/jthM9O5+CP/0WsI2u7G2d7m5TyUCaRdBjgfRW9ECRI5E1TQrkyJZh+LsX/GPF
+cVxnFwsfJJIzb94MZ55cONwpWN7MWqpB5QIol7rZV8ZysLmlXHbubtH2vx6OJpzqBmxemX5fmT53gnKz
+vSM/tz35EH7/p7/c3t7+RKR2gM9+W2q11M01x8/3H78sfjt3dB1j4Nw1x8XDp4+LL+eOUtfmqtePnz58
+rgevYp5qUXC5IUxR58osIjXWdG1GdJM7YbZlFtYuW96MTm2ZAmpr2fv9USEW3r6SmpcZZatsQOyUyhXU
+mC2LuVgEW9dS/eLcykZgwBk5UMDQvxWVXcZnh2gGhwo1qVDnHGraKQzvbh3YMiXZqswixARtB9NsSZUq
+5hHiu+BqqpzH00bDKWAx9+lcyYtsJYo8ogA/KSvVu6fjbqDmDcnR8EUJ6Dnd1so1tbsLdNedQkkZSqMn
+Y20QxoE0QjGn3wdG2Wo8DadcOlop4GX2P0b1PVs950HZSputAt5cCZiG462ATBkHF0M++JPnoMHwv8PF
+GX4rWm1sA3gx3GM4eo4XTa8EfN1MwkJdZvu9kA6N3U2mh0O2/D0uEtDQScWcy83ZtIXJTNyfNx78+yTM
+5+wJKXYu0rfQAsU0uURqkkbYn0ktscwk70lJ3knNIU3wKxlI6ng16ENnLWhMsX8YewEan5aYGaVo62Ai
+eT+NbV6I25eBUKKCeDYqFK1AXZiiOBfJalRI3XZIcNdCmTEBbFWZPnvtFqisDQeVijELxsBfcPSPyTvY
+gMb3qSaxjkz41imzruUUYTI9kpwHlmlBib/cI7EgAiGjMvtTSEegZ4quqW8yIpnRxEJrwYFGR1BQJCiA
+UAUWiXT+rqgmvic3QCba6Fwbu6ZqShxShNmQY6N2rfB4p/ezHw/b+Unc3MlGj+9iF8zuA/jBC/JRui4S
+X3cIbzEW1JEKQBMnFWgG/BsIbozq1pCbuh6oPSWUt8kJ09nGY35FTbdUopeb2ljCrMRHY98Tr0GPxhJj
+iQVmNmD9qoLaWCDh3r2LRBcK37lvSGzgNuT1B2g/SnFMLic3fD1TJbrqn9DvF0wvfDr3+zSPQipuQc8U
+6AbF4RCr4E5RBt2ai9uoO1FuLkhAZfiOnP8pkHWZ+S+Y/kvy/u8001xuAvipexZ2vkKyjnZH2WI+i0G2
+hqSiHbo8jAJBlze29aIqeX84ZGkjgvrtCxLlVfdmdJpzeknP9Ph3AKRN4x4oCgAA
 `,
 	},
 
@@ -16016,18 +16012,18 @@ HImJH9G9ru6/a+052v4vAAD//0+q7wgXNQAA
 
 	"/partials/dashboard.html": {
 		local:   "web/static/partials/dashboard.html",
-		size:    1108,
+		size:    1221,
 		modtime: 0,
 		compressed: `
-H4sIAAAJbogA/5xSwY7aMBA9h6+YWpVopYZ0e6RJJA7dtpeql/0AY08cCzOO7AEWIf595SRAVmKl1V4S
-j9/4vefnKbXdg3IyxkoEfxBAJo+tP1TCeaktGVHPsmmT8i53Jn/4kYBXiHQYGPpvbqnxPdfakp5wlYW2
-+8Q4/C+/N01gCD58zIKWZDBMTIxc77NwRzJu84fvg6SlbsezLMtGuPFhmytPHLwTaZ+PHVaC8Zn7kky+
-9RpdJRrrGMNlc4NH7Q9UiXHx5TPukfhrj3dOKmy90xhenWPvHduuX1p2WInHHgRLymokjgv471BGhIgI
-f9B1sFzCU5QG0+Lv2AbDsQiND8AtQjwSy+ekcYvnXgpj7hLagE0lij7YKOpyvWP2dOleM/Xpj+VpvmbK
-NTZy53i+hE9Rtah3DheP0jpLZpWeLX6Doa9/vfkS7nedBUQ+prs3zkteBmta/ilgyH3wIeoZQJZlZezk
-zZTUBkV9Or3Bey4m0BMp5yPqX/0Fz+eySFQ1DHXKoBik6rKQ90eKYy7VJjfB77pKXJl/pzou/iHqldoI
-kGpTiXkqI6zUhvzBoTa4ReK5uGZwIxDAdouStJacBu1WXAf8HfITJX3xMN37oPRLAAAA///VhAjAVAQA
-AA==
+H4sIAAAAAAAC/7RUwYrbMBA9O18xFYW0UMfdHlPbkEO37aXsZT9AscayiDIy0iTZEPLvRbKz8UIWloW9
+WHqa0XtPT8KlMntorAyhEt4dBJDOQ+cOlbBOKkNa1LNs2tQ4m1ud3/2IhRcVadEzpG9uqHWJa21ITbjK
+Qpl9ZBzGy/CqCfTe+fdZUJI0+omJkettFm5Ihm1+932QNNTveJZl2Vhund/mjSP2zoq4zsceK8H4xAmS
+zrdOoa1Eayyjvyxu8KjcgSoxTr58xj0Sf0313soGO2cV+hf72DnLpk9TwxYrcZ+KYKgxConDAh4syoAQ
+EOEP2h6WS3gMUmOc/B3bYNgWoHUeuEMIR2L5FDWu8dxKYcxdQuexrUSRgg2iLtc7ZkeX7jVTSn+Ep/ma
+KVfYyp3l+RI+haZDtbO4uJfGGtKreG3hGwx96fbmS7jddRYQ+BjP3loneemN7vingCH3wYeoZwBZlpWh
+l1dTUmkU9en0Cu+5mJQeqbEuoPqVDng+l0WkqmHAMYNikKrLQt5+Uhxy2Wxy7d2ur8Qz8++Iw+Ifolo1
+GwGy2VRiHmGAVbMhd7CoNG6ReC6eM7gSCGCzRUlKSY4P7QpE/Xb5iZK6eJiufaD0A1L6I4yqI3yn4P8B
+AIZ9qD7FBAAA
 `,
 	},
 
@@ -16196,29 +16192,32 @@ kPbj6XFNA6z51KuYSaTMHYWJRyzmlGCtFn9hrRxGXePwpOlxhYTb5W8O37F+S5OMtQkoZ+OZhB8rhTJy
 
 	"/partials/incident.html": {
 		local:   "web/static/partials/incident.html",
-		size:    5016,
+		size:    5846,
 		modtime: 0,
 		compressed: `
-H4sIAAAJbogA/7xYTW/jNhA9b38FK7TZXWAlrZNggXolBekiRReb9tCkvRQ90OJEYk2TAjnyBwz/94LU
-px0pCeKkF4chh49v3ow4I0WML0kqqDGxp9XKIzLzTa5WsQdaK+0l373pm6RK+CLzJ6d2YW+FCtBI3K/P
-qMxAO6wZl6zFikLGlxax+tv8sSjtsVymnIFEcnJCDFKEQwqWpTs8P0vI18Z6u202Bl/ZbheF+Vl30PD+
-4v6hwZVkXnIlGbnlC5hGpqCSoPGRL6BndYNUo2cXQLLDRQtBpPIFl/PYQ12CddwiJVFYPEbqQGuz8D+5
-hUHrQfuzeulNVCSRQa1klly64HyDzTQK66mKizWr6Qyj/dSiOS3aiLbuOuhvsPFIeIjXGx1D3qldB+Q4
-9mORfC3mX0qtbXLeIMXSvLz2NX4FbwNADJcpkDFvrYavF6drapBczqTSCypezWd7SnPIw477peTrkZ2v
-q8RfHFbHuE6t37mGu9gLc25Q6c3FHDbxdgsyVQze3Xv63u92XvJLKQT5tbKPQpp8uA+33aZK3vHsmsu5
-3fJHKYBcMY5Kj+wIYV3oC/sTb7czVLQ7/GpdaHewHYAxXEkLMqZpN3idS+6GC5DpMZcEbVZmKMkMpc/g
-jpYC3XhtvJ4qpjrsgpWaIlcynuQnrvjFvUrkorPbnSDNzEDwbmlmnH4TkqtSt9odR+T0CCKnjoh5ISaf
-jmDy6UWZTI4RZfKyqpyeHxOg8wMuL353XaaW5XNuL+s0v4s917V1fUFzBBpfKoQkCptRt0DTeTVvB910
-KpSpN1TDbulO6RT6Bv2JPbMMsGGWaVUWQVU1SByTt6WcS7WSb70WIwPsbS9KndX41fDJmg/0lq7+OHWf
-U1fdxuffbb1c63gEt5sCdruDgjtKO/jTgPaS2YYMo9ll23q7XpdQHO1D+gxsIW764ycT+Q2MoRl4yXSE
-Sm3QsnmsJj3Yk+fnzVMRhfn5A9busecMYo9W9oEAmWHuJb8rQhuI8b2Vw4d7LQWkMwGNefWP+/UNal4A
-84jBjYDYW3GG+XTy8eOPdUXFHCir/UeW2IhHIfZmbNT2Z2rx9idtoNqZKOxgI5wptmkMtfVDQwEUY48S
-Lhu/u3uAdV0ddRnonj42vF6l3Ph6mwkHJrUcCGv0qeCZnGqe5fi5r3PdD7f5SdtsbB0PUTcON15GoVO+
-S4PxrLlagsRHkoYUVIJwtOpJN/HFjt8t68vqvdeX9d0PXDJYfyDL91ZgcKfce310ML4NE5dZjc/Tubsa
-BC0M9GDqXMnP9zcjRwHNZUWJrWjD3XnDc/BJbiBLIXwXhF4Mlocx6E1079ZL+1JdzQ+/WNfBonWsnN7D
-/WblmI1lV69ytZJ/V2L80+tDW5LfLwOmJFyrSsqkHgRB8JT7fxm0X1IG7ujuY4q79DU86XtKg9k+9TlH
-8E1BU5iSQoO/0rT4bOUp9BOLlhOmSsammxmgvJ9UDe38bDBvbsrZv5Bi/S1mvFZ1MSEIi0JQhD1fTYXT
-fT/6H334WbHNUQ74OS6E9cLl3JALg1/F/gsAAP//+wXZGpgTAAA=
+H4sIAAAAAAAC/7xY3W/bNhB/zv4KTtjyAVRS84ECcyUVaeFhQbs+NN1ehj3Q4lniQpMCSdkxPP/vA6lv
+RXLc2tmLw9wdf7z73ZE8MSB0iWKGlQodKVYO4omrUrEKHZBSSCf64aRtEgvmssS9vDKKjgYzkBrZX5dg
+noC0WDPKSY0V+IQuDWLxt/pjUOplKY8pAa7R6SlSGmvou2C8tIun1xG6q6w3m2qid0e228BPr5uFhudn
+Txf1ppw40ZQT9JUuYBKoDHOklavpAlpW9xpL7RgFcNJXGgjEhcsofwgdLXMwgRukKPCz55zqca0W7hur
+GLQetL8uVSdBFgVKS8GT6NYm5yOsJ4FfigpfTk5qd4bRfqnRLBd1RutwLfRHWDvI7+O1Roc4b9kuE3KY
+92OZfCnPP+RSmuK811jn6vjcl/gFvEkAUpTHgMaiNRy+XJ4+YaXR7YwLucDsxWI2q1SL7A7czTl9HJn5
+skz8SWF1SOjYxJ1KmIeOn1KlhVy/e4B1uNkAjwWB8ye772K7daJfc8bQb4V94OPo1VO4zSYWfE6TT5Q/
+mClfcgZoSqgWcmSGD4+ZfGd+ws1mpgVuFp8+ZtIubAagFBXcgIxx2gxe5pC7pwx4DAfxXmpmmqOZ5i6B
+Oc6ZtuNH5bRYUcVi70gusaaCh5fpqb38ws2mm53t9lTjRA0k7ytOlOXvEqUilzV3hzlydYAjV9YRdSRP
+3hzgyZujenJ5CCmXx2Xl6uaQBN30fDn62XUbGy+/5/QyQdN56NiurekLqiW0crnQEAV+NWoUOH4o5GbQ
+iGMmVDmhGDaquZAxtA3ago5ZArryLJEiz7zi1kBhiM5y/sDFip85NUYCujU9y2VS4hfDvTkf6C3t/WPZ
+/Z571U78/rOtVWuNH97XdQbbbe/CHXXb+0OBdKLZGm02Y2rTehugCGE92oe0PaCLpj/e25HfQSmcgBNN
+RlwpDbbbNvKOO2lnT57eVLsi8NObHdZ221MCoYMLe48BT3TqRJ8FwhXE+Nwi4P5c44LGMwaVefGP/XWV
+ljQD4iCl1wxCZ0WJTieXr1//XN6oOgVMyvg1iUzGA1+3JCZrXUlJXldoElVLAr+BDfRMkHVlKE0cEjLA
+OnQworyKuzkHSNPVYVuBdveRYX1RcuP6uhJ6JiUdGh61ixlN+ETSJNVv2zyX/XBdn7iuxjpwX8sq4CrK
+wLfMN2UwXjWfhaZzGuNvqx0CjC5BUuiUD+9iPVdEAyBHraN2aN1CuY2fyoojt2enNSwy3ZPaw25qngu6
+8g8SsAbSFX5rPRJTjw0z6F8kJAH5fj05uyNngxVKvHak45VIvNv4OYvyc2UXRsnJLhvD0LR6T+kY1YVM
+vJKuvkm0V+dSVlD7opxjyoCcWXXMaPwQOhIyhtfnxLsjF070xf5n2pKj7Z7pErh+ZtugDHNgpVtWaAUf
+zPh8WUZw4bSL4Pwnygk8vkLLC1MOYFd58vhiYVxTVJQn7bBjwRjOFLRgyh2S3nQna6oZVFc9RqYfHP62
+XbYq48k9WEHmjLn2CGulaNk/wVqC5mVqaZ6kCvnws1SZLFzmyvI9/LVWBGZy2XR7qVjxvwoy/m59xdVO
+/rj0iODwSRRURuXA87x9uqelV79DnpzseIo0ykzCXq+RFWZ91qVUg6syHMMEZRLclcTZW0NPJvds+Swx
+RTFWO2rA5W5RVW6n14N1c5/P/oFYly+Z451ekxNkDg9mnkzbsaoCp3l9/R9jeC/I+qAA3FQvmInCqAdD
+GHxT/m8Agupf6dYWAAA=
 `,
 	},
 
@@ -16291,30 +16290,35 @@ S/HAVn/JWQ+yfG4wgi5P91hQ2H9L7FC75KebXdbJWbypp1f7LwAA//8CAgz6mQQAAA==
 
 	"/partials/silence.html": {
 		local:   "web/static/partials/silence.html",
-		size:    4404,
+		size:    5841,
 		modtime: 0,
 		compressed: `
-H4sIAAAJbogA/7RYUW/bOAx+7n6F4AFrMsRR2wEbENgehqG73UNxD9lwD0VxkC3aFipLPolu62X57wfJ
-duqkzbZ2l5daIWmK30dSohtxcUMyyayNA6NvA6KK0Jb6Ng7AGG2C5MXR2CTTMpRFeHrmFFsaJsEg8X9D
-zlQBxvtKheIbXxHl4sZ57J7DI9emGty4dVhqI75phUzu7u/VhdFN3QUgWQpyHJytwjOSaYXGReq0QWKR
-GSScIUTUi3Zj7198630eRULVDRJsa4gDhDsMtnbvnXt0leYg48Bv4AVZ6aDHQfecTHuP9eChBFmHqdTZ
-dZB80qZiuCBt27ZhVYWck8+fFxcXl4vl8opcXiy/0PDk3cnJ1Zx8/fKRiJwoTVBU8E0rmJOvFixR+tYp
-UsnU9TyitUe2zfLv8weKH5I9UHw/d/87GN4YhkKrA4EZ3D+5GpY1ZCJvCQgswZCBc6INiRgpDeRxUCLW
-C0p1DQotT+cKkHKdWZo2QnJaYiVpY8H8UzSCA/23AdNS58XOne6lAclQ3MCYBZYcrG78YfBjnk9Pnku0
-d/5klv+qHWomD4a51BbtoTB758/HTP6QOrUzYqFmhiFwkrYk01XFLJnMpjNSixosmXyfzlzZ2ZplYOfk
-/I5VtYQFiTLNIVFteAvpyemMqDY0wIV9HVGvORip2rcEsuJgzDrfv0Hs+d2C1Npg/PbNu/ezTDYWwcSn
-M5GzDGKpX38X+eu5Q3BjCTPwoKULLZkq5toUtL4uaM2wpLmQ4BcvLxhmZZAULn2uY3dSuEnbg1xtYjp0
-htypo1j1i1fEYB36PCUR3REcIsIKrGXFTwJ8fgH17p98Zz2MQee5BfQAtmMam5aQXaf6rtP0DHTr7dg3
-hqNQc20K2Hd0kg8NatKZdL7vCdsA2tcQf+YES+iGQJJCpiuwpFHXSt8qwhsjVOENrJCgMiA1GKH5jLAG
-dcVQZEzKtt+cCHxOtf6cz6EI0wZRq8E4RUVSVCGHnDWyZ0eK7Nrl36Kjxj0j2r32SFgRdbEkL/ZP1M7B
-soNuHxusXbL7wbo8S/4WUpJ0QxZfRLQ828PCztvNphuksBg2ymIrgffApXAhGaiBYRxMev8zwjI3G0yJ
-UGQ3UvearZm6n+j7t9xM7xRjm2Fr35X+b1gbUTHTjrjoNguS7jlyElEpehC/BvbxPI537POYaZULU02m
-XsiFZakEHgf96mOnDpLebivVA/j+04iL+xNhjPOWGSVUESTOwlW7aSSQ1cr9XK83KHe+fx4pmCE7Q6cI
-NdTBTyvnzYMkzd3R6jJVvvEm6OAOr/sfAUEb+lVo3ZURXF6ezk6vrvqKwRIY71OMZjhnsOy+rCKK5UgG
-iu9I+jlwS9Zd5lsidwnsiDaH9vYOXIzcRbQPyYmGOCNMNW83IW8VvOAzYqcjSud2u9SPIuSeEFFBHNj5
-0n/eJRFF/rjBueIP1PdJmH/wo+oPDL6wYonGF85+o68WzI/0F8MNtG3Sr44itqdF/Pqum366mYT2fLz3
-6Y1XK4dz0vMwXa9fgeL30nPFncwnOV6terjr9SuX4ni1AuVGj8kI5XS9dnafunP+PTl+1R35xwtyfOzc
-c+E8Cb5eB32y2QbGnmPb/8dhDGVoegnMTASfBolfjru6qxn+sIyG2omo74ndjv0vAAD//6rO3cE0EQAA
+H4sIAAAAAAAC/7RYX2/bOBJ/7n6KgRZI7KwlJSmwCwSSi2Ivez3gggPWLe6hKA6UOJaIUKSWpOp4XX33
+A6k/lhQ7t21PLyY9MxzO/GY4Qyqi7DOknGgde0ruPBCZr3O5iz1USipv/cOroUgquc8z/+bWMkYcwlEZ
+cL8+JSJD5XQlTNBeVxRS9tlqbMZu2EpVdGrs3M+lYn9KYQif7u/YmZJV2RjASYJ8aJwu/FtIpTDKWmq5
+3lobogxQYjAKHWlqe7vwZ6fzVcREWRkw+xJjz+CT8Ua7t8qdd4WkyGPPbeAIaW5dj71mXCxbjWWnIUde
++gmX6aO3/k2qgpg72O/3e78ofErh3bu7h4ePd5vNJ/j4sHkf+te/XF9/CuDD+1+BbUFIMKzAP6XAAD5o
+1CDkzjISTsRjEIWl82yM8vfjh4LOiR4Keh67/7sztFLEMClmcqZT/9XZsCkxZds9IDM5KugwB6kgIpAr
+3MZebkx5F4ayRGE0TQKBJqQy1WFSMU7D3BQ8rDSq/2QVoxj+UaHah1aLDizvR4WcGPYZhyiQ9Wx5ozCt
+lEKRzpU5xw2+Gu5/ldZ9wgN4C6mSAnSaI604wqJgojK4glxWagWU7EFuoZDC5KtuaIk7xMclEAO7nKU5
+mBxBM27tAVcSNCRodoiiYVkSEEH74OoA7p9IUXK8gyiVFNfXcANXcAWbSkSho8wWHZnOHJ3jBl8dnd/x
+j4oppLBjJgcCx0AH8E7ugEuRjeDmRBsNSGwUWIHATBuBFWCQBS26t/ncoHbleSZIO/Xfke7vWxU2fy2C
+R2hHUN1XSpYY/lMKKvtU7PrQvO3G3SFeBvDm+lsRdMq/Hb65fM6lNnoun53y70iZv3OZ6BVoLIkiBikk
+e0hlURANi9VyBSUrUcPiy3Jlu5UuSXqisom9v8Pk+mYFYu8rpExfzV7hXCc1JJsNWav7O4C9f7qDUioT
+//z6lzerlFfaoIpvVmxLUoy5vPrCtleB9eCzBqLw2U0gk5yILJAqC8vHLCyJycMt4+gmPz4Qk+beOrPh
+s41+EsI+bM9i1ds0d4TsZUWQ4i+Wy07ad3FaR+GEMIeFBWpNMpwrgVr1X33VfW6D3G41GufA2KahaI7p
+YyKfGk6LQDMf294LDkzdSpXhudIJbysjoRFpdB8B6x06dyD+0XSi5gWZYCoL1FCJRyF3Amil2KTZl6iY
+pCsglZEFMSwlnO/bzYGZb8nW/41nl4RJZYwUnXBiBCRG+BS3pOItOpyljzb+2lho7BiFzbITZkWhtWX9
+w/mHuFWwaVzXp97juujf4/nt+t+Mc0h6sOhdFOa3Z1CYrK7608CZNn4ltNlzpK3jnFmTFJZITOwtWv0r
+IKl9UiyBCZhaapfpkojjh4B2lf0UYBlDmW5rdyrdr18qVhC1H2DRbOatm3GgJAo5a534a86ejuNwxzaO
+qRRbporF0hEp0yThSGOvnf3asL11KzcKded8YzxSdqwIQz93RAkmMm9tJWy2K/sQORzs37ruvZx8NjmR
+MF10upPCBOhBPF7KnNfPghTY0mojlb92Isa62y13fzww2nczX9uW4X38eLO6+fSpzRiTI6FtiI3q6ozJ
+mw8yUWjyAQ0FnVDczVRPiO3lcERrOvyIZDvDhNRX8vG2lA3URWFrZxQejY9MIum+92N0ChhdgV4OcA70
+OP9fRYY6lFiBsaeDjXXdgmroaYF7Qafs9SSPdPD78eG7PhyG/4NN+46ta1sRYcL9W/vur2t4QWnQPRK8
+9eJwOMmp62Wbl/0w8uiYTMFbd+V+QeA9yTZGuQNwXuiDRvUS/6HrpBPsmtmriJw56m7+1NzimrtV2Ibw
+jUvT+HCwoVm0oVvW9QUKeqTeC2ppLi/jw6F1t64vbFbGhwMKe4VaDLxc1rWV+63pV2/g8qJpXZd3cHlZ
+12PAHf/4Rosv4SfoVZ4I+xJ+gssLmY4WnEwBJ9g9KJ3Y4mSk4csXuLxcQmPdhT0w8eHAaF177ekhPchn
+mqP7HDwEuiutHIlaMLr01m46rJ3NIaTPz2V3GKPQVZ5pXfzvAJvuz6XRFgAA
 `,
 	},
 
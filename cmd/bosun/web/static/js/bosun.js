@@ -3091,11 +3091,25 @@ bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$r
                 $scope.loadTimelinePanel(v, i);
             }
         };
+        $scope.replay = function (did) {
+            $http.post('/api/notifications/outbox/replay?id=' + did, null)
+                .success(function (data) {
+                for (var i = 0; i < $scope.deliveries.length; i++) {
+                    if ($scope.deliveries[i].Id == did) {
+                        $scope.deliveries[i] = data;
+                    }
+                }
+            })
+                .error(function (err) {
+                $scope.error = err;
+            });
+        };
         $http.get('/api/incidents/events?id=' + id)
             .success(function (data) {
             $scope.incident = data;
             $scope.state = $scope.incident;
             $scope.actions = data.Actions;
+            $scope.deliveries = data.Deliveries;
             $scope.body = $sce.trustAsHtml(data.Body);
             $scope.events = data.Events.reverse();
             $scope.configLink = configUrl($scope.incident.AlertKey, moment.unix($scope.incident.LastAbnormalTime * 1000));
@@ -3228,6 +3242,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
         $scope.edit = search.edit;
         $scope.forget = search.forget;
         $scope.message = search.message;
+        $scope.recurrence = search.recurrence;
+        $scope.occurrence = search.occurrence;
+        $scope.timezone = search.timezone;
         if (!$scope.end && !$scope.duration) {
             $scope.duration = '1h';
         }
@@ -3293,11 +3310,14 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
                 tags: tags.join(','),
                 edit: $scope.edit,
                 forget: $scope.forget ? 'true' : null,
-                message: $scope.message
+                message: $scope.message,
+                recurrence: $scope.recurrence,
+                occurrence: $scope.occurrence,
+                timezone: $scope.timezone
             };
             return data;
         }
-        var any = search.start || search.end || search.duration || search.alert || search.hosts || search.tags || search.forget;
+        var any = search.start || search.end || search.duration || search.alert || search.hosts || search.tags || search.forget || search.recurrence;
         var state = getData();
         $scope.change = function () {
             $scope.disableConfirm = true;
@@ -3324,6 +3344,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
             $location.search('tags', $scope.tags || null);
             $location.search('forget', $scope.forget || null);
             $location.search('message', $scope.message || null);
+            $location.search('recurrence', $scope.recurrence || null);
+            $location.search('occurrence', $scope.occurrence || null);
+            $location.search('timezone', $scope.timezone || null);
             $route.reload();
         };
         $scope.confirm = function () {
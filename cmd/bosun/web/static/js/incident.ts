@@ -3,6 +3,8 @@ interface IIncidentScope extends ng.IScope {
 	incident: any;
 	events: any;
 	actions: any;
+	deliveries: any;
+	replay: (id: number) => void;
 	body: any;
 	shown: any;
 	collapse: any;
@@ -61,11 +63,25 @@ bosunControllers.controller('IncidentCtrl', ['$scope', '$http', '$location', '$r
 			$scope.loadTimelinePanel(v, i);
 		}
 	};
+	$scope.replay = (did: number) => {
+		$http.post('/api/notifications/outbox/replay?id=' + did, null)
+			.success((data: any) => {
+				for (var i = 0; i < $scope.deliveries.length; i++) {
+					if ($scope.deliveries[i].Id == did) {
+						$scope.deliveries[i] = data;
+					}
+				}
+			})
+			.error((err: any) => {
+				$scope.error = err;
+			});
+	};
 	$http.get('/api/incidents/events?id=' + id)
 		.success((data: any) => {
 			$scope.incident = data;
 			$scope.state = $scope.incident;
 			$scope.actions = data.Actions;
+			$scope.deliveries = data.Deliveries;
 			$scope.body = $sce.trustAsHtml(data.Body);
 			$scope.events = data.Events.reverse();
 			$scope.configLink = configUrl($scope.incident.AlertKey, moment.unix($scope.incident.LastAbnormalTime *1000));
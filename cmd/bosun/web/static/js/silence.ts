@@ -20,6 +20,9 @@ interface ISilenceScope extends ng.IScope {
 	forget: string;
 	user: string;
 	message: string;
+	recurrence: string;
+	occurrence: string;
+	timezone: string;
 }
 
 bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$route', function($scope: ISilenceScope, $http: ng.IHttpService, $location: ng.ILocationService, $route: ng.route.IRouteService) {
@@ -33,6 +36,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 	$scope.edit = search.edit;
 	$scope.forget = search.forget;
 	$scope.message = search.message;
+	$scope.recurrence = search.recurrence;
+	$scope.occurrence = search.occurrence;
+	$scope.timezone = search.timezone;
 	if (!$scope.end && !$scope.duration) {
 		$scope.duration = '1h';
 	}
@@ -99,10 +105,13 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 			edit: $scope.edit,
 			forget: $scope.forget ? 'true' : null,
 			message: $scope.message,
+			recurrence: $scope.recurrence,
+			occurrence: $scope.occurrence,
+			timezone: $scope.timezone,
 		};
 		return data;
 	}
-	var any = search.start || search.end || search.duration || search.alert || search.hosts || search.tags || search.forget;
+	var any = search.start || search.end || search.duration || search.alert || search.hosts || search.tags || search.forget || search.recurrence;
 	var state = getData();
 	$scope.change = () => {
 		$scope.disableConfirm = true;
@@ -129,6 +138,9 @@ bosunControllers.controller('SilenceCtrl', ['$scope', '$http', '$location', '$ro
 		$location.search('tags', $scope.tags || null);
 		$location.search('forget', $scope.forget || null);
 		$location.search('message', $scope.message || null);
+		$location.search('recurrence', $scope.recurrence || null);
+		$location.search('occurrence', $scope.occurrence || null);
+		$location.search('timezone', $scope.timezone || null);
 		$route.reload();
 	};
 	$scope.confirm = () => {
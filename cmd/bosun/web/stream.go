@@ -0,0 +1,68 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"bosun.org/cmd/bosun/sched"
+	"bosun.org/opentsdb"
+	"bosun.org/slog"
+)
+
+// streamKeepalive is the time between comments sent to keep idle streams
+// open through proxies.
+const streamKeepalive = 30 * time.Second
+
+// Stream sends the events of the schedule as Server-Sent Events until the
+// client goes away. The alert parameter, a glob of alert names, and the tags
+// parameter, tags with globs of values such as host=ny-*, filter the events.
+func Stream(w http.ResponseWriter, r *http.Request) {
+	f := &sched.StreamFilter{Alert: r.FormValue("alert")}
+	if tags := r.FormValue("tags"); tags != "" {
+		// globs are not valid tag values, so only fail if nothing was parsed
+		ts, err := opentsdb.ParseTags(tags)
+		if err != nil && ts == nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.Tags = ts
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		serveError(w, fmt.Errorf("streaming is not supported"))
+		return
+	}
+	events, unsubscribe := schedule.SubscribeEvents()
+	defer unsubscribe()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	fmt.Fprint(w, ": connected\n\n")
+	flusher.Flush()
+	keepalive := time.NewTicker(streamKeepalive)
+	defer keepalive.Stop()
+	for {
+		var err error
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepalive.C:
+			_, err = fmt.Fprint(w, ": keepalive\n\n")
+		case e := <-events:
+			if !f.Match(e) {
+				continue
+			}
+			b, merr := json.Marshal(e)
+			if merr != nil {
+				slog.Errorln("error encoding stream event:", merr)
+				continue
+			}
+			_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, b)
+		}
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,57 @@
+package web
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"bosun.org/cmd/bosun/conf"
+	"bosun.org/cmd/bosun/conf/rule"
+)
+
+func TestStream(t *testing.T) {
+	schedule.Init(&conf.SystemConf{}, new(rule.Conf), testData, false, false, false)
+	ts := httptest.NewServer(http.HandlerFunc(Stream))
+	defer ts.Close()
+	resp, err := http.Get(ts.URL + "?alert=a&tags=host=ny-*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("unexpected content type %s", ct)
+	}
+	lines := make(chan string)
+	go func() {
+		s := bufio.NewScanner(resp.Body)
+		for s.Scan() {
+			lines <- s.Text()
+		}
+		close(lines)
+	}()
+	// wait for the subscription before publishing
+	if l := <-lines; l != ": connected" {
+		t.Fatalf("unexpected first line %q", l)
+	}
+	if err := schedule.ClearSilence("abc"); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	timeout := time.After(time.Second)
+	for len(got) < 2 {
+		select {
+		case l := <-lines:
+			if l != "" {
+				got = append(got, l)
+			}
+		case <-timeout:
+			t.Fatalf("no event before timeout, got %q", got)
+		}
+	}
+	if got[0] != "event: silenceClear" || !strings.HasPrefix(got[1], "data: {") || !strings.Contains(got[1], `"SilenceId":"abc"`) {
+		t.Errorf("unexpected event %q", got)
+	}
+}
@@ -133,7 +133,10 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	handleFunc("/api/", APIRedirect, fullyOpen).Name("api_redir")
 	handle("/api/action", JSON(Action), canPerformActions).Name("action").Methods(POST)
 	handle("/api/alerts", JSON(Alerts), canViewDash).Name("alerts").Methods(GET)
+	handle("/api/cache", JSON(QueryCache), canViewConfig).Name("query_cache").Methods(GET)
+	handle("/api/cache", JSON(QueryCache), canSaveConfig).Name("query_cache_flush").Methods(http.MethodDelete)
 	handle("/api/config", JSON(Config), canViewConfig).Name("get_config").Methods(GET)
+	handle("/api/config/files", JSON(ConfigFiles), canViewConfig).Name("config_files").Methods(GET)
 
 	handle("/api/config_test", JSON(ConfigTest), canViewConfig).Name("config_test").Methods(POST)
 	handle("/api/save_enabled", JSON(SaveEnabled), fullyOpen).Name("seve_enabled").Methods(GET)
@@ -160,6 +163,9 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	handle("/api/quiet", JSON(Quiet), canViewDash).Name("quiet").Methods(GET)
 	handle("/api/incidents/open", JSON(ListOpenIncidents), canViewDash).Name("open_incidents").Methods(GET)
 	handle("/api/incidents/events", JSON(IncidentEvents), canViewDash).Name("incident_events").Methods(GET)
+	handle("/api/incidents/search", JSON(SearchIncidents), canViewDash).Name("search_incidents").Methods(GET)
+	handle("/api/notifications/outbox", JSON(Outbox), canViewDash).Name("outbox").Methods(GET)
+	handle("/api/notifications/outbox/replay", JSON(OutboxReplay), canPerformActions).Name("outbox_replay").Methods(POST)
 	handle("/api/metadata/get", JSON(GetMetadata), canViewDash).Name("meta_get").Methods(GET)
 	handle("/api/metadata/metrics", JSON(MetadataMetrics), canViewDash).Name("meta_metrics").Methods(GET)
 	handle("/api/metadata/put", JSON(PutMetadata), canPutData).Name("meta_put").Methods(POST)
@@ -173,6 +179,7 @@ func Listen(httpAddr, httpsAddr, certFile, keyFile string, devMode bool, tsdbHos
 	handle("/api/silence/get", JSON(SilenceGet), canViewDash).Name("silence_get").Methods(GET)
 	handle("/api/silence/set", JSON(SilenceSet), canSilence).Name("silence_set")
 	handle("/api/status", JSON(Status), canViewDash).Name("status").Methods(GET)
+	handleFunc("/api/stream", Stream, canViewDash).Name("stream").Methods(GET)
 	handle("/api/tagk/{metric}", JSON(TagKeysByMetric), canViewDash).Name("search_tkeys_by_metric").Methods(GET)
 	handle("/api/tagv/{tagk}", JSON(TagValuesByTagKey), canViewDash).Name("search_tvals_by_metric").Methods(GET)
 	handle("/api/tagv/{tagk}/{metric}", JSON(TagValuesByMetricTagKey), canViewDash).Name("search_tvals_by_metrictagkey").Methods(GET)
@@ -627,7 +634,74 @@ func IncidentEvents(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request
 	if err != nil {
 		return nil, err
 	}
-	return schedule.DataAccess.State().GetIncidentState(num)
+	state, err := schedule.DataAccess.State().GetIncidentState(num)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, nil
+	}
+	deliveries, err := schedule.DataAccess.Outbox().GetIncidentDeliveries(num)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		*models.IncidentState
+		Deliveries []*models.Delivery
+	}{state, withoutPayloads(deliveries)}, nil
+}
+
+// Outbox lists the deliveries of notifications created in the last week, or
+// since the duration in the since parameter. The status parameter filters them
+// by status.
+func Outbox(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	since := 7 * 24 * time.Hour
+	if v := r.FormValue("since"); v != "" {
+		d, err := opentsdb.ParseDuration(v)
+		if err != nil {
+			return nil, err
+		}
+		since = time.Duration(d)
+	}
+	deliveries, err := schedule.DataAccess.Outbox().ListDeliveries(time.Now().UTC().Add(-since))
+	if err != nil {
+		return nil, err
+	}
+	if status := r.FormValue("status"); status != "" {
+		filtered := []*models.Delivery{}
+		for _, d := range deliveries {
+			if d.Status.String() == status {
+				filtered = append(filtered, d)
+			}
+		}
+		deliveries = filtered
+	}
+	return withoutPayloads(deliveries), nil
+}
+
+// OutboxReplay sends the delivery with the id parameter again and returns it.
+func OutboxReplay(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	id, err := strconv.ParseInt(r.FormValue("id"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid delivery id: %v", err)
+	}
+	d, err := schedule.ReplayDelivery(id)
+	if err != nil {
+		return nil, err
+	}
+	return withoutPayloads([]*models.Delivery{d})[0], nil
+}
+
+// withoutPayloads returns copies of deliveries without their payloads, which
+// can be large and may contain credentials.
+func withoutPayloads(deliveries []*models.Delivery) []*models.Delivery {
+	ds := make([]*models.Delivery, len(deliveries))
+	for i, d := range deliveries {
+		c := *d
+		c.Payload = nil
+		ds[i] = &c
+	}
+	return ds
 }
 
 func Status(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
@@ -669,7 +743,11 @@ func Status(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 		}
 		rt, err := schedule.DataAccess.State().GetRenderedTemplates(state.Id)
 		if err != nil {
-			return nil, err
+			if state.Open {
+				return nil, err
+			}
+			// the retention may have dropped the templates of old incidents
+			rt = &models.RenderedTemplates{}
 		}
 		st := ExtStatus{IncidentState: state, RenderedTemplates: rt}
 		if st.IncidentState == nil {
@@ -826,6 +904,18 @@ func SilenceSet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	if start.IsZero() {
 		start = time.Now().UTC()
 	}
+	// A recurring silence is active for the occurrence duration at each
+	// time of its recurrence, until its end.
+	var rec *models.Recurrence
+	if data["recurrence"] != "" {
+		if data["occurrence"] == "" {
+			return nil, fmt.Errorf("occurrence must be specified for recurring silences")
+		}
+		rec, err = models.ParseRecurrence(data["recurrence"], data["occurrence"], data["timezone"])
+		if err != nil {
+			return nil, err
+		}
+	}
 	if end.IsZero() {
 		d, err := opentsdb.ParseDuration(data["duration"])
 		if err != nil {
@@ -840,7 +930,7 @@ func SilenceSet(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	} else if ok {
 		username = data["user"]
 	}
-	return schedule.AddSilence(start, end, data["alert"], data["tags"], data["forget"] == "true", len(data["confirm"]) > 0, data["edit"], username, data["message"])
+	return schedule.AddSilence(start, end, rec, data["alert"], data["tags"], data["forget"] == "true", len(data["confirm"]) > 0, data["edit"], username, data["message"])
 }
 
 func SilenceClear(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
@@ -856,13 +946,27 @@ func ConfigTest(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (i
 	if len(b) == 0 {
 		return nil, fmt.Errorf("empty config")
 	}
-	_, err = rule.NewConf("test", schedule.SystemConf.EnabledBackends(), string(b))
+	_, err = parseTestConfig("test", r.FormValue("file"), string(b))
 	if err != nil {
 		fmt.Fprintf(w, err.Error())
 	}
 	return nil, nil
 }
 
+// parseTestConfig parses the running configuration with the text a user
+// submitted to be tested in place of its file named file, or its first file if
+// file is empty. The text may only include the files of the running
+// configuration, or none if the configuration is not from the rule directory.
+func parseTestConfig(name, file, text string) (*rule.Conf, error) {
+	if c, ok := schedule.RuleConf.(*rule.Conf); ok {
+		if file != "" {
+			return c.ParseRawFile(file, text)
+		}
+		return c.ParseText(text)
+	}
+	return rule.NewConf(name, schedule.SystemConf.EnabledBackends(), text)
+}
+
 func Config(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
 	var text string
 	var err error
@@ -871,6 +975,11 @@ func Config(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 		if err != nil {
 			return nil, err
 		}
+	} else if file := r.FormValue("file"); file != "" {
+		text, err = schedule.RuleConf.GetRawFile(file)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		text = schedule.RuleConf.GetRawText()
 	}
@@ -878,6 +987,12 @@ func Config(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (inter
 	return nil, nil
 }
 
+// ConfigFiles returns the names of the files of the rule configuration, in
+// the order they are loaded.
+func ConfigFiles(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	return schedule.RuleConf.GetFileNames(), nil
+}
+
 func APIRedirect(w http.ResponseWriter, req *http.Request) {
 	http.Redirect(w, req, "http://bosun.org/api.html", 302)
 }
@@ -24,6 +24,9 @@ var (
 	flagAlert    = flag.String("a", "", "Name of the alert to silence, defaults to empty which means all alerts.")
 	flagMessage  = flag.String("m", "", "Reason for the silence, defaults to an empty string.")
 	flagForget   = flag.String("f", "", "Set to 'true' to forget anything that goes unknown during the silence. Used when decommissioning something.")
+	flagRecur    = flag.String("r", "", "Cron schedule at which each occurrence of a recurring silence starts, e.g. \"0 1 * * Sun\". The silence recurs until the end of -d.")
+	flagOccur    = flag.String("o", "", "Duration of each occurrence of a recurring silence, e.g. 2h. Required with -r.")
+	flagTimezone = flag.String("z", "", "Timezone of the schedule of a recurring silence, e.g. Europe/London. Defaults to UTC.")
 )
 
 func main() {
@@ -61,6 +64,17 @@ func main() {
 		log.Fatal(err)
 	}
 	end := now.Add(d)
+	occurrence := ""
+	if *flagRecur != "" {
+		if *flagOccur == "" {
+			log.Fatal("-o is required with -r")
+		}
+		o, err := time.ParseDuration(*flagOccur)
+		if err != nil {
+			log.Fatal(err)
+		}
+		occurrence = fmt.Sprintf("%ds", int64(o.Seconds()))
+	}
 	if *flagForget != "" {
 		*flagForget = "true"
 	}
@@ -73,6 +87,10 @@ func main() {
 		Message string `json:"message"`
 		Confirm string `json:"confirm"`
 		Forget  string `json:"forget"`
+
+		Recurrence string `json:"recurrence,omitempty"`
+		Occurrence string `json:"occurrence,omitempty"`
+		Timezone   string `json:"timezone,omitempty"`
 	}{
 		un,
 		now.Format("2006-01-02 15:04:05 MST"),
@@ -82,6 +100,9 @@ func main() {
 		*flagMessage,
 		"confirm",
 		*flagForget,
+		*flagRecur,
+		occurrence,
+		*flagTimezone,
 	}
 	b, err := json.Marshal(s)
 	if err != nil {
@@ -110,4 +131,7 @@ func main() {
 	}
 	fmt.Printf("Created silence: Start: %s, End: %s, Tags: %s, Alert: %s, Message: %s\n",
 		s.Start, s.End, s.Tags, s.Alert, s.Message)
+	if s.Recurrence != "" {
+		fmt.Printf("Recurring at %s for %s\n", s.Recurrence, *flagOccur)
+	}
 }
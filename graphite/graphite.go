@@ -26,6 +26,8 @@ type Response []Series
 type Series struct {
 	Datapoints []DataPoint
 	Target     string
+	// Tags are the tags of a tagged series (Graphite 1.1+), including its name.
+	Tags map[string]string
 }
 
 type DataPoint []json.Number
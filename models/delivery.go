@@ -0,0 +1,82 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Delivery is the sending of one action of a notification, such as an email or
+// a post, or of an event to a webhook, kept in the outbox until it is sent or
+// has failed too often.
+type Delivery struct {
+	Id           int64
+	Notification string // name of the notification or webhook
+	Action       string // email, post, get, slack, pagerduty, opsgenie or webhook
+	AlertKey     string // alert key, or group name of unknown and action notifications
+	IncidentId   int64  `json:",omitempty"`
+	Subject      string
+
+	Status      DeliveryStatus
+	Attempts    int
+	LastError   string `json:",omitempty"`
+	Created     time.Time
+	LastAttempt *time.Time `json:",omitempty"`
+	NextAttempt time.Time
+
+	// Payload is what is sent, in a format of the action.
+	Payload json.RawMessage `json:",omitempty"`
+}
+
+// IsDone reports whether d will not be attempted again.
+func (d *Delivery) IsDone() bool {
+	return d.Status == DeliverySent || d.Status == DeliveryFailed
+}
+
+type DeliveryStatus int
+
+const (
+	DeliveryPending DeliveryStatus = iota
+	DeliverySent
+	DeliveryRetrying
+	DeliveryFailed
+)
+
+func (s DeliveryStatus) String() string {
+	switch s {
+	case DeliveryPending:
+		return "pending"
+	case DeliverySent:
+		return "sent"
+	case DeliveryRetrying:
+		return "retrying"
+	case DeliveryFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+func (s DeliveryStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+func (s *DeliveryStatus) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+	switch str {
+	case "pending":
+		*s = DeliveryPending
+	case "sent":
+		*s = DeliverySent
+	case "retrying":
+		*s = DeliveryRetrying
+	case "failed":
+		*s = DeliveryFailed
+	default:
+		return fmt.Errorf("unknown delivery status %q", str)
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// EscalationState is the progress of an incident through the levels of an
+// escalation.
+type EscalationState struct {
+	AlertKey   AlertKey
+	Escalation string
+	Level      int       // index of the level that is notified next
+	Notified   int       // number of times the level has been notified
+	Due        time.Time // when the level is notified next
+}
@@ -34,6 +34,26 @@ type IncidentState struct {
 
 	LastAbnormalStatus Status
 	LastAbnormalTime   int64
+
+	// PendingStatus is a status the alert key has been evaluated to, but not
+	// yet changed to because of the critFor, warnFor or recoverFor of the
+	// alert. PendingSince is the unix time it was first seen, and PendingCount
+	// the number of consecutive checks that have seen it.
+	PendingStatus Status `json:",omitempty"`
+	PendingSince  int64  `json:",omitempty"`
+	PendingCount  int    `json:",omitempty"`
+
+	// Flapping is true while the alert key changes status too often. No
+	// notifications are sent for the incident while it is flapping.
+	Flapping bool `json:",omitempty"`
+}
+
+// IncidentSummary is an incident without its events, which make up most of
+// its size, for reading many incidents at once. NumEvents is the number of
+// events of the incident.
+type IncidentSummary struct {
+	*IncidentState
+	NumEvents int
 }
 
 type RenderedTemplates struct {
@@ -58,6 +78,12 @@ func (s *IncidentState) IsActive() bool {
 	return s.CurrentStatus > StNormal
 }
 
+// IsPending reports whether the alert key is held back from changing to
+// another status.
+func (s *IncidentState) IsPending() bool {
+	return s.PendingStatus != StNone
+}
+
 type Event struct {
 	Warn, Crit  *Result `json:",omitempty"`
 	Status      Status
@@ -2,6 +2,7 @@ package models
 
 import (
 	"crypto/sha1"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -17,6 +18,72 @@ type Silence struct {
 	Forget     bool
 	User       string
 	Message    string
+	Recurrence *Recurrence `json:",omitempty"`
+}
+
+// Recurrence makes a silence recur between its start and end: it is only
+// active for Duration after each time matched by the cron Schedule, in
+// Timezone.
+type Recurrence struct {
+	Schedule string
+	Duration string
+	Timezone string `json:",omitempty"`
+
+	cron     *util.Cron
+	duration time.Duration
+	loc      *time.Location
+}
+
+// ParseRecurrence returns a recurrence of duration at the times of the cron
+// schedule in the named timezone, or UTC if it is empty.
+func ParseRecurrence(schedule, duration, timezone string) (*Recurrence, error) {
+	r := &Recurrence{
+		Schedule: schedule,
+		Duration: duration,
+		Timezone: timezone,
+	}
+	return r, r.parse()
+}
+
+func (r *Recurrence) parse() error {
+	var err error
+	if r.cron, err = util.ParseCron(r.Schedule); err != nil {
+		return err
+	}
+	d, err := opentsdb.ParseDuration(r.Duration)
+	if err != nil {
+		return err
+	}
+	if r.duration = time.Duration(d); r.duration <= 0 {
+		return fmt.Errorf("recurrence duration must be positive")
+	}
+	if r.loc, err = time.LoadLocation(r.Timezone); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Recurrence) UnmarshalJSON(b []byte) error {
+	type recurrence Recurrence
+	if err := json.Unmarshal(b, (*recurrence)(r)); err != nil {
+		return err
+	}
+	return r.parse()
+}
+
+// ActiveAt reports whether now is within Duration after a time of the
+// schedule.
+func (r *Recurrence) ActiveAt(now time.Time) bool {
+	start := r.cron.Next(now.Add(-r.duration).In(r.loc))
+	return !start.IsZero() && !start.After(now)
+}
+
+func (r *Recurrence) String() string {
+	s := fmt.Sprintf("%s for %s", r.Schedule, r.Duration)
+	if r.Timezone != "" {
+		s += " " + r.Timezone
+	}
+	return s
 }
 
 func (s *Silence) Silenced(now time.Time, alert string, tags opentsdb.TagSet) bool {
@@ -30,6 +97,9 @@ func (s *Silence) ActiveAt(now time.Time) bool {
 	if now.Before(s.Start) || now.After(s.End) {
 		return false
 	}
+	if s.Recurrence != nil {
+		return s.Recurrence.ActiveAt(now)
+	}
 	return true
 }
 
@@ -53,5 +123,8 @@ func (s *Silence) Matches(alert string, tags opentsdb.TagSet) bool {
 func (s Silence) ID() string {
 	h := sha1.New()
 	fmt.Fprintf(h, "%s|%s|%s%s", s.Start, s.End, s.Alert, s.Tags)
+	if s.Recurrence != nil {
+		fmt.Fprintf(h, "|%s", s.Recurrence)
+	}
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
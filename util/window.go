@@ -0,0 +1,249 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var weekdays = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+var months = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+// Window is a time of day range on some days of the week, such as
+// "Mon-Fri 08:00-18:00". A range that ends before it starts continues past
+// midnight into the next day.
+type Window struct {
+	Text string
+
+	days       [7]bool // by time.Weekday
+	start, end int     // minutes since midnight
+}
+
+// ParseWindow parses a window of the form "[days] HH:MM-HH:MM". Days is a
+// comma separated list of day names or ranges of day names, e.g. "Mon-Fri" or
+// "Sat,Sun". The window is on every day if days is omitted.
+func ParseWindow(s string) (*Window, error) {
+	w := &Window{Text: s}
+	fields := strings.Fields(s)
+	var span string
+	switch len(fields) {
+	case 1:
+		span = fields[0]
+		for i := range w.days {
+			w.days[i] = true
+		}
+	case 2:
+		span = fields[1]
+		for _, part := range strings.Split(fields[0], ",") {
+			lo, hi, err := parseRange(part, 0, 6, weekdays)
+			if err != nil {
+				return nil, fmt.Errorf("bad days in window %q: %v", s, err)
+			}
+			for d := lo; ; d = (d + 1) % 7 {
+				w.days[d] = true
+				if d == hi {
+					break
+				}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("window must be of the form \"[days] HH:MM-HH:MM\": %q", s)
+	}
+	sp := strings.Split(span, "-")
+	if len(sp) != 2 {
+		return nil, fmt.Errorf("bad time range in window %q", s)
+	}
+	var err error
+	if w.start, err = parseClock(sp[0]); err != nil {
+		return nil, fmt.Errorf("bad start in window %q: %v", s, err)
+	}
+	if w.end, err = parseClock(sp[1]); err != nil {
+		return nil, fmt.Errorf("bad end in window %q: %v", s, err)
+	}
+	if w.start == w.end {
+		return nil, fmt.Errorf("window %q is empty", s)
+	}
+	return w, nil
+}
+
+// parseClock parses a time of day of the form HH:MM, from 00:00 to 24:00, and
+// returns it as minutes since midnight.
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err == nil {
+		return t.Hour()*60 + t.Minute(), nil
+	}
+	if s == "24:00" {
+		return 24 * 60, nil
+	}
+	return 0, fmt.Errorf("time of day must be HH:MM: %q", s)
+}
+
+// Contains reports whether t, in its location, is in w.
+func (w *Window) Contains(t time.Time) bool {
+	m := t.Hour()*60 + t.Minute()
+	day := t.Weekday()
+	if w.start < w.end {
+		return w.days[day] && m >= w.start && m < w.end
+	}
+	if m >= w.start {
+		return w.days[day]
+	}
+	// The window started the day before.
+	return m < w.end && w.days[(day+6)%7]
+}
+
+// Cron is a schedule in the syntax of crontab(5): minute, hour, day of month,
+// month and day of week, separated by spaces. Each field is a *, a number or a
+// range of numbers with an optional /step, or a comma separated list of them.
+// Months and days of the week may also be given by their three letter names.
+// If both day fields are restricted, a time matches if either of them does.
+type Cron struct {
+	Text string
+
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+// ParseCron parses a cron schedule.
+func ParseCron(s string) (*Cron, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron schedule must have 5 fields: %q", s)
+	}
+	c := &Cron{
+		Text:    s,
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}
+	var err error
+	parse := func(field string, min, max int, names map[string]int) uint64 {
+		if err != nil {
+			return 0
+		}
+		var bits uint64
+		bits, err = parseCronField(field, min, max, names)
+		return bits
+	}
+	c.minute = parse(fields[0], 0, 59, nil)
+	c.hour = parse(fields[1], 0, 23, nil)
+	c.dom = parse(fields[2], 1, 31, nil)
+	c.month = parse(fields[3], 1, 12, months)
+	c.dow = parse(fields[4], 0, 7, weekdays)
+	if err != nil {
+		return nil, fmt.Errorf("bad cron schedule %q: %v", s, err)
+	}
+	// 7 is Sunday as well.
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	return c, nil
+}
+
+func parseCronField(field string, min, max int, names map[string]int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("bad step in %q", part)
+			}
+			part = part[:i]
+		}
+		lo, hi := min, max
+		if part != "*" {
+			var err error
+			lo, hi, err = parseRange(part, min, max, names)
+			if err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("bad range %q", part)
+			}
+		}
+		for i := lo; i <= hi; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+// parseRange parses a value or a range of values of the form lo-hi between
+// min and max. Values may also be keys of names.
+func parseRange(s string, min, max int, names map[string]int) (lo, hi int, err error) {
+	sp := strings.SplitN(s, "-", 2)
+	value := func(v string) (int, error) {
+		if n, ok := names[strings.ToLower(v)]; ok {
+			return n, nil
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, fmt.Errorf("bad value %q", v)
+		}
+		if n < min || n > max {
+			return 0, fmt.Errorf("%d is not between %d and %d", n, min, max)
+		}
+		return n, nil
+	}
+	if lo, err = value(sp[0]); err != nil {
+		return
+	}
+	hi = lo
+	if len(sp) == 2 {
+		hi, err = value(sp[1])
+	}
+	return
+}
+
+// Match reports whether the minute of t, in its location, is in c.
+func (c *Cron) Match(t time.Time) bool {
+	return c.minute&(1<<uint(t.Minute())) != 0 &&
+		c.hour&(1<<uint(t.Hour())) != 0 &&
+		c.month&(1<<uint(t.Month())) != 0 &&
+		c.matchDay(t)
+}
+
+func (c *Cron) matchDay(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case c.domStar:
+		return dow
+	case c.dowStar:
+		return dom
+	}
+	return dom || dow
+}
+
+// Next returns the first minute after t, in the location of t, that is in c,
+// or the zero time if there is none in the next five years.
+func (c *Cron) Next(t time.Time) time.Time {
+	loc := t.Location()
+	limit := t.AddDate(5, 0, 0)
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for t.Before(limit) {
+		y, m, d := t.Date()
+		switch {
+		case c.month&(1<<uint(m)) == 0:
+			t = time.Date(y, m+1, 1, 0, 0, 0, 0, loc)
+		case !c.matchDay(t):
+			t = time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(y, m, d, t.Hour()+1, 0, 0, 0, loc)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
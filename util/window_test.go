@@ -0,0 +1,91 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWindow(t *testing.T) {
+	// 2016-01-04 is a Monday.
+	at := func(day int, clock string) time.Time {
+		c, err := time.Parse("15:04", clock)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return time.Date(2016, 1, 3+day, c.Hour(), c.Minute(), 0, 0, time.UTC)
+	}
+	tests := []struct {
+		window string
+		t      time.Time
+		expect bool
+	}{
+		{"08:00-18:00", at(0, "08:00"), true},
+		{"08:00-18:00", at(0, "17:59"), true},
+		{"08:00-18:00", at(0, "18:00"), false},
+		{"08:00-18:00", at(0, "07:59"), false},
+		{"Mon-Fri 08:00-18:00", at(5, "12:00"), true},
+		{"Mon-Fri 08:00-18:00", at(6, "12:00"), false},
+		{"Sat,Sun 00:00-24:00", at(6, "23:59"), true},
+		{"Sat,Sun 00:00-24:00", at(0, "00:00"), true},
+		{"Sat,Sun 00:00-24:00", at(1, "00:00"), false},
+		{"Fri-Mon 22:00-06:00", at(1, "05:59"), true},
+		{"Fri-Mon 22:00-06:00", at(1, "22:00"), true},
+		{"Fri-Mon 22:00-06:00", at(2, "05:00"), true},
+		{"Fri-Mon 22:00-06:00", at(2, "06:00"), false},
+		{"Fri-Mon 22:00-06:00", at(2, "22:00"), false},
+		{"Fri-Mon 22:00-06:00", at(3, "05:00"), false},
+	}
+	for _, test := range tests {
+		w, err := ParseWindow(test.window)
+		if err != nil {
+			t.Errorf("%s: %v", test.window, err)
+			continue
+		}
+		if got := w.Contains(test.t); got != test.expect {
+			t.Errorf("%s: %v: expected %v, got %v", test.window, test.t, test.expect, got)
+		}
+	}
+	for _, s := range []string{"", "8-18", "Mon-Fri", "Mon-Fri 08:00", "Foo 08:00-18:00", "08:00-08:00", "08:00-25:00", "Mon Tue 08:00-18:00"} {
+		if _, err := ParseWindow(s); err == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}
+
+func TestCron(t *testing.T) {
+	from := time.Date(2016, 1, 4, 10, 30, 0, 0, time.UTC) // Monday
+	tests := []struct {
+		cron   string
+		expect time.Time
+	}{
+		{"* * * * *", time.Date(2016, 1, 4, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2016, 1, 4, 10, 45, 0, 0, time.UTC)},
+		{"0 9 * * *", time.Date(2016, 1, 5, 9, 0, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2016, 1, 4, 13, 0, 0, 0, time.UTC)},
+		{"0 9 * * sat,sun", time.Date(2016, 1, 9, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 7", time.Date(2016, 1, 10, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2016, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * Wed", time.Date(2016, 1, 6, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 feb *", time.Date(2016, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 feb *", time.Time{}},
+	}
+	for _, test := range tests {
+		c, err := ParseCron(test.cron)
+		if err != nil {
+			t.Errorf("%s: %v", test.cron, err)
+			continue
+		}
+		got := c.Next(from)
+		if !got.Equal(test.expect) {
+			t.Errorf("%s: expected %v, got %v", test.cron, test.expect, got)
+		}
+		if !got.IsZero() && !c.Match(got) {
+			t.Errorf("%s: %v does not match", test.cron, got)
+		}
+	}
+	for _, s := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *"} {
+		if _, err := ParseCron(s); err == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}